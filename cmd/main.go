@@ -10,6 +10,8 @@ import (
 	"robinhood-assignment/config"
 	"robinhood-assignment/helpers"
 	"robinhood-assignment/infrastructures"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/core/services"
 	"robinhood-assignment/internal/handlers"
 	"robinhood-assignment/internal/middlewares"
@@ -17,6 +19,7 @@ import (
 	"robinhood-assignment/internal/validate"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/asaskevich/govalidator"
 	helmet "github.com/danielkov/gin-helmet"
@@ -35,42 +38,194 @@ func main() {
 
 	// helper layer
 	myBcrypt := helpers.NewMyBcrypt()
-	myJWT := helpers.NewMyJWT()
+	myJWT, err := helpers.NewMyJWT()
+	if err != nil {
+		log.Fatalf("failed to load jwt keys: %s\n", err.Error())
+	}
+	mailer, err := helpers.NewMailer()
+	if err != nil {
+		log.Fatalf("failed to create mailer: %s\n", err.Error())
+	}
+	oidcProvider, err := helpers.NewOIDCProvider()
+	if err != nil {
+		log.Fatalf("failed to configure oidc: %s\n", err.Error())
+	}
 
 	interviewRepo := repositories.NewInterviewAppointmentRepository(mc, config.Get().Mongo.Database)
+	interviewCommentRepo := repositories.NewInterviewCommentRepository(mc, config.Get().Mongo.Database)
 	userRepo := repositories.NewUserRepository(mc, config.Get().Mongo.Database)
+	refreshTokenRepo := repositories.NewRefreshTokenRepository(mc, config.Get().Mongo.Database)
+	passwordResetTokenRepo := repositories.NewPasswordResetTokenRepository(mc, config.Get().Mongo.Database)
+	loginAttemptRepo := repositories.NewLoginAttemptRepository(mc, config.Get().Mongo.Database)
+	apiKeyRepo := repositories.NewAPIKeyRepository(mc, config.Get().Mongo.Database)
+	oidcStateRepo := repositories.NewOIDCStateRepository(mc, config.Get().Mongo.Database)
+	idempotencyKeyRepo := repositories.NewIdempotencyKeyRepository(mc, config.Get().Mongo.Database)
+	authSettingRepo := repositories.NewAuthSettingRepository(mc, config.Get().Mongo.Database)
+	candidateRepo := repositories.NewCandidateRepository(mc, config.Get().Mongo.Database)
+	workflowRepo := repositories.NewWorkflowRepository(mc, config.Get().Mongo.Database)
+	scorecardTemplateRepo := repositories.NewScorecardTemplateRepository(mc, config.Get().Mongo.Database)
+	scorecardRepo := repositories.NewScorecardRepository(mc, config.Get().Mongo.Database)
+	auditEventRepo := repositories.NewAuditEventRepository(mc, config.Get().Mongo.Database)
+
+	indexCtx, cancelIndex := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelIndex()
+	if err := interviewRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create interview appointment indexes: %s\n", err.Error())
+	}
+	if err := refreshTokenRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create refresh token indexes: %s\n", err.Error())
+	}
+	if err := passwordResetTokenRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create password reset token indexes: %s\n", err.Error())
+	}
+	if err := loginAttemptRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create login attempt indexes: %s\n", err.Error())
+	}
+	if err := apiKeyRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create api key indexes: %s\n", err.Error())
+	}
+	if err := oidcStateRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create oidc state indexes: %s\n", err.Error())
+	}
+	if err := idempotencyKeyRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create idempotency key indexes: %s\n", err.Error())
+	}
+	if err := candidateRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create candidate indexes: %s\n", err.Error())
+	}
+	if err := scorecardRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create scorecard indexes: %s\n", err.Error())
+	}
+	if err := interviewCommentRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create interview comment indexes: %s\n", err.Error())
+	}
+	if err := auditEventRepo.EnsureIndexes(indexCtx); err != nil {
+		log.Fatalf("failed to create audit event indexes: %s\n", err.Error())
+	}
 
-	interviewService := services.NewInterviewService(interviewRepo, userRepo)
-	authService := services.NewAuthService(userRepo, myBcrypt, myJWT)
+	// Comments used to be embedded in interview appointments. Move any that
+	// are left into their own collection before serving requests.
+	migrated, err := interviewCommentRepo.MigrateEmbeddedComments(context.Background())
+	if err != nil {
+		log.Fatalf("failed to migrate interview comments: %s\n", err.Error())
+	}
+	if migrated > 0 {
+		log.Printf("migrated %d interview comments\n", migrated)
+	}
+
+	interviewService := services.NewInterviewService(interviewRepo, userRepo, candidateRepo, workflowRepo, scorecardTemplateRepo, scorecardRepo, interviewCommentRepo, auditEventRepo)
+	authService := services.NewAuthService(userRepo, refreshTokenRepo, passwordResetTokenRepo, loginAttemptRepo, oidcStateRepo, authSettingRepo, auditEventRepo, myBcrypt, myJWT, mailer, oidcProvider)
+	userService := services.NewUserService(userRepo, refreshTokenRepo, loginAttemptRepo, auditEventRepo, myBcrypt)
+	apiKeyService := services.NewAPIKeyService(apiKeyRepo, userRepo, auditEventRepo)
+	candidateService := services.NewCandidateService(candidateRepo, auditEventRepo)
+	scorecardService := services.NewScorecardService(scorecardTemplateRepo, scorecardRepo, interviewRepo, interviewCommentRepo, auditEventRepo)
+	auditService := services.NewAuditService(auditEventRepo)
 
 	interviewValidate := validate.NewInterviewValidate()
 	authValidate := validate.NewAuthValidate()
+	userValidate := validate.NewUserValidate()
+	apiKeyValidate := validate.NewAPIKeyValidate()
+	candidateValidate := validate.NewCandidateValidate()
+	scorecardValidate := validate.NewScorecardValidate()
+	auditValidate := validate.NewAuditValidate()
 
 	interviewHandler := handlers.NewInterviewHandler(interviewService, interviewValidate)
 	authHandler := handlers.NewAuthHandler(authService, authValidate)
+	userHandler := handlers.NewUserHandler(userService, userValidate)
+	apiKeyHandler := handlers.NewAPIKeyHandler(apiKeyService, apiKeyValidate)
+	candidateHandler := handlers.NewCandidateHandler(candidateService, candidateValidate)
+	scorecardHandler := handlers.NewScorecardHandler(scorecardService, scorecardValidate)
+	auditHandler := handlers.NewAuditHandler(auditService, auditValidate)
 
-	middleware := middlewares.NewMidlewares(myJWT)
+	middleware := middlewares.NewMidlewares(myJWT, userRepo, refreshTokenRepo, apiKeyRepo, idempotencyKeyRepo)
 
 	r := gin.Default()
+	if err := r.SetTrustedProxies(config.Get().HTTPServer.TrustedProxies); err != nil {
+		log.Fatalf("invalid trusted proxies: %s\n", err.Error())
+	}
 	conf := cors.DefaultConfig()
 	conf.AllowAllOrigins = true
-	conf.AddAllowHeaders("Authorization")
+	conf.AddAllowHeaders("Authorization", "If-Match", "If-None-Match", "Idempotency-Key", "X-Request-Id")
+	conf.AddExposeHeaders("ETag", "Idempotent-Replayed", "X-Request-Id")
 	r.Use(cors.New(conf))
 	r.Use(helmet.Default())
+	r.Use(middleware.RequestID)
 	r.GET("/healthz", func(ctx *gin.Context) { ctx.JSON(http.StatusOK, gin.H{"message": "OK"}) })
+	r.GET("/.well-known/jwks.json", authHandler.JWKS)
 
 	interviewGroup := r.Group("/api/interviews")
-	interviewGroup.GET("", middleware.StaffMiddleware, interviewHandler.GetInterviewAppointments)
-	interviewGroup.GET("/:id", middleware.StaffMiddleware, interviewHandler.GetInterviewAppointment)
-	interviewGroup.POST("", middleware.StaffMiddleware, interviewHandler.CreateInterviewAppointment)
-	interviewGroup.PATCH("/:id", middleware.StaffMiddleware, interviewHandler.UpdateInterviewAppointment)
-	interviewGroup.PATCH("/:id/archive", middleware.StaffMiddleware, interviewHandler.ArchiveInterviewAppointment)
-	interviewGroup.POST("/:id/comment", middleware.StaffMiddleware, interviewHandler.AddInterviewComment)
-	interviewGroup.PATCH("/:id/comment/:commentId", middleware.StaffMiddleware, interviewHandler.UpdateInterviewComment)
+	interviewGroup.GET("", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), interviewHandler.GetInterviewAppointments)
+	interviewGroup.GET("/workflow", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), interviewHandler.GetWorkflow)
+	interviewGroup.PUT("/workflow", middleware.RequirePermission(constants.PERMISSION_WORKFLOW_MANAGE), interviewHandler.UpdateWorkflow)
+	interviewGroup.GET("/:id", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), interviewHandler.GetInterviewAppointment)
+	interviewGroup.POST("", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_CREATE), middleware.Idempotent, interviewHandler.CreateInterviewAppointment)
+	interviewGroup.PATCH("/:id", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_UPDATE), interviewHandler.UpdateInterviewAppointment)
+	interviewGroup.PUT("/:id/interviewers", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_ASSIGN), interviewHandler.AssignInterviewers)
+	interviewGroup.PATCH("/:id/archive", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_ARCHIVE), interviewHandler.ArchiveInterviewAppointment)
+	interviewGroup.PATCH("/:id/unarchive", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_ARCHIVE), interviewHandler.UnarchiveInterviewAppointment)
+	interviewGroup.GET("/:id/comments", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), interviewHandler.GetInterviewComments)
+	interviewGroup.POST("/:id/comment", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_COMMENT_CREATE), middleware.Idempotent, interviewHandler.AddInterviewComment)
+	interviewGroup.PATCH("/:id/comment/:commentId", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_COMMENT_EDIT), interviewHandler.UpdateInterviewComment)
+	interviewGroup.GET("/:id/comment/:commentId/revisions", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), interviewHandler.GetInterviewCommentRevisions)
+	interviewGroup.DELETE("/:id/comment/:commentId", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_COMMENT_DELETE), interviewHandler.DeleteInterviewComment)
+	interviewGroup.GET("/:id/activity", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), interviewHandler.GetInterviewActivity)
+	interviewGroup.GET("/:id/scorecards", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), scorecardHandler.GetScorecards)
+	interviewGroup.POST("/:id/scorecards", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_CONDUCT), scorecardHandler.SubmitScorecard)
+	interviewGroup.PUT("/:id/scorecards/:scorecardId", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_WRITE), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_CONDUCT), scorecardHandler.UpdateScorecard)
+
+	scorecardTemplateGroup := r.Group("/api/scorecard-templates")
+	scorecardTemplateGroup.GET("", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), scorecardHandler.GetScorecardTemplates)
+	scorecardTemplateGroup.GET("/:id", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), scorecardHandler.GetScorecardTemplate)
+	scorecardTemplateGroup.POST("", middleware.RequirePermission(constants.PERMISSION_SCORECARD_TEMPLATE_MANAGE), scorecardHandler.CreateScorecardTemplate)
+	scorecardTemplateGroup.PATCH("/:id/archive", middleware.RequirePermission(constants.PERMISSION_SCORECARD_TEMPLATE_MANAGE), scorecardHandler.ArchiveScorecardTemplate)
+
+	candidateGroup := r.Group("/api/candidates")
+	candidateGroup.GET("", middleware.APIKeyScope(constants.SCOPE_CANDIDATES_READ), middleware.RequirePermission(constants.PERMISSION_CANDIDATE_READ), candidateHandler.GetCandidates)
+	candidateGroup.GET("/:id", middleware.APIKeyScope(constants.SCOPE_CANDIDATES_READ), middleware.RequirePermission(constants.PERMISSION_CANDIDATE_READ), candidateHandler.GetCandidate)
+	candidateGroup.POST("", middleware.APIKeyScope(constants.SCOPE_CANDIDATES_WRITE), middleware.RequirePermission(constants.PERMISSION_CANDIDATE_MANAGE), candidateHandler.CreateCandidate)
+	candidateGroup.PATCH("/:id", middleware.APIKeyScope(constants.SCOPE_CANDIDATES_WRITE), middleware.RequirePermission(constants.PERMISSION_CANDIDATE_MANAGE), candidateHandler.UpdateCandidate)
 
 	authGroup := r.Group("/api/auth")
 	authGroup.POST("/login", authHandler.Login)
+	authGroup.POST("/refresh", authHandler.RefreshToken)
+	authGroup.POST("/logout", middleware.StaffMiddleware, authHandler.Logout)
+	authGroup.POST("/mfa/enroll", authHandler.EnrollMFA)
+	authGroup.POST("/mfa/verify", authHandler.VerifyMFA)
+	authGroup.POST("/forgot-password", authHandler.ForgotPassword)
+	authGroup.POST("/reset-password", authHandler.ResetPassword)
 	authGroup.POST("/staff", middleware.AdminMiddleware, authHandler.CreateStaff)
+	authGroup.GET("/oidc/login", authHandler.OIDCLogin)
+	authGroup.GET("/oidc/callback", authHandler.OIDCCallback)
+	authGroup.GET("/settings", middleware.RequirePermission(constants.PERMISSION_AUTH_SETTING_MANAGE), authHandler.GetAuthSetting)
+	authGroup.PATCH("/settings", middleware.RequirePermission(constants.PERMISSION_AUTH_SETTING_MANAGE), authHandler.UpdateAuthSetting)
+
+	userGroup := r.Group("/api/users")
+	userGroup.GET("", middleware.APIKeyScope(constants.SCOPE_USERS_READ), middleware.RequirePermission(constants.PERMISSION_USER_READ), userHandler.GetUsers)
+	userGroup.POST("/service-accounts", middleware.RequirePermission(constants.PERMISSION_USER_MANAGE), userHandler.CreateServiceAccount)
+	userGroup.GET("/:id", middleware.APIKeyScope(constants.SCOPE_USERS_READ), middleware.RequirePermission(constants.PERMISSION_USER_READ), userHandler.GetUser)
+	userGroup.PATCH("/:id", middleware.APIKeyScope(constants.SCOPE_USERS_WRITE), middleware.RequirePermission(constants.PERMISSION_USER_MANAGE), userHandler.UpdateUser)
+	userGroup.PATCH("/:id/role", middleware.RequirePermission(constants.PERMISSION_USER_MANAGE), userHandler.UpdateUserRole)
+	userGroup.PATCH("/:id/deactivate", middleware.APIKeyScope(constants.SCOPE_USERS_WRITE), middleware.RequirePermission(constants.PERMISSION_USER_MANAGE), userHandler.DeactivateUser)
+	userGroup.PATCH("/:id/reactivate", middleware.APIKeyScope(constants.SCOPE_USERS_WRITE), middleware.RequirePermission(constants.PERMISSION_USER_MANAGE), userHandler.ReactivateUser)
+	userGroup.PATCH("/:id/unlock", middleware.APIKeyScope(constants.SCOPE_USERS_WRITE), middleware.RequirePermission(constants.PERMISSION_USER_MANAGE), userHandler.UnlockUser)
+	userGroup.GET("/:id/interviews", middleware.APIKeyScope(constants.SCOPE_INTERVIEWS_READ), middleware.RequirePermission(constants.PERMISSION_INTERVIEW_READ), interviewHandler.GetInterviewerAppointments)
+	userGroup.GET("/:id/api-keys", middleware.RequirePermission(constants.PERMISSION_USER_MANAGE), apiKeyHandler.GetAPIKeys)
+	userGroup.POST("/:id/api-keys", middleware.RequirePermission(constants.PERMISSION_USER_MANAGE), apiKeyHandler.CreateAPIKey)
+	userGroup.PATCH("/:id/api-keys/:keyId/revoke", middleware.RequirePermission(constants.PERMISSION_USER_MANAGE), apiKeyHandler.RevokeAPIKey)
+
+	r.GET("/api/audit", middleware.RequirePermission(constants.PERMISSION_AUDIT_READ), auditHandler.GetAuditEvents)
+
+	meGroup := r.Group("/api/me")
+	meGroup.GET("", middleware.StaffMiddleware, userHandler.GetMe)
+	meGroup.PATCH("", middleware.StaffMiddleware, userHandler.UpdateMe)
+	meGroup.POST("/password", middleware.StaffMiddleware, userHandler.ChangePassword)
+	meGroup.POST("/mfa/enroll", middleware.StaffMiddleware, userHandler.EnrollMFA)
+	meGroup.POST("/mfa/confirm", middleware.StaffMiddleware, userHandler.ConfirmMFA)
+	meGroup.POST("/mfa/disable", middleware.StaffMiddleware, userHandler.DisableMFA)
+	meGroup.GET("/interviews", middleware.StaffMiddleware, interviewHandler.GetInterviewerAppointments)
+	meGroup.GET("/api-keys", middleware.StaffMiddleware, apiKeyHandler.GetAPIKeys)
+	meGroup.POST("/api-keys", middleware.StaffMiddleware, apiKeyHandler.CreateAPIKey)
+	meGroup.PATCH("/api-keys/:keyId/revoke", middleware.StaffMiddleware, apiKeyHandler.RevokeAPIKey)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", config.Get().HTTPServer.Port),
@@ -82,10 +237,17 @@ func main() {
 		}
 	}()
 
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	// A zero purge interval turns the cleanup off, like a zero retention.
+	if config.Get().Interview.ArchiveRetention > 0 && config.Get().Interview.ArchivePurgeInterval > 0 {
+		go purgeArchivedInterviews(jobCtx, interviewService, config.Get().Interview.ArchivePurgeInterval)
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutting down server...")
+	stopJobs()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := srv.Shutdown(ctx); err != nil {
@@ -93,3 +255,25 @@ func main() {
 	}
 	log.Println("Server exiting")
 }
+
+// purgeArchivedInterviews deletes expired archived appointments every
+// interval until ctx is cancelled.
+func purgeArchivedInterviews(ctx context.Context, interviewService ports.InterviewService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := interviewService.PurgeArchivedInterviewAppointments(ctx)
+			if err != nil {
+				log.Printf("failed to purge archived interview appointments: %s\n", err.Error())
+				continue
+			}
+			if deleted > 0 {
+				log.Printf("purged %d archived interview appointments\n", deleted)
+			}
+		}
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
@@ -11,6 +12,9 @@ type config struct {
 	Mongo      mongo
 	HTTPServer httpServer
 	Auth       auth
+	Mail       mail
+	OIDC       oidc
+	Interview  interview
 }
 
 type mongo struct {
@@ -19,12 +23,57 @@ type mongo struct {
 }
 
 type httpServer struct {
-	Port int `envconfig:"PORT" default:"8080"`
+	Port              int           `envconfig:"PORT" default:"8080"`
+	TrustedProxies    []string      `envconfig:"TRUSTED_PROXIES"`
+	IdempotencyKeyTTL time.Duration `envconfig:"IDEMPOTENCY_KEY_TTL" default:"24h"`
 }
 
 type auth struct {
-	BcryptCost int    `envconfig:"BCRYPT_COST"`
-	JwtSecret  string `envconfig:"JWT_SECRET"`
+	BcryptCost            int           `envconfig:"BCRYPT_COST"`
+	JwtSecret             string        `envconfig:"JWT_SECRET"`
+	JwtKeysDir            string        `envconfig:"JWT_KEYS_DIR"`
+	JwtActiveKid          string        `envconfig:"JWT_ACTIVE_KID"`
+	AccessTokenTTL        time.Duration `envconfig:"ACCESS_TOKEN_TTL" default:"60m"`
+	RefreshTokenTTL       time.Duration `envconfig:"REFRESH_TOKEN_TTL" default:"720h"`
+	PasswordResetTTL      time.Duration `envconfig:"PASSWORD_RESET_TTL" default:"30m"`
+	PasswordResetURL      string        `envconfig:"PASSWORD_RESET_URL"`
+	LoginMaxAttempts      int           `envconfig:"LOGIN_MAX_ATTEMPTS" default:"5"`
+	LoginMaxAttemptsPerIP int           `envconfig:"LOGIN_MAX_ATTEMPTS_PER_IP" default:"20"`
+	LoginAttemptWindow    time.Duration `envconfig:"LOGIN_ATTEMPT_WINDOW" default:"15m"`
+	LoginLockoutDuration  time.Duration `envconfig:"LOGIN_LOCKOUT_DURATION" default:"15m"`
+	LoginBaseDelay        time.Duration `envconfig:"LOGIN_BASE_DELAY" default:"1s"`
+	LoginMaxDelay         time.Duration `envconfig:"LOGIN_MAX_DELAY" default:"30s"`
+	MFAIssuer             string        `envconfig:"MFA_ISSUER" default:"robinhood-assignment"`
+	MFAPendingTTL         time.Duration `envconfig:"MFA_PENDING_TTL" default:"5m"`
+	MFARequiredForAdmin   bool          `envconfig:"MFA_REQUIRED_FOR_ADMIN"`
+}
+
+type mail struct {
+	Driver       string `envconfig:"MAIL_DRIVER" default:"log"`
+	From         string `envconfig:"MAIL_FROM" default:"no-reply@localhost"`
+	LogDir       string `envconfig:"MAIL_LOG_DIR"`
+	SMTPHost     string `envconfig:"SMTP_HOST"`
+	SMTPPort     int    `envconfig:"SMTP_PORT" default:"587"`
+	SMTPUsername string `envconfig:"SMTP_USERNAME"`
+	SMTPPassword string `envconfig:"SMTP_PASSWORD"`
+}
+
+type oidc struct {
+	Issuer       string            `envconfig:"OIDC_ISSUER"`
+	ClientID     string            `envconfig:"OIDC_CLIENT_ID"`
+	ClientSecret string            `envconfig:"OIDC_CLIENT_SECRET"`
+	RedirectURL  string            `envconfig:"OIDC_REDIRECT_URL"`
+	Scopes       []string          `envconfig:"OIDC_SCOPES" default:"openid,profile,email"`
+	GroupsClaim  string            `envconfig:"OIDC_GROUPS_CLAIM" default:"groups"`
+	RoleMapping  map[string]string `envconfig:"OIDC_ROLE_MAPPING"`
+	DefaultRole  string            `envconfig:"OIDC_DEFAULT_ROLE"`
+	StateTTL     time.Duration     `envconfig:"OIDC_STATE_TTL" default:"10m"`
+}
+
+type interview struct {
+	ScorecardLockWindow  time.Duration `envconfig:"SCORECARD_LOCK_WINDOW" default:"24h"`
+	ArchiveRetention     time.Duration `envconfig:"ARCHIVE_RETENTION" default:"2160h"`
+	ArchivePurgeInterval time.Duration `envconfig:"ARCHIVE_PURGE_INTERVAL" default:"1h"`
 }
 
 var cfg config
@@ -34,6 +83,9 @@ func New() {
 	if err := envconfig.Process("", &cfg); err != nil {
 		log.Fatalf("read env error : %s", err.Error())
 	}
+	if cfg.Interview.ArchivePurgeInterval < 0 {
+		log.Fatalf("read env error : ARCHIVE_PURGE_INTERVAL must not be negative")
+	}
 }
 
 func Get() config {
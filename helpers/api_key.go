@@ -0,0 +1,28 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+const APIKeyPrefix = "rhk_"
+
+// GenerateAPIKey returns a key of the form rhk_<id>_<secret>. The prefix
+// (rhk_<id>) is safe to display and identifies the key in listings.
+func GenerateAPIKey() (prefix string, key string, err error) {
+	id := make([]byte, 4)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", err
+	}
+	secret, err := GenerateRandomToken()
+	if err != nil {
+		return "", "", err
+	}
+	prefix = APIKeyPrefix + hex.EncodeToString(id)
+	return prefix, prefix + "_" + secret, nil
+}
+
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
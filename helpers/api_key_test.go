@@ -0,0 +1,22 @@
+package helpers_test
+
+import (
+	"robinhood-assignment/helpers"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	prefix, key, err := helpers.GenerateAPIKey()
+	assert.NoError(t, err)
+	assert.True(t, helpers.IsAPIKey(key))
+	assert.True(t, strings.HasPrefix(key, prefix+"_"))
+	assert.Len(t, prefix, len(helpers.APIKeyPrefix)+8)
+
+	_, other, err := helpers.GenerateAPIKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+	assert.False(t, helpers.IsAPIKey("eyJhbGciOiJIUzI1NiJ9.e30.sig"))
+}
@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// ListCursor points at an item of a list sorted by Sort and then by id. A
+// cursor with Before set continues the list backwards from the item.
+type ListCursor struct {
+	Sort   string `json:"s"`
+	Desc   bool   `json:"d,omitempty"`
+	Value  string `json:"v"`
+	ID     string `json:"id"`
+	Before bool   `json:"b,omitempty"`
+}
+
+// Encode returns the cursor as an opaque string.
+func (c ListCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeListCursor returns the cursor encoded by ListCursor.Encode.
+func DecodeListCursor(cursor string) (ListCursor, error) {
+	c := ListCursor{}
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return c, errInvalidCursor
+	}
+	if err := json.Unmarshal(b, &c); err != nil || c.Sort == "" || c.ID == "" {
+		return ListCursor{}, errInvalidCursor
+	}
+	return c, nil
+}
+
+// NewCreatedAtCursor returns a cursor that continues a list sorted by
+// creation time and then by id after the given item.
+func NewCreatedAtCursor(createdAt time.Time, id string, desc bool) string {
+	return ListCursor{Sort: "createdAt", Desc: desc, Value: createdAt.UTC().Format(time.RFC3339Nano), ID: id}.Encode()
+}
+
+// DecodeCreatedAtCursor returns the creation time and id of a cursor made by
+// NewCreatedAtCursor for the same order.
+func DecodeCreatedAtCursor(cursor string, desc bool) (time.Time, string, error) {
+	c, err := DecodeListCursor(cursor)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	if c.Sort != "createdAt" || c.Desc != desc || c.Before {
+		return time.Time{}, "", errInvalidCursor
+	}
+	t, err := time.Parse(time.RFC3339Nano, c.Value)
+	if err != nil {
+		return time.Time{}, "", errInvalidCursor
+	}
+	return t, c.ID, nil
+}
@@ -0,0 +1,38 @@
+package helpers_test
+
+import (
+	"robinhood-assignment/helpers"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCursor(t *testing.T) {
+	cursor := helpers.ListCursor{Sort: "title", Value: "Backend onsite", ID: "6476f457e64589e868aac981", Before: true}
+	got, err := helpers.DecodeListCursor(cursor.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, got)
+
+	for _, invalid := range []string{"not base64!", "e30", "eyJzIjoidGl0bGUifQ"} {
+		_, err := helpers.DecodeListCursor(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestCreatedAtCursor(t *testing.T) {
+	createdAt := time.Date(2023, 7, 1, 10, 30, 0, 123000000, time.UTC)
+	cursor := helpers.NewCreatedAtCursor(createdAt, "6476f457e64589e868aac981", true)
+	gotTime, gotID, err := helpers.DecodeCreatedAtCursor(cursor, true)
+	assert.NoError(t, err)
+	assert.Equal(t, createdAt, gotTime)
+	assert.Equal(t, "6476f457e64589e868aac981", gotID)
+
+	_, _, err = helpers.DecodeCreatedAtCursor(cursor, false)
+	assert.Error(t, err)
+	title := helpers.ListCursor{Sort: "title", Value: "Backend onsite", ID: "6476f457e64589e868aac981"}.Encode()
+	for _, invalid := range []string{"not base64!", "MTIz", title} {
+		_, _, err := helpers.DecodeCreatedAtCursor(invalid, false)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -8,6 +8,7 @@ import (
 type customError struct {
 	StatusCode int
 	Message    string
+	Details    interface{}
 }
 
 func NewCustomError(code int, message string) error {
@@ -17,6 +18,16 @@ func NewCustomError(code int, message string) error {
 	}
 }
 
+// NewCustomErrorWithDetails is like NewCustomError but also returns details
+// to the client, e.g. the records that caused a conflict.
+func NewCustomErrorWithDetails(code int, message string, details interface{}) error {
+	return customError{
+		StatusCode: code,
+		Message:    message,
+		Details:    details,
+	}
+}
+
 func (e customError) Error() string {
 	return e.Message
 }
@@ -30,6 +41,7 @@ func ErrorHandler(err error) *dto.ErrorResponse {
 	if e, ok := err.(customError); ok {
 		errRes.StatusCode = e.Code()
 		errRes.Error = e.Error()
+		errRes.Details = e.Details
 		return &errRes
 	}
 	errRes.StatusCode = http.StatusInternalServerError
@@ -0,0 +1,53 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// ETag returns the strong entity tag of a document at version.
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ContentETag returns the strong entity tag of a representation that depends
+// on more than the document version, such as joined or per-viewer data. The
+// parts are hashed after the version, so ETagVersion still reads the version.
+func ContentETag(version int64, parts ...string) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return `"` + strconv.FormatInt(version, 10) + "-" + hex.EncodeToString(hash.Sum(nil)[:8]) + `"`
+}
+
+// ETagVersion returns the version of an entity tag made by ETag or
+// ContentETag. Weak tags and tags of another form are not versions.
+func ETagVersion(etag string) (int64, bool) {
+	etag = strings.TrimSpace(etag)
+	if len(etag) < 3 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+		return 0, false
+	}
+	value, _, _ := strings.Cut(etag[1:len(etag)-1], "-")
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version < 0 {
+		return 0, false
+	}
+	return version, true
+}
+
+// ETagMatch reports whether an If-None-Match header lists etag. Tags are
+// compared weakly, so W/"1" matches "1", and "*" matches any tag.
+func ETagMatch(header string, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
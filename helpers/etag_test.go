@@ -0,0 +1,37 @@
+package helpers_test
+
+import (
+	"robinhood-assignment/helpers"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestETag(t *testing.T) {
+	etag := helpers.ETag(3)
+	assert.Equal(t, `"3"`, etag)
+	version, ok := helpers.ETagVersion(etag)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), version)
+
+	etag = helpers.ContentETag(3, "a", "b")
+	assert.Regexp(t, `^"3-[0-9a-f]{16}"$`, etag)
+	assert.Equal(t, etag, helpers.ContentETag(3, "a", "b"))
+	assert.NotEqual(t, etag, helpers.ContentETag(3, "ab"))
+	version, ok = helpers.ETagVersion(etag)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3), version)
+
+	for _, invalid := range []string{"", "3", `W/"3"`, `"abc"`, `"-1"`, `""`, `"x-1"`} {
+		_, ok := helpers.ETagVersion(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestETagMatch(t *testing.T) {
+	assert.True(t, helpers.ETagMatch(`"3"`, `"3"`))
+	assert.True(t, helpers.ETagMatch(`"1", W/"3"`, `"3"`))
+	assert.True(t, helpers.ETagMatch("*", `"3"`))
+	assert.False(t, helpers.ETagMatch(`"2"`, `"3"`))
+	assert.False(t, helpers.ETagMatch("", `"3"`))
+}
@@ -0,0 +1,99 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"robinhood-assignment/config"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"strings"
+	"time"
+)
+
+// NewMailer picks the delivery backend from MAIL_DRIVER: "smtp" sends through
+// the configured relay, "log" writes messages to MAIL_LOG_DIR or to the
+// application log so local setups work without a mail server.
+func NewMailer() (ports.Mailer, error) {
+	cfg := config.Get().Mail
+	switch cfg.Driver {
+	case "smtp":
+		if cfg.SMTPHost == "" {
+			return nil, fmt.Errorf("SMTP_HOST is required when MAIL_DRIVER is smtp")
+		}
+		return NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From), nil
+	case "log", "":
+		return NewLogMailer(cfg.LogDir, cfg.From), nil
+	default:
+		return nil, fmt.Errorf("unsupported mail driver %q", cfg.Driver)
+	}
+}
+
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPMailer(host string, port int, username, password, from string) ports.Mailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &smtpMailer{
+		addr: fmt.Sprintf("%s:%d", host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (m smtpMailer) Send(ctx context.Context, mail *domains.Mail) error {
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{mail.To}, buildMessage(m.from, mail))
+}
+
+type logMailer struct {
+	dir  string
+	from string
+}
+
+func NewLogMailer(dir, from string) ports.Mailer {
+	return &logMailer{dir: dir, from: from}
+}
+
+func (m logMailer) Send(ctx context.Context, mail *domains.Mail) error {
+	msg := buildMessage(m.from, mail)
+	if m.dir == "" {
+		log.Printf("mail to %s:\n%s", mail.To, msg)
+		return nil
+	}
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), sanitizeFileName(mail.To))
+	return os.WriteFile(filepath.Join(m.dir, name), msg, 0o600)
+}
+
+func buildMessage(from string, mail *domains.Mail) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mail.Subject)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=\"UTF-8\"\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+	return buf.Bytes()
+}
+
+func sanitizeFileName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == os.PathSeparator {
+			return '_'
+		}
+		return r
+	}, s)
+}
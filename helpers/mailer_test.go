@@ -0,0 +1,61 @@
+package helpers_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogMailer(t *testing.T) {
+	t.Run("write mail to directory", func(t *testing.T) {
+		dir := t.TempDir()
+		mailer := helpers.NewLogMailer(dir, "no-reply@example.com")
+		err := mailer.Send(context.Background(), &domains.Mail{
+			To:      "samart.ph.work@gmail.com",
+			Subject: "Reset your password",
+			Body:    "Hi Samart,\n\ntoken",
+		})
+		require.NoError(t, err)
+		files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.Len(t, files, 1)
+		data, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		msg := string(data)
+		assert.Contains(t, msg, "From: no-reply@example.com\r\n")
+		assert.Contains(t, msg, "To: samart.ph.work@gmail.com\r\n")
+		assert.Contains(t, msg, "Subject: Reset your password\r\n")
+		assert.Contains(t, msg, "\r\n\r\nHi Samart,\r\n\r\ntoken")
+	})
+}
+
+func TestNewMailer(t *testing.T) {
+	t.Run("default to log mailer", func(t *testing.T) {
+		t.Setenv("MAIL_DRIVER", "log")
+		config.New()
+		mailer, err := helpers.NewMailer()
+		assert.NoError(t, err)
+		assert.NotNil(t, mailer)
+	})
+	t.Run("error when smtp host is missing", func(t *testing.T) {
+		t.Setenv("MAIL_DRIVER", "smtp")
+		t.Setenv("SMTP_HOST", "")
+		config.New()
+		mailer, err := helpers.NewMailer()
+		assert.Error(t, err)
+		assert.Nil(t, mailer)
+	})
+	t.Run("error when driver is unknown", func(t *testing.T) {
+		t.Setenv("MAIL_DRIVER", "pigeon")
+		config.New()
+		mailer, err := helpers.NewMailer()
+		assert.Error(t, err)
+		assert.Nil(t, mailer)
+	})
+}
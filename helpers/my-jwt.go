@@ -1,23 +1,89 @@
 package helpers
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
 	"robinhood-assignment/config"
 	"robinhood-assignment/internal/core/domains"
 	"robinhood-assignment/internal/core/ports"
+	"sort"
+	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+type signingKey struct {
+	kid        string
+	method     jwt.SigningMethod
+	privateKey crypto.PrivateKey
+	publicKey  crypto.PublicKey
+}
+
 type myJWT struct {
+	keys   map[string]signingKey
+	active *signingKey
 }
 
-func NewMyJWT() ports.MyJWT {
-	return &myJWT{}
+// NewMyJWT loads every PEM file in JWT_KEYS_DIR into a key ring keyed by file
+// name. Private keys can sign and verify, public keys only verify, which lets
+// a retired key keep validating tokens until they expire. Without a key
+// directory tokens fall back to HS256 with JWT_SECRET.
+func NewMyJWT() (ports.MyJWT, error) {
+	dir := config.Get().Auth.JwtKeysDir
+	if dir == "" {
+		return &myJWT{}, nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	keys := map[string]signingKey{}
+	signers := []string{}
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		key, err := parseSigningKey(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("load jwt key %s: %w", kid, err)
+		}
+		keys[kid] = *key
+		if key.privateKey != nil {
+			signers = append(signers, kid)
+		}
+	}
+	activeKid := config.Get().Auth.JwtActiveKid
+	if activeKid == "" {
+		if len(signers) != 1 {
+			return nil, errors.New("JWT_ACTIVE_KID is required when the key ring does not have exactly one private key")
+		}
+		activeKid = signers[0]
+	}
+	active, ok := keys[activeKid]
+	if !ok || active.privateKey == nil {
+		return nil, fmt.Errorf("active jwt key %s has no private key", activeKid)
+	}
+	return &myJWT{keys: keys, active: &active}, nil
 }
 
-func (j myJWT) NewWithClaims(method jwt.SigningMethod, claims domains.Claims, opts ...jwt.TokenOption) *jwt.Token {
-	token := jwt.NewWithClaims(method, claims, opts...)
-	return token
+func (j myJWT) SignClaims(claims domains.Claims) (string, error) {
+	if j.active == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(config.Get().Auth.JwtSecret))
+	}
+	token := jwt.NewWithClaims(j.active.method, claims)
+	token.Header["kid"] = j.active.kid
+	return token.SignedString(j.active.privateKey)
 }
 
 func (j myJWT) ParseWithClaims(tokenString string, claims *domains.Claims, keyFunc jwt.Keyfunc, opts ...jwt.ParserOption) (*jwt.Token, error) {
@@ -26,5 +92,64 @@ func (j myJWT) ParseWithClaims(tokenString string, claims *domains.Claims, keyFu
 }
 
 func (j myJWT) ParseToken(token *jwt.Token) (interface{}, error) {
-	return []byte(config.Get().Auth.JwtSecret), nil
+	if j.active == nil {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(config.Get().Auth.JwtSecret), nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	key, ok := j.keys[kid]
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+	return key.publicKey, nil
+}
+
+func (j myJWT) PublicKeys() []domains.JSONWebKey {
+	kids := make([]string, 0, len(j.keys))
+	for kid := range j.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+	jwks := make([]domains.JSONWebKey, 0, len(kids))
+	for _, kid := range kids {
+		key := j.keys[kid]
+		jwk := domains.JSONWebKey{
+			Kid: key.kid,
+			Use: "sig",
+			Alg: key.method.Alg(),
+		}
+		switch pub := key.publicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		jwks = append(jwks, jwk)
+	}
+	return jwks
+}
+
+func parseSigningKey(kid string, data []byte) (*signingKey, error) {
+	if key, err := jwt.ParseRSAPrivateKeyFromPEM(data); err == nil {
+		return &signingKey{kid, jwt.SigningMethodRS256, key, &key.PublicKey}, nil
+	}
+	if key, err := jwt.ParseEdPrivateKeyFromPEM(data); err == nil {
+		return &signingKey{kid, jwt.SigningMethodEdDSA, key, key.(ed25519.PrivateKey).Public()}, nil
+	}
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		return &signingKey{kid, jwt.SigningMethodRS256, nil, key}, nil
+	}
+	if key, err := jwt.ParseEdPublicKeyFromPEM(data); err == nil {
+		return &signingKey{kid, jwt.SigningMethodEdDSA, nil, key}, nil
+	}
+	return nil, errors.New("unsupported key, expected an RSA or Ed25519 PEM key")
 }
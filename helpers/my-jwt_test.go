@@ -0,0 +1,135 @@
+package helpers_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(path, data, 0600))
+}
+
+func writeRSAKey(t *testing.T, dir string, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, kid+".pem"), "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key))
+	return key
+}
+
+func writeEdKey(t *testing.T, dir string, kid string) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, kid+".pem"), "PRIVATE KEY", der)
+	return key
+}
+
+func testClaims() domains.Claims {
+	return domains.Claims{
+		UserID:    "64aaf0156999249a602ff55f",
+		Role:      "STAFF",
+		SessionID: "64aaf0156999249a602ff560",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func TestMyJWT(t *testing.T) {
+	t.Run("sign and verify with HS256 when no key directory", func(t *testing.T) {
+		t.Setenv("JWT_SECRET", "mock-jwt-secret")
+		t.Setenv("JWT_KEYS_DIR", "")
+		config.New()
+		myJWT, err := helpers.NewMyJWT()
+		require.NoError(t, err)
+		tokenString, err := myJWT.SignClaims(testClaims())
+		require.NoError(t, err)
+		claims := &domains.Claims{}
+		token, err := myJWT.ParseWithClaims(tokenString, claims, myJWT.ParseToken)
+		assert.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodHS256, token.Method)
+		assert.Equal(t, "STAFF", claims.Role)
+		assert.Empty(t, myJWT.PublicKeys())
+	})
+	t.Run("sign with active key and keep verifying retired key", func(t *testing.T) {
+		dir := t.TempDir()
+		oldKey := writeRSAKey(t, dir, "2023-01")
+		writeEdKey(t, dir, "2023-07")
+		t.Setenv("JWT_KEYS_DIR", dir)
+		t.Setenv("JWT_ACTIVE_KID", "2023-01")
+		config.New()
+		oldJWT, err := helpers.NewMyJWT()
+		require.NoError(t, err)
+		oldToken, err := oldJWT.SignClaims(testClaims())
+		require.NoError(t, err)
+
+		der, err := x509.MarshalPKIXPublicKey(&oldKey.PublicKey)
+		require.NoError(t, err)
+		writePEM(t, filepath.Join(dir, "2023-01.pem"), "PUBLIC KEY", der)
+		t.Setenv("JWT_ACTIVE_KID", "2023-07")
+		config.New()
+		myJWT, err := helpers.NewMyJWT()
+		require.NoError(t, err)
+		newToken, err := myJWT.SignClaims(testClaims())
+		require.NoError(t, err)
+
+		token, err := myJWT.ParseWithClaims(oldToken, &domains.Claims{}, myJWT.ParseToken)
+		assert.NoError(t, err)
+		assert.Equal(t, "2023-01", token.Header["kid"])
+		assert.Equal(t, jwt.SigningMethodRS256, token.Method)
+		token, err = myJWT.ParseWithClaims(newToken, &domains.Claims{}, myJWT.ParseToken)
+		assert.NoError(t, err)
+		assert.Equal(t, "2023-07", token.Header["kid"])
+		assert.Equal(t, jwt.SigningMethodEdDSA, token.Method)
+
+		keys := myJWT.PublicKeys()
+		require.Len(t, keys, 2)
+		assert.Equal(t, "RSA", keys[0].Kty)
+		assert.Equal(t, "2023-01", keys[0].Kid)
+		assert.Equal(t, "AQAB", keys[0].E)
+		assert.Equal(t, "OKP", keys[1].Kty)
+		assert.Equal(t, "Ed25519", keys[1].Crv)
+	})
+	t.Run("reject token with unknown kid", func(t *testing.T) {
+		dir := t.TempDir()
+		writeEdKey(t, dir, "2023-07")
+		t.Setenv("JWT_KEYS_DIR", dir)
+		t.Setenv("JWT_ACTIVE_KID", "")
+		config.New()
+		myJWT, err := helpers.NewMyJWT()
+		require.NoError(t, err)
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+		tokenString, _ := token.SignedString([]byte("mock-jwt-secret"))
+		_, err = myJWT.ParseWithClaims(tokenString, &domains.Claims{}, myJWT.ParseToken)
+		assert.Error(t, err)
+	})
+	t.Run("error when active key is not a private key", func(t *testing.T) {
+		dir := t.TempDir()
+		key := writeRSAKey(t, dir, "2023-01")
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		require.NoError(t, err)
+		writePEM(t, filepath.Join(dir, "2023-01.pem"), "PUBLIC KEY", der)
+		t.Setenv("JWT_KEYS_DIR", dir)
+		t.Setenv("JWT_ACTIVE_KID", "2023-01")
+		config.New()
+		myJWT, err := helpers.NewMyJWT()
+		assert.Nil(t, myJWT)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,319 @@
+package helpers
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"robinhood-assignment/config"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// NewOIDCProvider builds the single sign-on client from the OIDC_* settings.
+// It returns nil when OIDC_ISSUER is empty, which leaves OIDC login disabled.
+func NewOIDCProvider() (ports.OIDCProvider, error) {
+	cfg := config.Get().OIDC
+	if cfg.Issuer == "" {
+		return nil, nil
+	}
+	if cfg.ClientID == "" || cfg.RedirectURL == "" {
+		return nil, errors.New("OIDC_CLIENT_ID and OIDC_REDIRECT_URL are required when OIDC_ISSUER is set")
+	}
+	return NewOIDCClient(cfg.Issuer, cfg.ClientID, cfg.ClientSecret, cfg.RedirectURL, cfg.Scopes, cfg.GroupsClaim), nil
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcClient struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	groupsClaim  string
+	httpClient   *http.Client
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]crypto.PublicKey
+}
+
+// NewOIDCClient talks to the provider at issuer. The discovery document and
+// signing keys are fetched on first use, so the application can start while
+// the provider is unreachable.
+func NewOIDCClient(issuer, clientID, clientSecret, redirectURL string, scopes []string, groupsClaim string) ports.OIDCProvider {
+	return &oidcClient{
+		issuer:       issuer,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		scopes:       scopes,
+		groupsClaim:  groupsClaim,
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// PKCEChallenge derives the S256 code challenge sent with the authorization
+// request from the verifier kept on the server.
+func PKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (c *oidcClient) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	d, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", c.clientID)
+	params.Set("redirect_uri", c.redirectURL)
+	params.Set("scope", strings.Join(c.scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", codeChallenge)
+	params.Set("code_challenge_method", "S256")
+	sep := "?"
+	if strings.Contains(d.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return d.AuthorizationEndpoint + sep + params.Encode(), nil
+}
+
+func (c *oidcClient) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domains.OIDCIdentity, error) {
+	d, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.redirectURL)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", c.clientID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(c.clientID), url.QueryEscape(c.clientSecret))
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oidc token endpoint returned %d", res.StatusCode)
+	}
+	body := struct {
+		IDToken string `json:"id_token"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	if body.IDToken == "" {
+		return nil, errors.New("oidc token response has no id_token")
+	}
+	return c.verifyIDToken(ctx, body.IDToken, nonce)
+}
+
+func (c *oidcClient) verifyIDToken(ctx context.Context, rawIDToken, nonce string) (*domains.OIDCIdentity, error) {
+	claims := jwt.MapClaims{}
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return c.publicKey(ctx, kid)
+	}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, keyFunc,
+		jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+		jwt.WithIssuer(c.issuer),
+		jwt.WithAudience(c.clientID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if exp, _ := claims.GetExpirationTime(); exp == nil {
+		return nil, errors.New("oidc id token has no expiry")
+	}
+	tokenNonce, _ := claims["nonce"].(string)
+	if subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) != 1 {
+		return nil, errors.New("oidc id token nonce mismatch")
+	}
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return nil, errors.New("oidc id token has no subject")
+	}
+	identity := &domains.OIDCIdentity{Subject: subject}
+	identity.Email, _ = claims["email"].(string)
+	identity.Name, _ = claims["name"].(string)
+	identity.Username, _ = claims["preferred_username"].(string)
+	identity.Picture, _ = claims["picture"].(string)
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		identity.EmailVerified = v
+	case string:
+		identity.EmailVerified = v == "true"
+	}
+	switch v := claims[c.groupsClaim].(type) {
+	case []interface{}:
+		for _, g := range v {
+			if s, ok := g.(string); ok {
+				identity.Groups = append(identity.Groups, s)
+			}
+		}
+	case string:
+		identity.Groups = []string{v}
+	}
+	return identity, nil
+}
+
+func (c *oidcClient) discover(ctx context.Context) (*oidcDiscovery, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.discovery != nil {
+		return c.discovery, nil
+	}
+	d := &oidcDiscovery{}
+	if err := c.getJSON(ctx, strings.TrimSuffix(c.issuer, "/")+"/.well-known/openid-configuration", d); err != nil {
+		return nil, err
+	}
+	if d.Issuer != c.issuer {
+		return nil, fmt.Errorf("oidc discovery issuer %q does not match %q", d.Issuer, c.issuer)
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, errors.New("oidc discovery document is incomplete")
+	}
+	c.discovery = d
+	return d, nil
+}
+
+// publicKey looks the signing key up by kid and refetches the key set once
+// when it is unknown, which picks up keys the provider rotated in.
+func (c *oidcClient) publicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	d, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+	jwks := struct {
+		Keys []oidcJWK `json:"keys"`
+	}{}
+	if err := c.getJSON(ctx, d.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+	keys := map[string]crypto.PublicKey{}
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	c.keys = keys
+	key, ok := keys[kid]
+	if !ok {
+		return nil, errors.New("unknown oidc signing key")
+	}
+	return key, nil
+}
+
+func (c *oidcClient) getJSON(ctx context.Context, endpoint string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned %d", endpoint, res.StatusCode)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+type oidcJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k oidcJWK) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
@@ -0,0 +1,136 @@
+package helpers_test
+
+import (
+	"context"
+	"net/url"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/helpers/oidctest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const redirectURL = "http://localhost:8080/api/auth/oidc/callback"
+
+func TestOIDCClient(t *testing.T) {
+	ctx := context.Background()
+	verifier := "verifier-0123456789-0123456789-0123456789"
+	t.Run("authorization code flow success", func(t *testing.T) {
+		idp := oidctest.NewServer("client-id", "client-secret")
+		defer idp.Close()
+		idp.Claims = map[string]interface{}{
+			"sub":                "idp-user-1",
+			"email":              "samart.ph.work@gmail.com",
+			"email_verified":     true,
+			"name":               "Samart",
+			"preferred_username": "samart",
+			"groups":             []string{"engineering", "interview-admins"},
+		}
+		client := helpers.NewOIDCClient(idp.Issuer(), idp.ClientID, idp.ClientSecret, redirectURL, []string{"openid", "email"}, "groups")
+		authURL, err := client.AuthCodeURL(ctx, "state-1", "nonce-1", helpers.PKCEChallenge(verifier))
+		require.NoError(t, err)
+		u, _ := url.Parse(authURL)
+		assert.Equal(t, "S256", u.Query().Get("code_challenge_method"))
+		assert.Equal(t, "openid email", u.Query().Get("scope"))
+		assert.Equal(t, redirectURL, u.Query().Get("redirect_uri"))
+
+		code, state, err := idp.Authorize(authURL)
+		require.NoError(t, err)
+		assert.Equal(t, "state-1", state)
+		identity, err := client.Exchange(ctx, code, verifier, "nonce-1")
+		require.NoError(t, err)
+		assert.Equal(t, "idp-user-1", identity.Subject)
+		assert.Equal(t, "samart.ph.work@gmail.com", identity.Email)
+		assert.True(t, identity.EmailVerified)
+		assert.Equal(t, "Samart", identity.Name)
+		assert.Equal(t, "samart", identity.Username)
+		assert.Equal(t, []string{"engineering", "interview-admins"}, identity.Groups)
+	})
+	t.Run("exchange error when code verifier does not match", func(t *testing.T) {
+		idp := oidctest.NewServer("client-id", "client-secret")
+		defer idp.Close()
+		client := helpers.NewOIDCClient(idp.Issuer(), idp.ClientID, idp.ClientSecret, redirectURL, []string{"openid"}, "groups")
+		authURL, err := client.AuthCodeURL(ctx, "state-1", "nonce-1", helpers.PKCEChallenge(verifier))
+		require.NoError(t, err)
+		code, _, err := idp.Authorize(authURL)
+		require.NoError(t, err)
+		_, err = client.Exchange(ctx, code, "another-verifier", "nonce-1")
+		assert.Error(t, err)
+	})
+	t.Run("exchange error when code is reused", func(t *testing.T) {
+		idp := oidctest.NewServer("client-id", "client-secret")
+		defer idp.Close()
+		client := helpers.NewOIDCClient(idp.Issuer(), idp.ClientID, idp.ClientSecret, redirectURL, []string{"openid"}, "groups")
+		authURL, err := client.AuthCodeURL(ctx, "state-1", "nonce-1", helpers.PKCEChallenge(verifier))
+		require.NoError(t, err)
+		code, _, err := idp.Authorize(authURL)
+		require.NoError(t, err)
+		_, err = client.Exchange(ctx, code, verifier, "nonce-1")
+		require.NoError(t, err)
+		_, err = client.Exchange(ctx, code, verifier, "nonce-1")
+		assert.Error(t, err)
+	})
+	t.Run("exchange error when nonce does not match", func(t *testing.T) {
+		idp := oidctest.NewServer("client-id", "client-secret")
+		defer idp.Close()
+		client := helpers.NewOIDCClient(idp.Issuer(), idp.ClientID, idp.ClientSecret, redirectURL, []string{"openid"}, "groups")
+		authURL, err := client.AuthCodeURL(ctx, "state-1", "nonce-1", helpers.PKCEChallenge(verifier))
+		require.NoError(t, err)
+		code, _, err := idp.Authorize(authURL)
+		require.NoError(t, err)
+		_, err = client.Exchange(ctx, code, verifier, "nonce-2")
+		assert.Error(t, err)
+	})
+	t.Run("exchange error when id token is expired", func(t *testing.T) {
+		idp := oidctest.NewServer("client-id", "client-secret")
+		defer idp.Close()
+		idp.Claims = map[string]interface{}{"sub": "idp-user-1", "exp": time.Now().Add(-time.Minute).Unix()}
+		client := helpers.NewOIDCClient(idp.Issuer(), idp.ClientID, idp.ClientSecret, redirectURL, []string{"openid"}, "groups")
+		authURL, err := client.AuthCodeURL(ctx, "state-1", "nonce-1", helpers.PKCEChallenge(verifier))
+		require.NoError(t, err)
+		code, _, err := idp.Authorize(authURL)
+		require.NoError(t, err)
+		_, err = client.Exchange(ctx, code, verifier, "nonce-1")
+		assert.Error(t, err)
+	})
+	t.Run("exchange error when audience is another client", func(t *testing.T) {
+		idp := oidctest.NewServer("client-id", "client-secret")
+		defer idp.Close()
+		idp.Claims = map[string]interface{}{"sub": "idp-user-1", "aud": "another-client"}
+		client := helpers.NewOIDCClient(idp.Issuer(), idp.ClientID, idp.ClientSecret, redirectURL, []string{"openid"}, "groups")
+		authURL, err := client.AuthCodeURL(ctx, "state-1", "nonce-1", helpers.PKCEChallenge(verifier))
+		require.NoError(t, err)
+		code, _, err := idp.Authorize(authURL)
+		require.NoError(t, err)
+		_, err = client.Exchange(ctx, code, verifier, "nonce-1")
+		assert.Error(t, err)
+	})
+	t.Run("error when issuer does not match discovery", func(t *testing.T) {
+		idp := oidctest.NewServer("client-id", "client-secret")
+		defer idp.Close()
+		client := helpers.NewOIDCClient(idp.Issuer()+"/", idp.ClientID, idp.ClientSecret, redirectURL, []string{"openid"}, "groups")
+		_, err := client.AuthCodeURL(ctx, "state-1", "nonce-1", helpers.PKCEChallenge(verifier))
+		assert.Error(t, err)
+	})
+}
+
+func TestNewOIDCProvider(t *testing.T) {
+	t.Run("disabled without issuer", func(t *testing.T) {
+		t.Setenv("OIDC_ISSUER", "")
+		config.New()
+		provider, err := helpers.NewOIDCProvider()
+		assert.NoError(t, err)
+		assert.Nil(t, provider)
+	})
+	t.Run("error when client id is missing", func(t *testing.T) {
+		t.Setenv("OIDC_ISSUER", "https://idp.example.com")
+		t.Setenv("OIDC_CLIENT_ID", "")
+		t.Setenv("OIDC_REDIRECT_URL", redirectURL)
+		config.New()
+		_, err := helpers.NewOIDCProvider()
+		assert.Error(t, err)
+	})
+}
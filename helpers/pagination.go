@@ -1,5 +1,10 @@
 package helpers
 
+import (
+	"net/url"
+	"strings"
+)
+
 func Paginate[T interface{}](items *[]T, size int64) (int64, bool) {
 	lenItems := int64(len(*items))
 	if lenItems < size+1 {
@@ -8,3 +13,29 @@ func Paginate[T interface{}](items *[]T, size int64) (int64, bool) {
 	*items = (*items)[:lenItems-1]
 	return lenItems - 1, true
 }
+
+// PageLink is a link to another page of a list. Query holds the parameters
+// that differ from the current request; an empty value removes a parameter.
+type PageLink struct {
+	Rel   string
+	Query map[string]string
+}
+
+// LinkHeader returns an RFC 8288 Link header value with a link relative to
+// requestURL for every page, or "" when there are none.
+func LinkHeader(requestURL *url.URL, links ...PageLink) string {
+	values := make([]string, 0, len(links))
+	for _, link := range links {
+		query := requestURL.Query()
+		for key, value := range link.Query {
+			if value == "" {
+				query.Del(key)
+			} else {
+				query.Set(key, value)
+			}
+		}
+		target := url.URL{Path: requestURL.Path, RawQuery: query.Encode()}
+		values = append(values, "<"+target.String()+`>; rel="`+link.Rel+`"`)
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,20 @@
+package helpers_test
+
+import (
+	"net/url"
+	"robinhood-assignment/helpers"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkHeader(t *testing.T) {
+	requestURL, _ := url.Parse("http://example.com/api/interviews?limit=10&page=2&status=TODO")
+	got := helpers.LinkHeader(requestURL,
+		helpers.PageLink{Rel: "next", Query: map[string]string{"cursor": "abc", "page": ""}},
+		helpers.PageLink{Rel: "first", Query: map[string]string{"page": ""}},
+	)
+	expected := `</api/interviews?cursor=abc&limit=10&status=TODO>; rel="next", </api/interviews?limit=10&status=TODO>; rel="first"`
+	assert.Equal(t, expected, got)
+	assert.Equal(t, "", helpers.LinkHeader(requestURL))
+}
@@ -0,0 +1,79 @@
+package helpers_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"robinhood-assignment/helpers"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPCode(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, c := range cases {
+		got, err := helpers.TOTPCode(secret, time.Unix(c.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, c.code, got)
+	}
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	secret, err := helpers.GenerateTOTPSecret()
+	require.NoError(t, err)
+	now := time.Now()
+	t.Run("accept current code", func(t *testing.T) {
+		code, _ := helpers.TOTPCode(secret, now)
+		step, ok := helpers.VerifyTOTP(secret, code, now)
+		assert.True(t, ok)
+		assert.Equal(t, now.Unix()/30, step)
+	})
+	t.Run("accept previous step", func(t *testing.T) {
+		code, _ := helpers.TOTPCode(secret, now.Add(-30*time.Second))
+		_, ok := helpers.VerifyTOTP(secret, code, now)
+		assert.True(t, ok)
+	})
+	t.Run("reject old code", func(t *testing.T) {
+		code, _ := helpers.TOTPCode(secret, now.Add(-2*time.Minute))
+		_, ok := helpers.VerifyTOTP(secret, code, now)
+		assert.False(t, ok)
+	})
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := helpers.TOTPProvisioningURI("Robinhood", "samart", "JBSWY3DPEHPK3PXP")
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/Robinhood:samart", parsed.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", parsed.Query().Get("secret"))
+	assert.Equal(t, "Robinhood", parsed.Query().Get("issuer"))
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := helpers.GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	assert.Len(t, codes, 10)
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Len(t, code, 11)
+		assert.Equal(t, "-", code[5:6])
+		assert.False(t, seen[code])
+		seen[code] = true
+		assert.Equal(t, strings.ReplaceAll(code, "-", ""), helpers.NormalizeRecoveryCode(strings.ToUpper(code)))
+	}
+}
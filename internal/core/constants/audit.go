@@ -0,0 +1,56 @@
+package constants
+
+// Actions recorded in the audit log.
+const (
+	AUDIT_INTERVIEW_CREATE       = "interview.create"
+	AUDIT_INTERVIEW_UPDATE       = "interview.update"
+	AUDIT_INTERVIEW_ASSIGN       = "interview.assign"
+	AUDIT_INTERVIEW_ARCHIVE      = "interview.archive"
+	AUDIT_INTERVIEW_UNARCHIVE    = "interview.unarchive"
+	AUDIT_COMMENT_CREATE         = "comment.create"
+	AUDIT_COMMENT_UPDATE         = "comment.update"
+	AUDIT_COMMENT_DELETE         = "comment.delete"
+	AUDIT_COMMENT_PURGE          = "comment.purge"
+	AUDIT_SCORECARD_SUBMIT       = "scorecard.submit"
+	AUDIT_SCORECARD_UPDATE       = "scorecard.update"
+	AUDIT_TEMPLATE_CREATE        = "scorecard_template.create"
+	AUDIT_TEMPLATE_ARCHIVE       = "scorecard_template.archive"
+	AUDIT_WORKFLOW_UPDATE        = "workflow.update"
+	AUDIT_CANDIDATE_CREATE       = "candidate.create"
+	AUDIT_CANDIDATE_UPDATE       = "candidate.update"
+	AUDIT_STAFF_CREATE           = "staff.create"
+	AUDIT_SERVICE_ACCOUNT_CREATE = "service_account.create"
+	AUDIT_USER_UPDATE            = "user.update"
+	AUDIT_USER_ROLE_UPDATE       = "user.role.update"
+	AUDIT_USER_DEACTIVATE        = "user.deactivate"
+	AUDIT_USER_REACTIVATE        = "user.reactivate"
+	AUDIT_USER_UNLOCK            = "user.unlock"
+	AUDIT_USER_MFA_DISABLE       = "user.mfa.disable"
+	AUDIT_API_KEY_CREATE         = "api_key.create"
+	AUDIT_API_KEY_REVOKE         = "api_key.revoke"
+	AUDIT_LOGIN_SUCCEEDED        = "auth.login"
+	AUDIT_LOGIN_FAILED           = "auth.login.failed"
+	// The password or single sign-on step passed and a second factor was
+	// asked for. The login is only done once it is followed by auth.login.
+	AUDIT_LOGIN_MFA_CHALLENGED = "auth.login.mfa_challenged"
+)
+
+// Types of the targets of audit events.
+const (
+	AUDIT_TARGET_INTERVIEW = "interview"
+	AUDIT_TARGET_COMMENT   = "comment"
+	AUDIT_TARGET_SCORECARD = "scorecard"
+	AUDIT_TARGET_TEMPLATE  = "scorecard_template"
+	AUDIT_TARGET_WORKFLOW  = "workflow"
+	AUDIT_TARGET_CANDIDATE = "candidate"
+	AUDIT_TARGET_USER      = "user"
+	AUDIT_TARGET_API_KEY   = "api_key"
+)
+
+func IsAuditTargetType(targetType string) bool {
+	switch targetType {
+	case AUDIT_TARGET_INTERVIEW, AUDIT_TARGET_COMMENT, AUDIT_TARGET_SCORECARD, AUDIT_TARGET_TEMPLATE, AUDIT_TARGET_WORKFLOW, AUDIT_TARGET_CANDIDATE, AUDIT_TARGET_USER, AUDIT_TARGET_API_KEY:
+		return true
+	}
+	return false
+}
@@ -1,6 +1,28 @@
 package constants
 
 const (
-	ADMIN_ROLE = "ADMIN"
-	STAFF_ROLE = "STAFF"
+	ADMIN_ROLE       = "ADMIN"
+	STAFF_ROLE       = "STAFF"
+	INTERVIEWER_ROLE = "INTERVIEWER"
+	VIEWER_ROLE      = "VIEWER"
 )
+
+const MFA_PENDING_PURPOSE = "mfa"
+
+const (
+	SCOPE_INTERVIEWS_READ  = "interviews:read"
+	SCOPE_INTERVIEWS_WRITE = "interviews:write"
+	SCOPE_USERS_READ       = "users:read"
+	SCOPE_USERS_WRITE      = "users:write"
+	SCOPE_CANDIDATES_READ  = "candidates:read"
+	SCOPE_CANDIDATES_WRITE = "candidates:write"
+)
+
+var API_KEY_SCOPES = []string{
+	SCOPE_INTERVIEWS_READ,
+	SCOPE_INTERVIEWS_WRITE,
+	SCOPE_USERS_READ,
+	SCOPE_USERS_WRITE,
+	SCOPE_CANDIDATES_READ,
+	SCOPE_CANDIDATES_WRITE,
+}
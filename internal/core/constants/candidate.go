@@ -0,0 +1,16 @@
+package constants
+
+const (
+	CANDIDATE_STAGE_APPLIED   = "APPLIED"
+	CANDIDATE_STAGE_SCREENING = "SCREENING"
+	CANDIDATE_STAGE_ONSITE    = "ONSITE"
+	CANDIDATE_STAGE_OFFER     = "OFFER"
+	CANDIDATE_STAGE_HIRED     = "HIRED"
+	CANDIDATE_STAGE_REJECTED  = "REJECTED"
+)
+
+// IsClosedCandidateStage reports whether a candidate has left the hiring
+// pipeline, after which no new interviews can be scheduled for them.
+func IsClosedCandidateStage(stage string) bool {
+	return stage == CANDIDATE_STAGE_HIRED || stage == CANDIDATE_STAGE_REJECTED
+}
@@ -0,0 +1,9 @@
+package constants
+
+// Statuses of the default interview workflow. Admins can replace the workflow,
+// so other status names may exist in the database.
+const (
+	INTERVIEW_STATUS_TODO        = "TODO"
+	INTERVIEW_STATUS_IN_PROGRESS = "IN_PROGRESS"
+	INTERVIEW_STATUS_DONE        = "DONE"
+)
@@ -0,0 +1,98 @@
+package constants
+
+const (
+	PERMISSION_INTERVIEW_READ            = "interview:read"
+	PERMISSION_INTERVIEW_CREATE          = "interview:create"
+	PERMISSION_INTERVIEW_UPDATE          = "interview:update"
+	PERMISSION_INTERVIEW_UPDATE_ANY      = "interview:update:any"
+	PERMISSION_INTERVIEW_ARCHIVE         = "interview:archive"
+	PERMISSION_INTERVIEW_ARCHIVE_ANY     = "interview:archive:any"
+	PERMISSION_INTERVIEW_ASSIGN          = "interview:assign"
+	PERMISSION_INTERVIEW_CONDUCT         = "interview:conduct"
+	PERMISSION_CANDIDATE_READ            = "candidate:read"
+	PERMISSION_CANDIDATE_MANAGE          = "candidate:manage"
+	PERMISSION_COMMENT_CREATE            = "comment:create"
+	PERMISSION_COMMENT_EDIT              = "comment:edit"
+	PERMISSION_COMMENT_EDIT_ANY          = "comment:edit:any"
+	PERMISSION_COMMENT_DELETE            = "comment:delete"
+	PERMISSION_COMMENT_DELETE_ANY        = "comment:delete:any"
+	PERMISSION_COMMENT_PURGE             = "comment:purge"
+	PERMISSION_USER_READ                 = "user:read"
+	PERMISSION_USER_MANAGE               = "user:manage"
+	PERMISSION_AUTH_SETTING_MANAGE       = "auth:setting:manage"
+	PERMISSION_WORKFLOW_MANAGE           = "workflow:manage"
+	PERMISSION_SCORECARD_TEMPLATE_MANAGE = "scorecard:template:manage"
+	PERMISSION_FEEDBACK_READ_ANY         = "feedback:read:any"
+	PERMISSION_AUDIT_READ                = "audit:read"
+)
+
+// ROLE_PERMISSIONS maps the built-in roles to their permissions. A permission
+// without the ":any" suffix only covers resources the user created; the
+// services check ownership.
+var ROLE_PERMISSIONS = map[string][]string{
+	VIEWER_ROLE: {
+		PERMISSION_INTERVIEW_READ,
+		PERMISSION_CANDIDATE_READ,
+	},
+	INTERVIEWER_ROLE: {
+		PERMISSION_INTERVIEW_READ,
+		PERMISSION_INTERVIEW_UPDATE,
+		PERMISSION_INTERVIEW_CONDUCT,
+		PERMISSION_CANDIDATE_READ,
+		PERMISSION_COMMENT_CREATE,
+		PERMISSION_COMMENT_EDIT,
+		PERMISSION_COMMENT_DELETE,
+	},
+	STAFF_ROLE: {
+		PERMISSION_INTERVIEW_READ,
+		PERMISSION_INTERVIEW_CREATE,
+		PERMISSION_INTERVIEW_UPDATE,
+		PERMISSION_INTERVIEW_ARCHIVE,
+		PERMISSION_INTERVIEW_ASSIGN,
+		PERMISSION_INTERVIEW_CONDUCT,
+		PERMISSION_CANDIDATE_READ,
+		PERMISSION_CANDIDATE_MANAGE,
+		PERMISSION_COMMENT_CREATE,
+		PERMISSION_COMMENT_EDIT,
+		PERMISSION_COMMENT_DELETE,
+	},
+	ADMIN_ROLE: {
+		PERMISSION_INTERVIEW_READ,
+		PERMISSION_INTERVIEW_CREATE,
+		PERMISSION_INTERVIEW_UPDATE,
+		PERMISSION_INTERVIEW_UPDATE_ANY,
+		PERMISSION_INTERVIEW_ARCHIVE,
+		PERMISSION_INTERVIEW_ARCHIVE_ANY,
+		PERMISSION_INTERVIEW_ASSIGN,
+		PERMISSION_INTERVIEW_CONDUCT,
+		PERMISSION_CANDIDATE_READ,
+		PERMISSION_CANDIDATE_MANAGE,
+		PERMISSION_COMMENT_CREATE,
+		PERMISSION_COMMENT_EDIT,
+		PERMISSION_COMMENT_EDIT_ANY,
+		PERMISSION_COMMENT_DELETE,
+		PERMISSION_COMMENT_DELETE_ANY,
+		PERMISSION_COMMENT_PURGE,
+		PERMISSION_USER_READ,
+		PERMISSION_USER_MANAGE,
+		PERMISSION_AUTH_SETTING_MANAGE,
+		PERMISSION_WORKFLOW_MANAGE,
+		PERMISSION_SCORECARD_TEMPLATE_MANAGE,
+		PERMISSION_FEEDBACK_READ_ANY,
+		PERMISSION_AUDIT_READ,
+	},
+}
+
+func IsRole(role string) bool {
+	_, ok := ROLE_PERMISSIONS[role]
+	return ok
+}
+
+func HasPermission(role string, permission string) bool {
+	for _, p := range ROLE_PERMISSIONS[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,15 @@
+package constants
+
+const (
+	RECOMMENDATION_STRONG_NO  = "STRONG_NO"
+	RECOMMENDATION_NO         = "NO"
+	RECOMMENDATION_YES        = "YES"
+	RECOMMENDATION_STRONG_YES = "STRONG_YES"
+)
+
+var RECOMMENDATIONS = []string{
+	RECOMMENDATION_STRONG_NO,
+	RECOMMENDATION_NO,
+	RECOMMENDATION_YES,
+	RECOMMENDATION_STRONG_YES,
+}
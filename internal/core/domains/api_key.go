@@ -0,0 +1,38 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type APIKey struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	UserID     primitive.ObjectID `bson:"userId"`
+	Name       string             `bson:"name"`
+	Prefix     string             `bson:"prefix"`
+	KeyHash    string             `bson:"keyHash"`
+	Scopes     []string           `bson:"scopes"`
+	ExpiresAt  *time.Time         `bson:"expiresAt"`
+	LastUsedAt *time.Time         `bson:"lastUsedAt"`
+	RevokedAt  *time.Time         `bson:"revokedAt"`
+	CreatedBy  primitive.ObjectID `bson:"createdBy"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+}
+
+type CreateAPIKeyParams struct {
+	UserID    primitive.ObjectID
+	Name      string
+	Prefix    string
+	KeyHash   string
+	Scopes    []string
+	ExpiresAt *time.Time
+	CreatedBy primitive.ObjectID
+}
+
+// IssuedAPIKey carries the plain key, which is only available right after it
+// is created.
+type IssuedAPIKey struct {
+	APIKey
+	Key string
+}
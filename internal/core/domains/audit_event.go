@@ -0,0 +1,66 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditEvent records who changed what and from where. Events are only ever
+// added; the one exception is that Changes are removed when the data they
+// copy is deleted, such as the text of a deleted comment.
+type AuditEvent struct {
+	ID primitive.ObjectID `bson:"_id"`
+	// ActorID is empty for a failed login with an unknown username.
+	ActorID    primitive.ObjectID `bson:"actorId,omitempty"`
+	Actor      *User              `bson:"actor,omitempty"`
+	Action     string             `bson:"action"`
+	TargetType string             `bson:"targetType"`
+	TargetID   primitive.ObjectID `bson:"targetId,omitempty"`
+	// AppointmentID is the appointment that the target belongs to, so that
+	// its comments show up in its activity.
+	AppointmentID primitive.ObjectID `bson:"appointmentId,omitempty"`
+	Changes       []AuditChange      `bson:"changes,omitempty"`
+	Metadata      map[string]string  `bson:"metadata,omitempty"`
+	RequestID     string             `bson:"requestId,omitempty"`
+	IPAddress     string             `bson:"ipAddress,omitempty"`
+	CreatedAt     time.Time          `bson:"createdAt"`
+}
+
+// AuditChange is the value of a field before and after a change. Before is
+// nil for a field that was just set and After for one that was cleared.
+type AuditChange struct {
+	Field  string      `bson:"field"`
+	Before interface{} `bson:"before,omitempty"`
+	After  interface{} `bson:"after,omitempty"`
+}
+
+type CreateAuditEventParams struct {
+	ActorID       primitive.ObjectID
+	Action        string
+	TargetType    string
+	TargetID      primitive.ObjectID
+	AppointmentID primitive.ObjectID
+	Changes       []AuditChange
+	Metadata      map[string]string
+	RequestID     string
+	IPAddress     string
+}
+
+// GetAuditEventsParams selects a page of audit events, newest first. Zero
+// fields do not filter.
+type GetAuditEventsParams struct {
+	ActorID       primitive.ObjectID
+	Action        string
+	TargetType    string
+	TargetID      primitive.ObjectID
+	AppointmentID primitive.ObjectID
+	RequestID     string
+	From          *time.Time
+	To            *time.Time
+	// BeforeCreatedAt and BeforeID continue after the last event of the
+	// previous page. They are zero for the first page.
+	BeforeCreatedAt time.Time
+	BeforeID        primitive.ObjectID
+	Limit           int64
+}
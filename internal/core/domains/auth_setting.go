@@ -0,0 +1,19 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuthSetting struct {
+	PasswordLoginDisabled bool               `bson:"passwordLoginDisabled"`
+	UpdatedBy             primitive.ObjectID `bson:"updatedBy,omitempty"`
+	UpdatedAt             time.Time          `bson:"updatedAt,omitempty"`
+	OIDCEnabled           bool               `bson:"-"`
+}
+
+type UpdateAuthSettingParams struct {
+	PasswordLoginDisabled bool
+	UpdatedBy             primitive.ObjectID
+}
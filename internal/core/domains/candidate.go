@@ -0,0 +1,46 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Candidate struct {
+	ID         primitive.ObjectID     `bson:"_id"`
+	Name       string                 `bson:"name"`
+	Email      string                 `bson:"email"`
+	Phone      string                 `bson:"phone,omitempty"`
+	Position   string                 `bson:"position"`
+	Stage      string                 `bson:"stage"`
+	CreatedBy  primitive.ObjectID     `bson:"createdBy"`
+	CreatedAt  time.Time              `bson:"createdAt"`
+	UpdatedAt  time.Time              `bson:"updatedAt"`
+	Interviews []InterviewAppointment `bson:"interviews,omitempty"`
+}
+
+type GetCandidatesParams struct {
+	Search   string
+	Position string
+	Stage    string
+	Offset   uint32
+	Limit    uint32
+}
+
+type CreateCandidateParams struct {
+	Name      string
+	Email     string
+	Phone     string
+	Position  string
+	Stage     string
+	CreatedBy primitive.ObjectID
+}
+
+type UpdateCandidateParams struct {
+	ID       primitive.ObjectID
+	Name     string
+	Email    string
+	Phone    string
+	Position string
+	Stage    string
+}
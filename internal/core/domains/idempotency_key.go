@@ -0,0 +1,36 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IdempotencyKey records a request sent with an Idempotency-Key header so a
+// retry gets the first response instead of running again. StatusCode is 0
+// while the first request is still running.
+type IdempotencyKey struct {
+	ID          primitive.ObjectID  `bson:"_id"`
+	UserID      primitive.ObjectID  `bson:"userId"`
+	Key         string              `bson:"key"`
+	Fingerprint string              `bson:"fingerprint"`
+	StatusCode  int                 `bson:"statusCode"`
+	Header      map[string][]string `bson:"header,omitempty"`
+	Body        []byte              `bson:"body,omitempty"`
+	ExpiresAt   time.Time           `bson:"expiresAt"`
+	CreatedAt   time.Time           `bson:"createdAt"`
+}
+
+type CreateIdempotencyKeyParams struct {
+	UserID      primitive.ObjectID
+	Key         string
+	Fingerprint string
+	ExpiresAt   time.Time
+}
+
+type SaveIdempotentResponseParams struct {
+	ID         primitive.ObjectID
+	StatusCode int
+	Header     map[string][]string
+	Body       []byte
+}
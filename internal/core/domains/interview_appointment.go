@@ -7,38 +7,201 @@ import (
 )
 
 type CreateInterviewAppointment struct {
-	ID           primitive.ObjectID `bson:"_id"`
-	Title        string             `bson:"title"`
-	Description  string             `bson:"description"`
-	Comments     []InterviewComment `bson:"comments"`
-	Status       string             `bson:"status"`
-	IsArchived   bool               `bson:"isArchived"`
-	CreateUserId primitive.ObjectID `bson:"createUserId"`
-	CreatedAt    time.Time          `bson:"createdAt"`
-	UpdatedAt    time.Time          `bson:"updatedAt"`
+	ID                  primitive.ObjectID      `bson:"_id"`
+	Title               string                  `bson:"title"`
+	Description         string                  `bson:"description"`
+	Status              string                  `bson:"status"`
+	StatusHistory       []InterviewStatusChange `bson:"statusHistory"`
+	IsArchived          bool                    `bson:"isArchived"`
+	StartAt             time.Time               `bson:"startAt,omitempty"`
+	EndAt               time.Time               `bson:"endAt,omitempty"`
+	DurationMinutes     int                     `bson:"durationMinutes,omitempty"`
+	Timezone            string                  `bson:"timezone,omitempty"`
+	Location            string                  `bson:"location,omitempty"`
+	MeetingURL          string                  `bson:"meetingUrl,omitempty"`
+	Tags                []string                `bson:"tags"`
+	InterviewerIDs      []primitive.ObjectID    `bson:"interviewerIds"`
+	CandidateID         primitive.ObjectID      `bson:"candidateId,omitempty"`
+	ScorecardTemplateID primitive.ObjectID      `bson:"scorecardTemplateId,omitempty"`
+	BlindFeedback       bool                    `bson:"blindFeedback"`
+	HiringManagerID     primitive.ObjectID      `bson:"hiringManagerId,omitempty"`
+	CreateUserId        primitive.ObjectID      `bson:"createUserId"`
+	Version             int64                   `bson:"version"`
+	CreatedAt           time.Time               `bson:"createdAt"`
+	UpdatedAt           time.Time               `bson:"updatedAt"`
 }
 
 type InterviewAppointment struct {
-	ID          primitive.ObjectID `bson:"_id"`
-	Title       string             `bson:"title"`
-	Description string             `bson:"description"`
-	Comments    []InterviewComment `bson:"comments"`
-	Status      string             `bson:"status"`
-	IsArchived  bool               `bson:"isArchived"`
-	CreateUser  User               `bson:"createUser"`
-	CreatedAt   time.Time          `bson:"createdAt"`
-	UpdatedAt   time.Time          `bson:"updatedAt"`
+	ID                  primitive.ObjectID      `bson:"_id"`
+	Title               string                  `bson:"title"`
+	Description         string                  `bson:"description"`
+	Status              string                  `bson:"status"`
+	StatusHistory       []InterviewStatusChange `bson:"statusHistory,omitempty"`
+	IsArchived          bool                    `bson:"isArchived"`
+	ArchivedAt          time.Time               `bson:"archivedAt,omitempty"`
+	ArchivedBy          primitive.ObjectID      `bson:"archivedBy,omitempty"`
+	StartAt             time.Time               `bson:"startAt,omitempty"`
+	EndAt               time.Time               `bson:"endAt,omitempty"`
+	DurationMinutes     int                     `bson:"durationMinutes,omitempty"`
+	Timezone            string                  `bson:"timezone,omitempty"`
+	Location            string                  `bson:"location,omitempty"`
+	MeetingURL          string                  `bson:"meetingUrl,omitempty"`
+	Tags                []string                `bson:"tags,omitempty"`
+	InterviewerIDs      []primitive.ObjectID    `bson:"interviewerIds,omitempty"`
+	Interviewers        []User                  `bson:"interviewers,omitempty"`
+	CandidateID         primitive.ObjectID      `bson:"candidateId,omitempty"`
+	Candidate           *Candidate              `bson:"candidate,omitempty"`
+	ScorecardTemplateID primitive.ObjectID      `bson:"scorecardTemplateId,omitempty"`
+	ScorecardTemplate   *ScorecardTemplate      `bson:"scorecardTemplate,omitempty"`
+	Scorecards          []Scorecard             `bson:"scorecards,omitempty"`
+	BlindFeedback       bool                    `bson:"blindFeedback"`
+	HiringManagerID     primitive.ObjectID      `bson:"hiringManagerId,omitempty"`
+	// FeedbackHidden is set when blind feedback removed other users'
+	// comments and scorecards for the caller.
+	FeedbackHidden bool `bson:"-"`
+	// TimeInStatus and ScorecardSummary are worked out when an appointment
+	// is read on its own.
+	TimeInStatus     []StatusDuration  `bson:"-"`
+	ScorecardSummary *ScorecardSummary `bson:"-"`
+	// Comments holds the first page of comments for the deprecated comments
+	// field of the detail response. Comments are stored in their own
+	// collection.
+	Comments   []InterviewComment `bson:"-"`
+	CreateUser User               `bson:"createUser"`
+	// Version goes up by one with every change of the appointment.
+	// Appointments created before versions were added are at 0.
+	Version   int64     `bson:"version"`
+	CreatedAt time.Time `bson:"createdAt"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+// StatusDuration is the total time an appointment has spent in a status,
+// including the time so far in its current status.
+type StatusDuration struct {
+	Status   string
+	Duration time.Duration
+}
+
+// InterviewStatusChange records a status move. The first entry of a new
+// appointment has an empty From.
+type InterviewStatusChange struct {
+	From      string             `bson:"from"`
+	To        string             `bson:"to"`
+	UserID    primitive.ObjectID `bson:"userId"`
+	ChangedAt time.Time          `bson:"changedAt"`
+}
+
+type GetInterviewAppointmentsParams struct {
+	// Archived lists archived appointments instead.
+	Archived  bool
+	Status    string
+	CreatedBy primitive.ObjectID
+	Tag       string
+	StartFrom *time.Time
+	StartTo   *time.Time
+	// Search matches the text index of title and description. Appointments
+	// in SearchIDs, which had a hit in their comments, match as well.
+	Search    string
+	SearchIDs []primitive.ObjectID
+	// SortBy is the field to sort on. Ties are broken by _id in the same
+	// direction.
+	SortBy   string
+	SortDesc bool
+	// Cursor continues the list from an item instead of skipping Offset
+	// items.
+	Cursor *InterviewAppointmentCursor
+	Offset uint32
+	Limit  uint32
+	// WithTotal also counts every appointment matching the filters.
+	WithTotal bool
+}
+
+// InterviewAppointmentCursor selects the appointments after the one with
+// SortValue and ID, or before it when Before is set. Appointments before it
+// are returned nearest first.
+type InterviewAppointmentCursor struct {
+	SortValue interface{}
+	ID        primitive.ObjectID
+	Before    bool
+}
+
+// InterviewAppointmentPage is a page of the appointment list. NextCursor and
+// PrevCursor are set when there are appointments after or before it.
+type InterviewAppointmentPage struct {
+	Items      []InterviewAppointment
+	HasNext    bool
+	NextCursor string
+	PrevCursor string
+	// TotalCount counts every appointment matching the filters. It is only
+	// set when it was asked for.
+	TotalCount *int64
+}
+
+// InterviewSchedule holds the scheduled time of an appointment. Times are in
+// UTC; Timezone is the IANA zone the appointment is shown in.
+type InterviewSchedule struct {
+	StartAt         time.Time
+	EndAt           time.Time
+	DurationMinutes int
+	Timezone        string
+	Location        string
+	MeetingURL      string
 }
 
 type CreateInterviewAppointmentParams struct {
-	Title       string
-	Description string
-	UserID      primitive.ObjectID
+	Title               string
+	Description         string
+	Status              string
+	Schedule            InterviewSchedule
+	Tags                []string
+	CandidateID         primitive.ObjectID
+	ScorecardTemplateID primitive.ObjectID
+	BlindFeedback       bool
+	HiringManagerID     primitive.ObjectID
+	UserID              primitive.ObjectID
 }
 
 type UpdateInterviewAppointmentParams struct {
 	ID          primitive.ObjectID
 	Title       string
 	Description string
-	Status      string
+	// StatusChange is only applied while the appointment is still in
+	// StatusChange.From.
+	StatusChange *InterviewStatusChange
+	Schedule     InterviewSchedule
+	// Tags replaces the tags when it is not nil.
+	Tags                *[]string
+	CandidateID         primitive.ObjectID
+	ScorecardTemplateID primitive.ObjectID
+	BlindFeedback       *bool
+	HiringManagerID     primitive.ObjectID
+	// Version, when set, only applies the update while the appointment is
+	// still at that version.
+	Version *int64
+}
+
+type ArchiveInterviewAppointmentParams struct {
+	ID     primitive.ObjectID
+	UserID primitive.ObjectID
+}
+
+type UpdateInterviewersParams struct {
+	ID             primitive.ObjectID
+	InterviewerIDs []primitive.ObjectID
+}
+
+// FindInterviewConflictsParams selects appointments of any of InterviewerIDs
+// that overlap [StartAt, EndAt), apart from ExcludeID.
+type FindInterviewConflictsParams struct {
+	InterviewerIDs []primitive.ObjectID
+	StartAt        time.Time
+	EndAt          time.Time
+	ExcludeID      primitive.ObjectID
+}
+
+type GetInterviewerAppointmentsParams struct {
+	InterviewerID primitive.ObjectID
+	EndAfter      time.Time
+	Offset        uint32
+	Limit         uint32
 }
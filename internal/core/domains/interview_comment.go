@@ -7,29 +7,83 @@ import (
 )
 
 type AddInterviewComment struct {
-	ID        primitive.ObjectID `bson:"_id"`
-	Comment   string             `bson:"comment"`
-	UserID    primitive.ObjectID `bson:"userId"`
-	CreatedAt time.Time          `bson:"createdAt"`
-	UpdatedAt time.Time          `bson:"updatedAt"`
+	ID            primitive.ObjectID `bson:"_id"`
+	AppointmentID primitive.ObjectID `bson:"appointmentId"`
+	Comment       string             `bson:"comment"`
+	UserID        primitive.ObjectID `bson:"userId"`
+	Version       int64              `bson:"version"`
+	CreatedAt     time.Time          `bson:"createdAt"`
+	UpdatedAt     time.Time          `bson:"updatedAt"`
 }
 
 type InterviewComment struct {
-	ID        primitive.ObjectID `bson:"_id"`
+	ID            primitive.ObjectID `bson:"_id"`
+	AppointmentID primitive.ObjectID `bson:"appointmentId"`
+	Comment       string             `bson:"comment"`
+	UserID        primitive.ObjectID `bson:"userId"`
+	User          User               `bson:"user"`
+	// Version goes up by one with every edit or deletion. Comments created
+	// before versions were added are at 0.
+	Version   int64     `bson:"version"`
+	CreatedAt time.Time `bson:"createdAt"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+	// UpdatedBy is the user who made the last edit. It is empty until the
+	// comment is edited.
+	UpdatedBy primitive.ObjectID         `bson:"updatedBy,omitempty"`
+	Revisions []InterviewCommentRevision `bson:"revisions,omitempty"`
+	// DeletedAt and DeletedBy are set on a deleted comment. Its text is kept
+	// for moderation but not returned by the API.
+	DeletedAt time.Time          `bson:"deletedAt,omitempty"`
+	DeletedBy primitive.ObjectID `bson:"deletedBy,omitempty"`
+}
+
+// InterviewCommentRevision is an earlier text of a comment, written by UserID
+// at CreatedAt.
+type InterviewCommentRevision struct {
 	Comment   string             `bson:"comment"`
-	User      User               `bson:"user"`
+	UserID    primitive.ObjectID `bson:"userId"`
 	CreatedAt time.Time          `bson:"createdAt"`
-	UpdatedAt time.Time          `bson:"updatedAt"`
+	User      *User              `bson:"-"`
+}
+
+// GetInterviewCommentsParams selects a page of the comments of an
+// appointment, oldest first.
+type GetInterviewCommentsParams struct {
+	AppointmentID primitive.ObjectID
+	// UserID limits the page to the comments of one user when set.
+	UserID primitive.ObjectID
+	// AfterCreatedAt and AfterID continue after the last comment of the
+	// previous page. They are zero for the first page.
+	AfterCreatedAt time.Time
+	AfterID        primitive.ObjectID
+	Limit          int64
+}
+
+// SearchInterviewCommentsParams is a text search over comments that were not
+// deleted. With UserID set, only that user's comments are searched.
+type SearchInterviewCommentsParams struct {
+	Query  string
+	UserID primitive.ObjectID
 }
 
 type AddInterviewCommentParams struct {
-	ID      primitive.ObjectID
-	Comment string
-	UserID  primitive.ObjectID
+	AppointmentID primitive.ObjectID
+	Comment       string
+	UserID        primitive.ObjectID
 }
 
+// UpdateInterviewCommentParams replaces the text of a comment and keeps the
+// previous text in Revision. The update only applies while the comment was
+// last updated at PreviousUpdatedAt.
 type UpdateInterviewCommentParams struct {
-	ID        primitive.ObjectID
+	CommentID         primitive.ObjectID
+	Comment           string
+	UserID            primitive.ObjectID
+	Revision          InterviewCommentRevision
+	PreviousUpdatedAt time.Time
+}
+
+type DeleteInterviewCommentParams struct {
 	CommentID primitive.ObjectID
-	Comment   string
+	UserID    primitive.ObjectID
 }
@@ -0,0 +1,12 @@
+package domains
+
+type JSONWebKey struct {
+	Kty string
+	Kid string
+	Use string
+	Alg string
+	N   string
+	E   string
+	Crv string
+	X   string
+}
@@ -0,0 +1,19 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type LoginAttempt struct {
+	ID           primitive.ObjectID `bson:"_id"`
+	Key          string             `bson:"key"`
+	Failures     int                `bson:"failures"`
+	BlockedUntil *time.Time         `bson:"blockedUntil"`
+	// ClientIPs are the addresses the failures came from in this window. They
+	// are only kept for username guards, to clear the matching IP guards on
+	// unlock.
+	ClientIPs []string  `bson:"clientIps,omitempty"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
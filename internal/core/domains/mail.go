@@ -0,0 +1,7 @@
+package domains
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,33 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type OIDCState struct {
+	ID           primitive.ObjectID `bson:"_id"`
+	StateHash    string             `bson:"stateHash"`
+	Nonce        string             `bson:"nonce"`
+	CodeVerifier string             `bson:"codeVerifier"`
+	ExpiresAt    time.Time          `bson:"expiresAt"`
+	CreatedAt    time.Time          `bson:"createdAt"`
+}
+
+type CreateOIDCStateParams struct {
+	StateHash    string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+}
+
+type OIDCIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Username      string
+	Picture       string
+	Groups        []string
+}
@@ -0,0 +1,22 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PasswordResetToken struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	UserID    primitive.ObjectID `bson:"userId"`
+	TokenHash string             `bson:"tokenHash"`
+	ExpiresAt time.Time          `bson:"expiresAt"`
+	UsedAt    *time.Time         `bson:"usedAt"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+type CreatePasswordResetTokenParams struct {
+	UserID    primitive.ObjectID
+	TokenHash string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,34 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RefreshToken struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	UserID    primitive.ObjectID `bson:"userId"`
+	SessionID primitive.ObjectID `bson:"sessionId"`
+	TokenHash string             `bson:"tokenHash"`
+	ExpiresAt time.Time          `bson:"expiresAt"`
+	UsedAt    *time.Time         `bson:"usedAt"`
+	RevokedAt *time.Time         `bson:"revokedAt"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+type CreateRefreshTokenParams struct {
+	UserID    primitive.ObjectID
+	SessionID primitive.ObjectID
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+type AuthToken struct {
+	AccessToken       string
+	RefreshToken      string
+	ExpiresAt         time.Time
+	MFAToken          string
+	MFAEnrollRequired bool
+	RecoveryCodes     []string
+}
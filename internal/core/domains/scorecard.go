@@ -0,0 +1,114 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ScorecardTemplate struct {
+	ID           primitive.ObjectID    `bson:"_id"`
+	Name         string                `bson:"name"`
+	Competencies []ScorecardCompetency `bson:"competencies"`
+	RatingScale  ScorecardRatingScale  `bson:"ratingScale"`
+	Questions    []ScorecardQuestion   `bson:"questions"`
+	IsArchived   bool                  `bson:"isArchived"`
+	CreatedBy    primitive.ObjectID    `bson:"createdBy"`
+	CreatedAt    time.Time             `bson:"createdAt"`
+	UpdatedAt    time.Time             `bson:"updatedAt"`
+}
+
+type ScorecardCompetency struct {
+	Name        string `bson:"name"`
+	Description string `bson:"description,omitempty"`
+}
+
+// ScorecardRatingScale is the inclusive range of scores a competency can be
+// rated with.
+type ScorecardRatingScale struct {
+	Min int `bson:"min"`
+	Max int `bson:"max"`
+}
+
+type ScorecardQuestion struct {
+	ID       primitive.ObjectID `bson:"_id"`
+	Text     string             `bson:"text"`
+	Required bool               `bson:"required"`
+}
+
+// Scorecard is the feedback of one interviewer on one appointment. It can be
+// changed by its interviewer until LockedAt.
+type Scorecard struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	AppointmentID  primitive.ObjectID `bson:"appointmentId"`
+	TemplateID     primitive.ObjectID `bson:"templateId"`
+	InterviewerID  primitive.ObjectID `bson:"interviewerId"`
+	Interviewer    *User              `bson:"interviewer,omitempty"`
+	Ratings        []ScorecardRating  `bson:"ratings"`
+	Answers        []ScorecardAnswer  `bson:"answers"`
+	Recommendation string             `bson:"recommendation"`
+	SubmittedAt    time.Time          `bson:"submittedAt"`
+	UpdatedAt      time.Time          `bson:"updatedAt"`
+	LockedAt       time.Time          `bson:"lockedAt"`
+}
+
+type ScorecardRating struct {
+	Competency string `bson:"competency"`
+	Score      int    `bson:"score"`
+	Note       string `bson:"note,omitempty"`
+}
+
+type ScorecardAnswer struct {
+	QuestionID primitive.ObjectID `bson:"questionId"`
+	Answer     string             `bson:"answer"`
+}
+
+// ScorecardSummary aggregates the scorecards submitted for an appointment per
+// competency of its template. Averages are nil until a score was given.
+type ScorecardSummary struct {
+	TemplateID      primitive.ObjectID
+	TemplateName    string
+	RatingScale     ScorecardRatingScale
+	Submitted       int
+	Expected        int
+	AverageScore    *float64
+	Competencies    []CompetencyScore
+	Recommendations map[string]int
+}
+
+type CompetencyScore struct {
+	Competency   string
+	AverageScore *float64
+	Ratings      int
+}
+
+type GetScorecardTemplatesParams struct {
+	IncludeArchived bool
+	Offset          uint32
+	Limit           uint32
+}
+
+type CreateScorecardTemplateParams struct {
+	Name         string
+	Competencies []ScorecardCompetency
+	RatingScale  ScorecardRatingScale
+	Questions    []ScorecardQuestion
+	CreatedBy    primitive.ObjectID
+}
+
+type CreateScorecardParams struct {
+	AppointmentID  primitive.ObjectID
+	TemplateID     primitive.ObjectID
+	InterviewerID  primitive.ObjectID
+	Ratings        []ScorecardRating
+	Answers        []ScorecardAnswer
+	Recommendation string
+	LockedAt       time.Time
+}
+
+type UpdateScorecardParams struct {
+	ID             primitive.ObjectID
+	Ratings        []ScorecardRating
+	Answers        []ScorecardAnswer
+	Recommendation string
+}
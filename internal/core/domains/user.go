@@ -6,26 +6,62 @@ import (
 )
 
 type CreateUserParams struct {
-	Name     string
-	Email    string
-	Username string
-	Password string
-	ImageUrl string
-	Role     string
+	Name             string
+	Email            string
+	Username         string
+	Password         string
+	ImageUrl         string
+	Role             string
+	IsServiceAccount bool
+	OIDCSubject      string
 }
 
 type User struct {
-	ID       primitive.ObjectID `bson:"_id"`
-	Name     string             `bson:"name"`
-	Email    string             `bson:"email"`
-	Username string             `bson:"username"`
-	Password string             `bson:"password"`
-	ImageUrl string             `bson:"imageUrl"`
-	Role     string             `bson:"role"`
+	ID               primitive.ObjectID `bson:"_id"`
+	Name             string             `bson:"name"`
+	Email            string             `bson:"email"`
+	Username         string             `bson:"username"`
+	Password         string             `bson:"password"`
+	ImageUrl         string             `bson:"imageUrl"`
+	Role             string             `bson:"role"`
+	IsDeactivated    bool               `bson:"isDeactivated"`
+	IsServiceAccount bool               `bson:"isServiceAccount"`
+	OIDCSubject      string             `bson:"oidcSubject,omitempty"`
+	MFAEnabled       bool               `bson:"mfaEnabled"`
+	TOTPSecret       string             `bson:"totpSecret,omitempty"`
+	PendingTOTP      string             `bson:"pendingTotpSecret,omitempty"`
+	TOTPLastStep     int64              `bson:"totpLastStep,omitempty"`
+	RecoveryCodes    []string           `bson:"recoveryCodes,omitempty"`
+}
+
+type GetUsersParams struct {
+	Search        string
+	Role          string
+	IsDeactivated *bool
+	Offset        uint32
+	Limit         uint32
+}
+
+type UpdateUserParams struct {
+	ID            primitive.ObjectID
+	Name          string
+	Email         string
+	ImageUrl      string
+	Password      string
+	Role          string
+	IsDeactivated *bool
+	OIDCSubject   string
 }
 
 type Claims struct {
-	UserID string `json:"userId"`
-	Role   string `json:"role"`
+	UserID    string `json:"userId"`
+	Role      string `json:"role"`
+	SessionID string `json:"sessionId"`
+	Purpose   string `json:"purpose,omitempty"`
 	jwt.RegisteredClaims
 }
+
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
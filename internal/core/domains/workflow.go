@@ -0,0 +1,27 @@
+package domains
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Workflow lists the statuses an interview appointment can be in and the
+// statuses it may move to from each of them.
+type Workflow struct {
+	Statuses      []WorkflowStatus   `bson:"statuses"`
+	InitialStatus string             `bson:"initialStatus"`
+	UpdatedBy     primitive.ObjectID `bson:"updatedBy,omitempty"`
+	UpdatedAt     time.Time          `bson:"updatedAt,omitempty"`
+}
+
+type WorkflowStatus struct {
+	Name        string   `bson:"name"`
+	Transitions []string `bson:"transitions"`
+}
+
+type UpdateWorkflowParams struct {
+	Statuses      []WorkflowStatus
+	InitialStatus string
+	UpdatedBy     primitive.ObjectID
+}
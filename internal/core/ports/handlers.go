@@ -7,6 +7,40 @@ import (
 type AuthHandler interface {
 	CreateStaff(ctx *gin.Context)
 	Login(ctx *gin.Context)
+	RefreshToken(ctx *gin.Context)
+	Logout(ctx *gin.Context)
+	EnrollMFA(ctx *gin.Context)
+	VerifyMFA(ctx *gin.Context)
+	ForgotPassword(ctx *gin.Context)
+	ResetPassword(ctx *gin.Context)
+	JWKS(ctx *gin.Context)
+	OIDCLogin(ctx *gin.Context)
+	OIDCCallback(ctx *gin.Context)
+	GetAuthSetting(ctx *gin.Context)
+	UpdateAuthSetting(ctx *gin.Context)
+}
+
+type UserHandler interface {
+	GetUsers(ctx *gin.Context)
+	GetUser(ctx *gin.Context)
+	UpdateUser(ctx *gin.Context)
+	UpdateUserRole(ctx *gin.Context)
+	DeactivateUser(ctx *gin.Context)
+	ReactivateUser(ctx *gin.Context)
+	UnlockUser(ctx *gin.Context)
+	GetMe(ctx *gin.Context)
+	UpdateMe(ctx *gin.Context)
+	ChangePassword(ctx *gin.Context)
+	EnrollMFA(ctx *gin.Context)
+	ConfirmMFA(ctx *gin.Context)
+	DisableMFA(ctx *gin.Context)
+	CreateServiceAccount(ctx *gin.Context)
+}
+
+type APIKeyHandler interface {
+	GetAPIKeys(ctx *gin.Context)
+	CreateAPIKey(ctx *gin.Context)
+	RevokeAPIKey(ctx *gin.Context)
 }
 
 type InterviewHandler interface {
@@ -15,6 +49,36 @@ type InterviewHandler interface {
 	CreateInterviewAppointment(ctx *gin.Context)
 	UpdateInterviewAppointment(ctx *gin.Context)
 	ArchiveInterviewAppointment(ctx *gin.Context)
+	UnarchiveInterviewAppointment(ctx *gin.Context)
+	AssignInterviewers(ctx *gin.Context)
+	GetInterviewerAppointments(ctx *gin.Context)
+	GetInterviewComments(ctx *gin.Context)
 	AddInterviewComment(ctx *gin.Context)
 	UpdateInterviewComment(ctx *gin.Context)
+	DeleteInterviewComment(ctx *gin.Context)
+	GetInterviewCommentRevisions(ctx *gin.Context)
+	GetWorkflow(ctx *gin.Context)
+	UpdateWorkflow(ctx *gin.Context)
+	GetInterviewActivity(ctx *gin.Context)
+}
+
+type CandidateHandler interface {
+	GetCandidates(ctx *gin.Context)
+	GetCandidate(ctx *gin.Context)
+	CreateCandidate(ctx *gin.Context)
+	UpdateCandidate(ctx *gin.Context)
+}
+
+type ScorecardHandler interface {
+	GetScorecardTemplates(ctx *gin.Context)
+	GetScorecardTemplate(ctx *gin.Context)
+	CreateScorecardTemplate(ctx *gin.Context)
+	ArchiveScorecardTemplate(ctx *gin.Context)
+	GetScorecards(ctx *gin.Context)
+	SubmitScorecard(ctx *gin.Context)
+	UpdateScorecard(ctx *gin.Context)
+}
+
+type AuditHandler interface {
+	GetAuditEvents(ctx *gin.Context)
 }
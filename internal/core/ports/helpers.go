@@ -1,6 +1,7 @@
 package ports
 
 import (
+	"context"
 	"robinhood-assignment/internal/core/domains"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -11,8 +12,18 @@ type MyBcrypt interface {
 	CompareHashAndPassword(hashedPassword, password string) error
 }
 
+type Mailer interface {
+	Send(ctx context.Context, mail *domains.Mail) error
+}
+
 type MyJWT interface {
-	NewWithClaims(method jwt.SigningMethod, claims domains.Claims, opts ...jwt.TokenOption) *jwt.Token
+	SignClaims(claims domains.Claims) (string, error)
 	ParseWithClaims(tokenString string, claims *domains.Claims, keyFunc jwt.Keyfunc, opts ...jwt.ParserOption) (*jwt.Token, error)
 	ParseToken(token *jwt.Token) (interface{}, error)
+	PublicKeys() []domains.JSONWebKey
+}
+
+type OIDCProvider interface {
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domains.OIDCIdentity, error)
 }
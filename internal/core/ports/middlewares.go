@@ -7,4 +7,8 @@ import (
 type Middlewares interface {
 	AdminMiddleware(ctx *gin.Context)
 	StaffMiddleware(ctx *gin.Context)
+	RequirePermission(permission string) gin.HandlerFunc
+	APIKeyScope(scope string) gin.HandlerFunc
+	Idempotent(ctx *gin.Context)
+	RequestID(ctx *gin.Context)
 }
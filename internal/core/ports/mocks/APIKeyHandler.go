@@ -0,0 +1,43 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIKeyHandler is an autogenerated mock type for the APIKeyHandler type
+type APIKeyHandler struct {
+	mock.Mock
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx
+func (_m *APIKeyHandler) CreateAPIKey(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetAPIKeys provides a mock function with given fields: ctx
+func (_m *APIKeyHandler) GetAPIKeys(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx
+func (_m *APIKeyHandler) RevokeAPIKey(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+type mockConstructorTestingTNewAPIKeyHandler interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAPIKeyHandler creates a new instance of APIKeyHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAPIKeyHandler(t mockConstructorTestingTNewAPIKeyHandler) *APIKeyHandler {
+	mock := &APIKeyHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
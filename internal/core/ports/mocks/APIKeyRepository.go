@@ -0,0 +1,180 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+
+	time "time"
+)
+
+// APIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type APIKeyRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *APIKeyRepository) Create(ctx context.Context, params *domains.CreateAPIKeyParams) (*domains.APIKey, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateAPIKeyParams) (*domains.APIKey, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateAPIKeyParams) *domains.APIKey); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreateAPIKeyParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *APIKeyRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *APIKeyRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.APIKey, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (*domains.APIKey, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) *domains.APIKey); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByKeyHash provides a mock function with given fields: ctx, keyHash
+func (_m *APIKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*domains.APIKey, error) {
+	ret := _m.Called(ctx, keyHash)
+
+	var r0 *domains.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.APIKey, error)); ok {
+		return rf(ctx, keyHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.APIKey); ok {
+		r0 = rf(ctx, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userID
+func (_m *APIKeyRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) ([]domains.APIKey, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domains.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) ([]domains.APIKey, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) []domains.APIKey); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Revoke provides a mock function with given fields: ctx, id
+func (_m *APIKeyRepository) Revoke(ctx context.Context, id primitive.ObjectID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Touch provides a mock function with given fields: ctx, id, usedAt
+func (_m *APIKeyRepository) Touch(ctx context.Context, id primitive.ObjectID, usedAt time.Time) error {
+	ret := _m.Called(ctx, id, usedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, time.Time) error); ok {
+		r0 = rf(ctx, id, usedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewAPIKeyRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAPIKeyRepository creates a new instance of APIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAPIKeyRepository(t mockConstructorTestingTNewAPIKeyRepository) *APIKeyRepository {
+	mock := &APIKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+	dto "robinhood-assignment/internal/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIKeyService is an autogenerated mock type for the APIKeyService type
+type APIKeyService struct {
+	mock.Mock
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, req
+func (_m *APIKeyService) CreateAPIKey(ctx context.Context, req *dto.CreateAPIKeyRequest) (*domains.IssuedAPIKey, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.IssuedAPIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateAPIKeyRequest) (*domains.IssuedAPIKey, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateAPIKeyRequest) *domains.IssuedAPIKey); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.IssuedAPIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.CreateAPIKeyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAPIKeys provides a mock function with given fields: ctx, userID
+func (_m *APIKeyService) GetAPIKeys(ctx context.Context, userID string) ([]domains.APIKey, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domains.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domains.APIKey, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domains.APIKey); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx, req
+func (_m *APIKeyService) RevokeAPIKey(ctx context.Context, req *dto.RevokeAPIKeyRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.RevokeAPIKeyRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewAPIKeyService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAPIKeyService creates a new instance of APIKeyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAPIKeyService(t mockConstructorTestingTNewAPIKeyService) *APIKeyService {
+	mock := &APIKeyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "robinhood-assignment/internal/dto"
+
+	gin "github.com/gin-gonic/gin"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIKeyValidate is an autogenerated mock type for the APIKeyValidate type
+type APIKeyValidate struct {
+	mock.Mock
+}
+
+// ValidateCreateAPIKey provides a mock function with given fields: ctx
+func (_m *APIKeyValidate) ValidateCreateAPIKey(ctx *gin.Context) (*dto.CreateAPIKeyRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.CreateAPIKeyRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.CreateAPIKeyRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.CreateAPIKeyRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CreateAPIKeyRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetAPIKeys provides a mock function with given fields: ctx
+func (_m *APIKeyValidate) ValidateGetAPIKeys(ctx *gin.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateRevokeAPIKey provides a mock function with given fields: ctx
+func (_m *APIKeyValidate) ValidateRevokeAPIKey(ctx *gin.Context) (*dto.RevokeAPIKeyRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.RevokeAPIKeyRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.RevokeAPIKeyRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.RevokeAPIKeyRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RevokeAPIKeyRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewAPIKeyValidate interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAPIKeyValidate creates a new instance of APIKeyValidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAPIKeyValidate(t mockConstructorTestingTNewAPIKeyValidate) *APIKeyValidate {
+	mock := &APIKeyValidate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,114 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditEventRepository is an autogenerated mock type for the AuditEventRepository type
+type AuditEventRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *AuditEventRepository) Create(ctx context.Context, params *domains.CreateAuditEventParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateAuditEventParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *AuditEventRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx, params
+func (_m *AuditEventRepository) GetAll(ctx context.Context, params *domains.GetAuditEventsParams) ([]domains.AuditEvent, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domains.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetAuditEventsParams) ([]domains.AuditEvent, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetAuditEventsParams) []domains.AuditEvent); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.GetAuditEventsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedactAppointments provides a mock function with given fields: ctx, appointmentIDs
+func (_m *AuditEventRepository) RedactAppointments(ctx context.Context, appointmentIDs []primitive.ObjectID) error {
+	ret := _m.Called(ctx, appointmentIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID) error); ok {
+		r0 = rf(ctx, appointmentIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RedactTarget provides a mock function with given fields: ctx, targetID
+func (_m *AuditEventRepository) RedactTarget(ctx context.Context, targetID primitive.ObjectID) error {
+	ret := _m.Called(ctx, targetID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewAuditEventRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuditEventRepository creates a new instance of AuditEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuditEventRepository(t mockConstructorTestingTNewAuditEventRepository) *AuditEventRepository {
+	mock := &AuditEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,33 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditHandler is an autogenerated mock type for the AuditHandler type
+type AuditHandler struct {
+	mock.Mock
+}
+
+// GetAuditEvents provides a mock function with given fields: ctx
+func (_m *AuditHandler) GetAuditEvents(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+type mockConstructorTestingTNewAuditHandler interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuditHandler creates a new instance of AuditHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuditHandler(t mockConstructorTestingTNewAuditHandler) *AuditHandler {
+	mock := &AuditHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+	dto "robinhood-assignment/internal/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditService is an autogenerated mock type for the AuditService type
+type AuditService struct {
+	mock.Mock
+}
+
+// GetAuditEvents provides a mock function with given fields: ctx, req
+func (_m *AuditService) GetAuditEvents(ctx context.Context, req *dto.GetAuditEventsRequest) ([]domains.AuditEvent, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []domains.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetAuditEventsRequest) ([]domains.AuditEvent, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetAuditEventsRequest) []domains.AuditEvent); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetAuditEventsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewAuditService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuditService creates a new instance of AuditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuditService(t mockConstructorTestingTNewAuditService) *AuditService {
+	mock := &AuditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "robinhood-assignment/internal/dto"
+
+	gin "github.com/gin-gonic/gin"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditValidate is an autogenerated mock type for the AuditValidate type
+type AuditValidate struct {
+	mock.Mock
+}
+
+// ValidateGetAuditEvents provides a mock function with given fields: ctx
+func (_m *AuditValidate) ValidateGetAuditEvents(ctx *gin.Context) (*dto.GetAuditEventsRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.GetAuditEventsRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetAuditEventsRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetAuditEventsRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetAuditEventsRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewAuditValidate interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuditValidate creates a new instance of AuditValidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuditValidate(t mockConstructorTestingTNewAuditValidate) *AuditValidate {
+	mock := &AuditValidate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -17,11 +17,66 @@ func (_m *AuthHandler) CreateStaff(ctx *gin.Context) {
 	_m.Called(ctx)
 }
 
+// EnrollMFA provides a mock function with given fields: ctx
+func (_m *AuthHandler) EnrollMFA(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// ForgotPassword provides a mock function with given fields: ctx
+func (_m *AuthHandler) ForgotPassword(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetAuthSetting provides a mock function with given fields: ctx
+func (_m *AuthHandler) GetAuthSetting(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// JWKS provides a mock function with given fields: ctx
+func (_m *AuthHandler) JWKS(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
 // Login provides a mock function with given fields: ctx
 func (_m *AuthHandler) Login(ctx *gin.Context) {
 	_m.Called(ctx)
 }
 
+// Logout provides a mock function with given fields: ctx
+func (_m *AuthHandler) Logout(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// OIDCCallback provides a mock function with given fields: ctx
+func (_m *AuthHandler) OIDCCallback(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// OIDCLogin provides a mock function with given fields: ctx
+func (_m *AuthHandler) OIDCLogin(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// RefreshToken provides a mock function with given fields: ctx
+func (_m *AuthHandler) RefreshToken(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// ResetPassword provides a mock function with given fields: ctx
+func (_m *AuthHandler) ResetPassword(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// UpdateAuthSetting provides a mock function with given fields: ctx
+func (_m *AuthHandler) UpdateAuthSetting(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// VerifyMFA provides a mock function with given fields: ctx
+func (_m *AuthHandler) VerifyMFA(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
 type mockConstructorTestingTNewAuthHandler interface {
 	mock.TestingT
 	Cleanup(func())
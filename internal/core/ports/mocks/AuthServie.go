@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	domains "robinhood-assignment/internal/core/domains"
 	dto "robinhood-assignment/internal/dto"
 
 	mock "github.com/stretchr/testify/mock"
@@ -14,6 +15,32 @@ type AuthServie struct {
 	mock.Mock
 }
 
+// CompleteOIDCLogin provides a mock function with given fields: ctx, req
+func (_m *AuthServie) CompleteOIDCLogin(ctx context.Context, req *dto.OIDCCallbackRequest) (*domains.AuthToken, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.AuthToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.OIDCCallbackRequest) (*domains.AuthToken, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.OIDCCallbackRequest) *domains.AuthToken); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.AuthToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.OIDCCallbackRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateStaff provides a mock function with given fields: ctx, req
 func (_m *AuthServie) CreateStaff(ctx context.Context, req *dto.CreateStaffRequest) error {
 	ret := _m.Called(ctx, req)
@@ -28,19 +55,103 @@ func (_m *AuthServie) CreateStaff(ctx context.Context, req *dto.CreateStaffReque
 	return r0
 }
 
+// EnrollMFA provides a mock function with given fields: ctx, req
+func (_m *AuthServie) EnrollMFA(ctx context.Context, req *dto.MFAEnrollRequest) (*domains.TOTPEnrollment, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.TOTPEnrollment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.MFAEnrollRequest) (*domains.TOTPEnrollment, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.MFAEnrollRequest) *domains.TOTPEnrollment); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.TOTPEnrollment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.MFAEnrollRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ForgotPassword provides a mock function with given fields: ctx, req
+func (_m *AuthServie) ForgotPassword(ctx context.Context, req *dto.ForgotPasswordRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ForgotPasswordRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAuthSetting provides a mock function with given fields: ctx
+func (_m *AuthServie) GetAuthSetting(ctx context.Context) (*domains.AuthSetting, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *domains.AuthSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domains.AuthSetting, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domains.AuthSetting); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.AuthSetting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJWKS provides a mock function with given fields:
+func (_m *AuthServie) GetJWKS() []domains.JSONWebKey {
+	ret := _m.Called()
+
+	var r0 []domains.JSONWebKey
+	if rf, ok := ret.Get(0).(func() []domains.JSONWebKey); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.JSONWebKey)
+		}
+	}
+
+	return r0
+}
+
 // Login provides a mock function with given fields: ctx, req
-func (_m *AuthServie) Login(ctx context.Context, req *dto.LoginRequest) (string, error) {
+func (_m *AuthServie) Login(ctx context.Context, req *dto.LoginRequest) (*domains.AuthToken, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 string
+	var r0 *domains.AuthToken
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dto.LoginRequest) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.LoginRequest) (*domains.AuthToken, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *dto.LoginRequest) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.LoginRequest) *domains.AuthToken); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.AuthToken)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, *dto.LoginRequest) error); ok {
@@ -52,6 +163,136 @@ func (_m *AuthServie) Login(ctx context.Context, req *dto.LoginRequest) (string,
 	return r0, r1
 }
 
+// Logout provides a mock function with given fields: ctx, req
+func (_m *AuthServie) Logout(ctx context.Context, req *dto.LogoutRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.LogoutRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshToken provides a mock function with given fields: ctx, req
+func (_m *AuthServie) RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*domains.AuthToken, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.AuthToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.RefreshTokenRequest) (*domains.AuthToken, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.RefreshTokenRequest) *domains.AuthToken); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.AuthToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.RefreshTokenRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetPassword provides a mock function with given fields: ctx, req
+func (_m *AuthServie) ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ResetPasswordRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartOIDCLogin provides a mock function with given fields: ctx
+func (_m *AuthServie) StartOIDCLogin(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAuthSetting provides a mock function with given fields: ctx, req
+func (_m *AuthServie) UpdateAuthSetting(ctx context.Context, req *dto.UpdateAuthSettingRequest) (*domains.AuthSetting, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.AuthSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateAuthSettingRequest) (*domains.AuthSetting, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateAuthSettingRequest) *domains.AuthSetting); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.AuthSetting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.UpdateAuthSettingRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyMFA provides a mock function with given fields: ctx, req
+func (_m *AuthServie) VerifyMFA(ctx context.Context, req *dto.MFAVerifyRequest) (*domains.AuthToken, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.AuthToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.MFAVerifyRequest) (*domains.AuthToken, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.MFAVerifyRequest) *domains.AuthToken); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.AuthToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.MFAVerifyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewAuthServie interface {
 	mock.TestingT
 	Cleanup(func())
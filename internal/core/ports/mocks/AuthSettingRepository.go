@@ -0,0 +1,82 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuthSettingRepository is an autogenerated mock type for the AuthSettingRepository type
+type AuthSettingRepository struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx
+func (_m *AuthSettingRepository) Get(ctx context.Context) (*domains.AuthSetting, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *domains.AuthSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domains.AuthSetting, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domains.AuthSetting); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.AuthSetting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, params
+func (_m *AuthSettingRepository) Update(ctx context.Context, params *domains.UpdateAuthSettingParams) (*domains.AuthSetting, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.AuthSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateAuthSettingParams) (*domains.AuthSetting, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateAuthSettingParams) *domains.AuthSetting); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.AuthSetting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.UpdateAuthSettingParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewAuthSettingRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAuthSettingRepository creates a new instance of AuthSettingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAuthSettingRepository(t mockConstructorTestingTNewAuthSettingRepository) *AuthSettingRepository {
+	mock := &AuthSettingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
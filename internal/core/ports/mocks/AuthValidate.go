@@ -41,6 +41,32 @@ func (_m *AuthValidate) ValidateCreateStaff(ctx *gin.Context) (*dto.CreateStaffR
 	return r0, r1
 }
 
+// ValidateForgotPassword provides a mock function with given fields: ctx
+func (_m *AuthValidate) ValidateForgotPassword(ctx *gin.Context) (*dto.ForgotPasswordRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.ForgotPasswordRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.ForgotPasswordRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.ForgotPasswordRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ForgotPasswordRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ValidateLogin provides a mock function with given fields: ctx
 func (_m *AuthValidate) ValidateLogin(ctx *gin.Context) (*dto.LoginRequest, error) {
 	ret := _m.Called(ctx)
@@ -67,6 +93,188 @@ func (_m *AuthValidate) ValidateLogin(ctx *gin.Context) (*dto.LoginRequest, erro
 	return r0, r1
 }
 
+// ValidateLogout provides a mock function with given fields: ctx
+func (_m *AuthValidate) ValidateLogout(ctx *gin.Context) (*dto.LogoutRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.LogoutRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.LogoutRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.LogoutRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LogoutRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateMFAEnroll provides a mock function with given fields: ctx
+func (_m *AuthValidate) ValidateMFAEnroll(ctx *gin.Context) (*dto.MFAEnrollRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.MFAEnrollRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.MFAEnrollRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.MFAEnrollRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MFAEnrollRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateMFAVerify provides a mock function with given fields: ctx
+func (_m *AuthValidate) ValidateMFAVerify(ctx *gin.Context) (*dto.MFAVerifyRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.MFAVerifyRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.MFAVerifyRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.MFAVerifyRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MFAVerifyRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateOIDCCallback provides a mock function with given fields: ctx
+func (_m *AuthValidate) ValidateOIDCCallback(ctx *gin.Context) (*dto.OIDCCallbackRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.OIDCCallbackRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.OIDCCallbackRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.OIDCCallbackRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OIDCCallbackRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateRefreshToken provides a mock function with given fields: ctx
+func (_m *AuthValidate) ValidateRefreshToken(ctx *gin.Context) (*dto.RefreshTokenRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.RefreshTokenRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.RefreshTokenRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.RefreshTokenRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RefreshTokenRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateResetPassword provides a mock function with given fields: ctx
+func (_m *AuthValidate) ValidateResetPassword(ctx *gin.Context) (*dto.ResetPasswordRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.ResetPasswordRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.ResetPasswordRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.ResetPasswordRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ResetPasswordRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateUpdateAuthSetting provides a mock function with given fields: ctx
+func (_m *AuthValidate) ValidateUpdateAuthSetting(ctx *gin.Context) (*dto.UpdateAuthSettingRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UpdateAuthSettingRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UpdateAuthSettingRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UpdateAuthSettingRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UpdateAuthSettingRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewAuthValidate interface {
 	mock.TestingT
 	Cleanup(func())
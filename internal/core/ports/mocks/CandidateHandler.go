@@ -0,0 +1,48 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CandidateHandler is an autogenerated mock type for the CandidateHandler type
+type CandidateHandler struct {
+	mock.Mock
+}
+
+// CreateCandidate provides a mock function with given fields: ctx
+func (_m *CandidateHandler) CreateCandidate(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetCandidate provides a mock function with given fields: ctx
+func (_m *CandidateHandler) GetCandidate(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetCandidates provides a mock function with given fields: ctx
+func (_m *CandidateHandler) GetCandidates(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// UpdateCandidate provides a mock function with given fields: ctx
+func (_m *CandidateHandler) UpdateCandidate(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+type mockConstructorTestingTNewCandidateHandler interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCandidateHandler creates a new instance of CandidateHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCandidateHandler(t mockConstructorTestingTNewCandidateHandler) *CandidateHandler {
+	mock := &CandidateHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
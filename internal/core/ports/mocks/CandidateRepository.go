@@ -0,0 +1,176 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CandidateRepository is an autogenerated mock type for the CandidateRepository type
+type CandidateRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *CandidateRepository) Create(ctx context.Context, params *domains.CreateCandidateParams) (*domains.Candidate, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateCandidateParams) (*domains.Candidate, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateCandidateParams) *domains.Candidate); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreateCandidateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *CandidateRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *CandidateRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.Candidate, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (*domains.Candidate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) *domains.Candidate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, params
+func (_m *CandidateRepository) GetAll(ctx context.Context, params *domains.GetCandidatesParams) ([]domains.Candidate, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domains.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetCandidatesParams) ([]domains.Candidate, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetCandidatesParams) []domains.Candidate); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.GetCandidatesParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWithInterviews provides a mock function with given fields: ctx, id
+func (_m *CandidateRepository) GetWithInterviews(ctx context.Context, id primitive.ObjectID) (*domains.Candidate, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (*domains.Candidate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) *domains.Candidate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, params
+func (_m *CandidateRepository) Update(ctx context.Context, params *domains.UpdateCandidateParams) (*domains.Candidate, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateCandidateParams) (*domains.Candidate, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateCandidateParams) *domains.Candidate); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.UpdateCandidateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCandidateRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCandidateRepository creates a new instance of CandidateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCandidateRepository(t mockConstructorTestingTNewCandidateRepository) *CandidateRepository {
+	mock := &CandidateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+	dto "robinhood-assignment/internal/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CandidateService is an autogenerated mock type for the CandidateService type
+type CandidateService struct {
+	mock.Mock
+}
+
+// CreateCandidate provides a mock function with given fields: ctx, req
+func (_m *CandidateService) CreateCandidate(ctx context.Context, req *dto.CreateCandidateRequest) (*domains.Candidate, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateCandidateRequest) (*domains.Candidate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateCandidateRequest) *domains.Candidate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.CreateCandidateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCandidate provides a mock function with given fields: ctx, id
+func (_m *CandidateService) GetCandidate(ctx context.Context, id string) (*domains.Candidate, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.Candidate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.Candidate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCandidates provides a mock function with given fields: ctx, req
+func (_m *CandidateService) GetCandidates(ctx context.Context, req *dto.GetCandidatesRequest) ([]domains.Candidate, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []domains.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetCandidatesRequest) ([]domains.Candidate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetCandidatesRequest) []domains.Candidate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetCandidatesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCandidate provides a mock function with given fields: ctx, req
+func (_m *CandidateService) UpdateCandidate(ctx context.Context, req *dto.UpdateCandidateRequest) (*domains.Candidate, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateCandidateRequest) (*domains.Candidate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateCandidateRequest) *domains.Candidate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.UpdateCandidateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCandidateService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCandidateService creates a new instance of CandidateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCandidateService(t mockConstructorTestingTNewCandidateService) *CandidateService {
+	mock := &CandidateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
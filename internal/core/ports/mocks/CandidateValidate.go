@@ -0,0 +1,133 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "robinhood-assignment/internal/dto"
+
+	gin "github.com/gin-gonic/gin"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CandidateValidate is an autogenerated mock type for the CandidateValidate type
+type CandidateValidate struct {
+	mock.Mock
+}
+
+// ValidateCreateCandidate provides a mock function with given fields: ctx
+func (_m *CandidateValidate) ValidateCreateCandidate(ctx *gin.Context) (*dto.CreateCandidateRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.CreateCandidateRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.CreateCandidateRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.CreateCandidateRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CreateCandidateRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetCandidate provides a mock function with given fields: ctx
+func (_m *CandidateValidate) ValidateGetCandidate(ctx *gin.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetCandidates provides a mock function with given fields: ctx
+func (_m *CandidateValidate) ValidateGetCandidates(ctx *gin.Context) (*dto.GetCandidatesRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.GetCandidatesRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetCandidatesRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetCandidatesRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetCandidatesRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateUpdateCandidate provides a mock function with given fields: ctx
+func (_m *CandidateValidate) ValidateUpdateCandidate(ctx *gin.Context) (*dto.UpdateCandidateRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UpdateCandidateRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UpdateCandidateRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UpdateCandidateRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UpdateCandidateRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCandidateValidate interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCandidateValidate creates a new instance of CandidateValidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCandidateValidate(t mockConstructorTestingTNewCandidateValidate) *CandidateValidate {
+	mock := &CandidateValidate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,126 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IdempotencyKeyRepository is an autogenerated mock type for the IdempotencyKeyRepository type
+type IdempotencyKeyRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *IdempotencyKeyRepository) Create(ctx context.Context, params *domains.CreateIdempotencyKeyParams) (*domains.IdempotencyKey, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateIdempotencyKeyParams) (*domains.IdempotencyKey, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateIdempotencyKeyParams) *domains.IdempotencyKey); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.IdempotencyKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreateIdempotencyKeyParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *IdempotencyKeyRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *IdempotencyKeyRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, userID, key
+func (_m *IdempotencyKeyRepository) Get(ctx context.Context, userID primitive.ObjectID, key string) (*domains.IdempotencyKey, error) {
+	ret := _m.Called(ctx, userID, key)
+
+	var r0 *domains.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string) (*domains.IdempotencyKey, error)); ok {
+		return rf(ctx, userID, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string) *domains.IdempotencyKey); ok {
+		r0 = rf(ctx, userID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.IdempotencyKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID, string) error); ok {
+		r1 = rf(ctx, userID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveResponse provides a mock function with given fields: ctx, params
+func (_m *IdempotencyKeyRepository) SaveResponse(ctx context.Context, params *domains.SaveIdempotentResponseParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.SaveIdempotentResponseParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewIdempotencyKeyRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewIdempotencyKeyRepository creates a new instance of IdempotencyKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewIdempotencyKeyRepository(t mockConstructorTestingTNewIdempotencyKeyRepository) *IdempotencyKeyRepository {
+	mock := &IdempotencyKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
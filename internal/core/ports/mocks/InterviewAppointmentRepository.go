@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+
+	time "time"
 )
 
 // InterviewAppointmentRepository is an autogenerated mock type for the InterviewAppointmentRepository type
@@ -16,12 +18,12 @@ type InterviewAppointmentRepository struct {
 	mock.Mock
 }
 
-// AddComment provides a mock function with given fields: ctx, params
-func (_m *InterviewAppointmentRepository) AddComment(ctx context.Context, params *domains.AddInterviewCommentParams) error {
+// ArchiveInterviewAppointment provides a mock function with given fields: ctx, params
+func (_m *InterviewAppointmentRepository) ArchiveInterviewAppointment(ctx context.Context, params *domains.ArchiveInterviewAppointmentParams) error {
 	ret := _m.Called(ctx, params)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domains.AddInterviewCommentParams) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.ArchiveInterviewAppointmentParams) error); ok {
 		r0 = rf(ctx, params)
 	} else {
 		r0 = ret.Error(0)
@@ -30,13 +32,65 @@ func (_m *InterviewAppointmentRepository) AddComment(ctx context.Context, params
 	return r0
 }
 
-// ArchiveInterviewAppointment provides a mock function with given fields: ctx, id
-func (_m *InterviewAppointmentRepository) ArchiveInterviewAppointment(ctx context.Context, id primitive.ObjectID) error {
-	ret := _m.Called(ctx, id)
+// Create provides a mock function with given fields: ctx, params
+func (_m *InterviewAppointmentRepository) Create(ctx context.Context, params *domains.CreateInterviewAppointmentParams) (*domains.CreateInterviewAppointment, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.CreateInterviewAppointment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateInterviewAppointmentParams) (*domains.CreateInterviewAppointment, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateInterviewAppointmentParams) *domains.CreateInterviewAppointment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.CreateInterviewAppointment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreateInterviewAppointmentParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteArchived provides a mock function with given fields: ctx, ids, before
+func (_m *InterviewAppointmentRepository) DeleteArchived(ctx context.Context, ids []primitive.ObjectID, before time.Time) ([]primitive.ObjectID, error) {
+	ret := _m.Called(ctx, ids, before)
+
+	var r0 []primitive.ObjectID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID, time.Time) ([]primitive.ObjectID, error)); ok {
+		return rf(ctx, ids, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID, time.Time) []primitive.ObjectID); ok {
+		r0 = rf(ctx, ids, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]primitive.ObjectID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []primitive.ObjectID, time.Time) error); ok {
+		r1 = rf(ctx, ids, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *InterviewAppointmentRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -44,24 +98,24 @@ func (_m *InterviewAppointmentRepository) ArchiveInterviewAppointment(ctx contex
 	return r0
 }
 
-// Create provides a mock function with given fields: ctx, params
-func (_m *InterviewAppointmentRepository) Create(ctx context.Context, params *domains.CreateInterviewAppointmentParams) (*domains.CreateInterviewAppointment, error) {
+// FindConflicts provides a mock function with given fields: ctx, params
+func (_m *InterviewAppointmentRepository) FindConflicts(ctx context.Context, params *domains.FindInterviewConflictsParams) ([]domains.InterviewAppointment, error) {
 	ret := _m.Called(ctx, params)
 
-	var r0 *domains.CreateInterviewAppointment
+	var r0 []domains.InterviewAppointment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateInterviewAppointmentParams) (*domains.CreateInterviewAppointment, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.FindInterviewConflictsParams) ([]domains.InterviewAppointment, error)); ok {
 		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateInterviewAppointmentParams) *domains.CreateInterviewAppointment); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.FindInterviewConflictsParams) []domains.InterviewAppointment); ok {
 		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domains.CreateInterviewAppointment)
+			r0 = ret.Get(0).([]domains.InterviewAppointment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreateInterviewAppointmentParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.FindInterviewConflictsParams) error); ok {
 		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
@@ -96,25 +150,112 @@ func (_m *InterviewAppointmentRepository) Get(ctx context.Context, id primitive.
 	return r0, r1
 }
 
-// GetAll provides a mock function with given fields: ctx, offset, limit
-func (_m *InterviewAppointmentRepository) GetAll(ctx context.Context, offset uint32, limit uint32) ([]domains.InterviewAppointment, error) {
-	ret := _m.Called(ctx, offset, limit)
+// GetAll provides a mock function with given fields: ctx, params
+func (_m *InterviewAppointmentRepository) GetAll(ctx context.Context, params *domains.GetInterviewAppointmentsParams) ([]domains.InterviewAppointment, *int64, error) {
+	ret := _m.Called(ctx, params)
 
 	var r0 []domains.InterviewAppointment
+	var r1 *int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetInterviewAppointmentsParams) ([]domains.InterviewAppointment, *int64, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetInterviewAppointmentsParams) []domains.InterviewAppointment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.InterviewAppointment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.GetInterviewAppointmentsParams) *int64); ok {
+		r1 = rf(ctx, params)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*int64)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *domains.GetInterviewAppointmentsParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetArchived provides a mock function with given fields: ctx, id
+func (_m *InterviewAppointmentRepository) GetArchived(ctx context.Context, id primitive.ObjectID) (*domains.InterviewAppointment, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.InterviewAppointment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32) ([]domains.InterviewAppointment, error)); ok {
-		return rf(ctx, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (*domains.InterviewAppointment, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32) []domains.InterviewAppointment); ok {
-		r0 = rf(ctx, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) *domains.InterviewAppointment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.InterviewAppointment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetArchivedIDsBefore provides a mock function with given fields: ctx, before
+func (_m *InterviewAppointmentRepository) GetArchivedIDsBefore(ctx context.Context, before time.Time) ([]primitive.ObjectID, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 []primitive.ObjectID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]primitive.ObjectID, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []primitive.ObjectID); ok {
+		r0 = rf(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]primitive.ObjectID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlindByIDs provides a mock function with given fields: ctx, ids
+func (_m *InterviewAppointmentRepository) GetBlindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]domains.InterviewAppointment, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []domains.InterviewAppointment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID) ([]domains.InterviewAppointment, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID) []domains.InterviewAppointment); ok {
+		r0 = rf(ctx, ids)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domains.InterviewAppointment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint32) error); ok {
-		r1 = rf(ctx, offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, []primitive.ObjectID) error); ok {
+		r1 = rf(ctx, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -122,6 +263,72 @@ func (_m *InterviewAppointmentRepository) GetAll(ctx context.Context, offset uin
 	return r0, r1
 }
 
+// GetByInterviewer provides a mock function with given fields: ctx, params
+func (_m *InterviewAppointmentRepository) GetByInterviewer(ctx context.Context, params *domains.GetInterviewerAppointmentsParams) ([]domains.InterviewAppointment, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domains.InterviewAppointment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetInterviewerAppointmentsParams) ([]domains.InterviewAppointment, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetInterviewerAppointmentsParams) []domains.InterviewAppointment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.InterviewAppointment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.GetInterviewerAppointmentsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatusesInUse provides a mock function with given fields: ctx
+func (_m *InterviewAppointmentRepository) GetStatusesInUse(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnarchiveInterviewAppointment provides a mock function with given fields: ctx, id
+func (_m *InterviewAppointmentRepository) UnarchiveInterviewAppointment(ctx context.Context, id primitive.ObjectID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, params
 func (_m *InterviewAppointmentRepository) Update(ctx context.Context, params *domains.UpdateInterviewAppointmentParams) (*domains.InterviewAppointment, error) {
 	ret := _m.Called(ctx, params)
@@ -148,12 +355,12 @@ func (_m *InterviewAppointmentRepository) Update(ctx context.Context, params *do
 	return r0, r1
 }
 
-// UpdateComment provides a mock function with given fields: ctx, params
-func (_m *InterviewAppointmentRepository) UpdateComment(ctx context.Context, params *domains.UpdateInterviewCommentParams) error {
+// UpdateInterviewers provides a mock function with given fields: ctx, params
+func (_m *InterviewAppointmentRepository) UpdateInterviewers(ctx context.Context, params *domains.UpdateInterviewersParams) error {
 	ret := _m.Called(ctx, params)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateInterviewCommentParams) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateInterviewersParams) error); ok {
 		r0 = rf(ctx, params)
 	} else {
 		r0 = ret.Error(0)
@@ -0,0 +1,254 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// InterviewCommentRepository is an autogenerated mock type for the InterviewCommentRepository type
+type InterviewCommentRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *InterviewCommentRepository) Create(ctx context.Context, params *domains.AddInterviewCommentParams) (*domains.AddInterviewComment, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.AddInterviewComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.AddInterviewCommentParams) (*domains.AddInterviewComment, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.AddInterviewCommentParams) *domains.AddInterviewComment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.AddInterviewComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.AddInterviewCommentParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, params
+func (_m *InterviewCommentRepository) Delete(ctx context.Context, params *domains.DeleteInterviewCommentParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.DeleteInterviewCommentParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByAppointments provides a mock function with given fields: ctx, appointmentIDs
+func (_m *InterviewCommentRepository) DeleteByAppointments(ctx context.Context, appointmentIDs []primitive.ObjectID) error {
+	ret := _m.Called(ctx, appointmentIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID) error); ok {
+		r0 = rf(ctx, appointmentIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *InterviewCommentRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExistsByAuthor provides a mock function with given fields: ctx, appointmentID, userID
+func (_m *InterviewCommentRepository) ExistsByAuthor(ctx context.Context, appointmentID primitive.ObjectID, userID primitive.ObjectID) (bool, error) {
+	ret := _m.Called(ctx, appointmentID, userID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, primitive.ObjectID) (bool, error)); ok {
+		return rf(ctx, appointmentID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, primitive.ObjectID) bool); ok {
+		r0 = rf(ctx, appointmentID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, appointmentID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *InterviewCommentRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.InterviewComment, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.InterviewComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (*domains.InterviewComment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) *domains.InterviewComment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.InterviewComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByAppointment provides a mock function with given fields: ctx, params
+func (_m *InterviewCommentRepository) GetByAppointment(ctx context.Context, params *domains.GetInterviewCommentsParams) ([]domains.InterviewComment, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domains.InterviewComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetInterviewCommentsParams) ([]domains.InterviewComment, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetInterviewCommentsParams) []domains.InterviewComment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.InterviewComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.GetInterviewCommentsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MigrateEmbeddedComments provides a mock function with given fields: ctx
+func (_m *InterviewCommentRepository) MigrateEmbeddedComments(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Purge provides a mock function with given fields: ctx, appointmentID, id
+func (_m *InterviewCommentRepository) Purge(ctx context.Context, appointmentID primitive.ObjectID, id primitive.ObjectID) error {
+	ret := _m.Called(ctx, appointmentID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, appointmentID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchAppointmentIDs provides a mock function with given fields: ctx, params
+func (_m *InterviewCommentRepository) SearchAppointmentIDs(ctx context.Context, params *domains.SearchInterviewCommentsParams) ([]primitive.ObjectID, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []primitive.ObjectID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.SearchInterviewCommentsParams) ([]primitive.ObjectID, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.SearchInterviewCommentsParams) []primitive.ObjectID); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]primitive.ObjectID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.SearchInterviewCommentsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, params
+func (_m *InterviewCommentRepository) Update(ctx context.Context, params *domains.UpdateInterviewCommentParams) error {
+	ret := _m.Called(ctx, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateInterviewCommentParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewInterviewCommentRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewInterviewCommentRepository creates a new instance of InterviewCommentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewInterviewCommentRepository(t mockConstructorTestingTNewInterviewCommentRepository) *InterviewCommentRepository {
+	mock := &InterviewCommentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
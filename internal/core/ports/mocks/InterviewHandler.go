@@ -22,11 +22,26 @@ func (_m *InterviewHandler) ArchiveInterviewAppointment(ctx *gin.Context) {
 	_m.Called(ctx)
 }
 
+// AssignInterviewers provides a mock function with given fields: ctx
+func (_m *InterviewHandler) AssignInterviewers(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
 // CreateInterviewAppointment provides a mock function with given fields: ctx
 func (_m *InterviewHandler) CreateInterviewAppointment(ctx *gin.Context) {
 	_m.Called(ctx)
 }
 
+// DeleteInterviewComment provides a mock function with given fields: ctx
+func (_m *InterviewHandler) DeleteInterviewComment(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetInterviewActivity provides a mock function with given fields: ctx
+func (_m *InterviewHandler) GetInterviewActivity(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
 // GetInterviewAppointment provides a mock function with given fields: ctx
 func (_m *InterviewHandler) GetInterviewAppointment(ctx *gin.Context) {
 	_m.Called(ctx)
@@ -37,6 +52,31 @@ func (_m *InterviewHandler) GetInterviewAppointments(ctx *gin.Context) {
 	_m.Called(ctx)
 }
 
+// GetInterviewCommentRevisions provides a mock function with given fields: ctx
+func (_m *InterviewHandler) GetInterviewCommentRevisions(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetInterviewComments provides a mock function with given fields: ctx
+func (_m *InterviewHandler) GetInterviewComments(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetInterviewerAppointments provides a mock function with given fields: ctx
+func (_m *InterviewHandler) GetInterviewerAppointments(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetWorkflow provides a mock function with given fields: ctx
+func (_m *InterviewHandler) GetWorkflow(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// UnarchiveInterviewAppointment provides a mock function with given fields: ctx
+func (_m *InterviewHandler) UnarchiveInterviewAppointment(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
 // UpdateInterviewAppointment provides a mock function with given fields: ctx
 func (_m *InterviewHandler) UpdateInterviewAppointment(ctx *gin.Context) {
 	_m.Called(ctx)
@@ -47,6 +87,11 @@ func (_m *InterviewHandler) UpdateInterviewComment(ctx *gin.Context) {
 	_m.Called(ctx)
 }
 
+// UpdateWorkflow provides a mock function with given fields: ctx
+func (_m *InterviewHandler) UpdateWorkflow(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
 type mockConstructorTestingTNewInterviewHandler interface {
 	mock.TestingT
 	Cleanup(func())
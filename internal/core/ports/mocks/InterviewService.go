@@ -29,13 +29,27 @@ func (_m *InterviewService) AddInterviewComment(ctx context.Context, req *dto.Ad
 	return r0
 }
 
-// ArchiveInterviewAppointment provides a mock function with given fields: ctx, id
-func (_m *InterviewService) ArchiveInterviewAppointment(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// ArchiveInterviewAppointment provides a mock function with given fields: ctx, req
+func (_m *InterviewService) ArchiveInterviewAppointment(ctx context.Context, req *dto.ArchiveInterviewAppointmentRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ArchiveInterviewAppointmentRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssignInterviewers provides a mock function with given fields: ctx, req
+func (_m *InterviewService) AssignInterviewers(ctx context.Context, req *dto.AssignInterviewersRequest) error {
+	ret := _m.Called(ctx, req)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.AssignInterviewersRequest) error); ok {
+		r0 = rf(ctx, req)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -69,25 +83,143 @@ func (_m *InterviewService) CreateInterviewAppointment(ctx context.Context, req
 	return r0, r1
 }
 
-// GetInterviewAppointment provides a mock function with given fields: ctx, id
-func (_m *InterviewService) GetInterviewAppointment(ctx context.Context, id string) (*domains.InterviewAppointment, error) {
-	ret := _m.Called(ctx, id)
+// DeleteInterviewComment provides a mock function with given fields: ctx, req
+func (_m *InterviewService) DeleteInterviewComment(ctx context.Context, req *dto.DeleteInterviewCommentRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.DeleteInterviewCommentRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetInterviewActivity provides a mock function with given fields: ctx, req
+func (_m *InterviewService) GetInterviewActivity(ctx context.Context, req *dto.GetInterviewActivityRequest) ([]domains.AuditEvent, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []domains.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewActivityRequest) ([]domains.AuditEvent, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewActivityRequest) []domains.AuditEvent); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetInterviewActivityRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInterviewAppointment provides a mock function with given fields: ctx, req
+func (_m *InterviewService) GetInterviewAppointment(ctx context.Context, req *dto.GetInterviewAppointmentRequest) (*domains.InterviewAppointment, error) {
+	ret := _m.Called(ctx, req)
 
 	var r0 *domains.InterviewAppointment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.InterviewAppointment, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewAppointmentRequest) (*domains.InterviewAppointment, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.InterviewAppointment); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewAppointmentRequest) *domains.InterviewAppointment); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domains.InterviewAppointment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetInterviewAppointmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInterviewAppointments provides a mock function with given fields: ctx, req
+func (_m *InterviewService) GetInterviewAppointments(ctx context.Context, req *dto.GetInterviewAppointmentsRequest) (*domains.InterviewAppointmentPage, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.InterviewAppointmentPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewAppointmentsRequest) (*domains.InterviewAppointmentPage, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewAppointmentsRequest) *domains.InterviewAppointmentPage); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.InterviewAppointmentPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetInterviewAppointmentsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInterviewCommentRevisions provides a mock function with given fields: ctx, req
+func (_m *InterviewService) GetInterviewCommentRevisions(ctx context.Context, req *dto.GetInterviewCommentRevisionsRequest) ([]domains.InterviewCommentRevision, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []domains.InterviewCommentRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewCommentRevisionsRequest) ([]domains.InterviewCommentRevision, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewCommentRevisionsRequest) []domains.InterviewCommentRevision); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.InterviewCommentRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetInterviewCommentRevisionsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInterviewComments provides a mock function with given fields: ctx, req
+func (_m *InterviewService) GetInterviewComments(ctx context.Context, req *dto.GetInterviewCommentsRequest) ([]domains.InterviewComment, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []domains.InterviewComment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewCommentsRequest) ([]domains.InterviewComment, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewCommentsRequest) []domains.InterviewComment); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.InterviewComment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetInterviewCommentsRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -95,25 +227,25 @@ func (_m *InterviewService) GetInterviewAppointment(ctx context.Context, id stri
 	return r0, r1
 }
 
-// GetInterviewAppointments provides a mock function with given fields: ctx, offset, limit
-func (_m *InterviewService) GetInterviewAppointments(ctx context.Context, offset uint32, limit uint32) ([]domains.InterviewAppointment, error) {
-	ret := _m.Called(ctx, offset, limit)
+// GetInterviewerAppointments provides a mock function with given fields: ctx, req
+func (_m *InterviewService) GetInterviewerAppointments(ctx context.Context, req *dto.GetInterviewerAppointmentsRequest) ([]domains.InterviewAppointment, error) {
+	ret := _m.Called(ctx, req)
 
 	var r0 []domains.InterviewAppointment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32) ([]domains.InterviewAppointment, error)); ok {
-		return rf(ctx, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewerAppointmentsRequest) ([]domains.InterviewAppointment, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32) []domains.InterviewAppointment); ok {
-		r0 = rf(ctx, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetInterviewerAppointmentsRequest) []domains.InterviewAppointment); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domains.InterviewAppointment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint32) error); ok {
-		r1 = rf(ctx, offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetInterviewerAppointmentsRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -121,12 +253,62 @@ func (_m *InterviewService) GetInterviewAppointments(ctx context.Context, offset
 	return r0, r1
 }
 
-// UpdateInterviewAppointment provides a mock function with given fields: ctx, req
-func (_m *InterviewService) UpdateInterviewAppointment(ctx context.Context, req *dto.UpdateInterviewAppointmentRequest) error {
+// GetWorkflow provides a mock function with given fields: ctx
+func (_m *InterviewService) GetWorkflow(ctx context.Context) (*domains.Workflow, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *domains.Workflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domains.Workflow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domains.Workflow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Workflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeArchivedInterviewAppointments provides a mock function with given fields: ctx
+func (_m *InterviewService) PurgeArchivedInterviewAppointments(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnarchiveInterviewAppointment provides a mock function with given fields: ctx, req
+func (_m *InterviewService) UnarchiveInterviewAppointment(ctx context.Context, req *dto.UnarchiveInterviewAppointmentRequest) error {
 	ret := _m.Called(ctx, req)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateInterviewAppointmentRequest) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UnarchiveInterviewAppointmentRequest) error); ok {
 		r0 = rf(ctx, req)
 	} else {
 		r0 = ret.Error(0)
@@ -135,18 +317,78 @@ func (_m *InterviewService) UpdateInterviewAppointment(ctx context.Context, req
 	return r0
 }
 
+// UpdateInterviewAppointment provides a mock function with given fields: ctx, req
+func (_m *InterviewService) UpdateInterviewAppointment(ctx context.Context, req *dto.UpdateInterviewAppointmentRequest) (int64, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateInterviewAppointmentRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateInterviewAppointmentRequest) int64); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.UpdateInterviewAppointmentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateInterviewComment provides a mock function with given fields: ctx, req
-func (_m *InterviewService) UpdateInterviewComment(ctx context.Context, req *dto.UpdateInterviewCommentRequest) error {
+func (_m *InterviewService) UpdateInterviewComment(ctx context.Context, req *dto.UpdateInterviewCommentRequest) (int64, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateInterviewCommentRequest) error); ok {
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateInterviewCommentRequest) (int64, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateInterviewCommentRequest) int64); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.UpdateInterviewCommentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWorkflow provides a mock function with given fields: ctx, req
+func (_m *InterviewService) UpdateWorkflow(ctx context.Context, req *dto.UpdateWorkflowRequest) (*domains.Workflow, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.Workflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateWorkflowRequest) (*domains.Workflow, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateWorkflowRequest) *domains.Workflow); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Workflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.UpdateWorkflowRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 type mockConstructorTestingTNewInterviewService interface {
@@ -42,18 +42,46 @@ func (_m *InterviewValidate) ValidateAddInterviewComment(ctx *gin.Context) (*dto
 }
 
 // ValidateArchiveInterviewAppointment provides a mock function with given fields: ctx
-func (_m *InterviewValidate) ValidateArchiveInterviewAppointment(ctx *gin.Context) (string, error) {
+func (_m *InterviewValidate) ValidateArchiveInterviewAppointment(ctx *gin.Context) (*dto.ArchiveInterviewAppointmentRequest, error) {
 	ret := _m.Called(ctx)
 
-	var r0 string
+	var r0 *dto.ArchiveInterviewAppointmentRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*gin.Context) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.ArchiveInterviewAppointmentRequest, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(*gin.Context) string); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.ArchiveInterviewAppointmentRequest); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ArchiveInterviewAppointmentRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateAssignInterviewers provides a mock function with given fields: ctx
+func (_m *InterviewValidate) ValidateAssignInterviewers(ctx *gin.Context) (*dto.AssignInterviewersRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.AssignInterviewersRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.AssignInterviewersRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.AssignInterviewersRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AssignInterviewersRequest)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
@@ -91,19 +119,73 @@ func (_m *InterviewValidate) ValidateCreateInterviewAppointment(ctx *gin.Context
 	return r0, r1
 }
 
+// ValidateDeleteInterviewComment provides a mock function with given fields: ctx
+func (_m *InterviewValidate) ValidateDeleteInterviewComment(ctx *gin.Context) (*dto.DeleteInterviewCommentRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.DeleteInterviewCommentRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.DeleteInterviewCommentRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.DeleteInterviewCommentRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DeleteInterviewCommentRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetInterviewActivity provides a mock function with given fields: ctx
+func (_m *InterviewValidate) ValidateGetInterviewActivity(ctx *gin.Context) (*dto.GetInterviewActivityRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.GetInterviewActivityRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetInterviewActivityRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetInterviewActivityRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetInterviewActivityRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ValidateGetInterviewAppointment provides a mock function with given fields: ctx
-func (_m *InterviewValidate) ValidateGetInterviewAppointment(ctx *gin.Context) (string, error) {
+func (_m *InterviewValidate) ValidateGetInterviewAppointment(ctx *gin.Context) (*dto.GetInterviewAppointmentRequest, error) {
 	ret := _m.Called(ctx)
 
-	var r0 string
+	var r0 *dto.GetInterviewAppointmentRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*gin.Context) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetInterviewAppointmentRequest, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(*gin.Context) string); ok {
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetInterviewAppointmentRequest); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetInterviewAppointmentRequest)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
@@ -141,6 +223,110 @@ func (_m *InterviewValidate) ValidateGetInterviewAppointments(ctx *gin.Context)
 	return r0, r1
 }
 
+// ValidateGetInterviewCommentRevisions provides a mock function with given fields: ctx
+func (_m *InterviewValidate) ValidateGetInterviewCommentRevisions(ctx *gin.Context) (*dto.GetInterviewCommentRevisionsRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.GetInterviewCommentRevisionsRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetInterviewCommentRevisionsRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetInterviewCommentRevisionsRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetInterviewCommentRevisionsRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetInterviewComments provides a mock function with given fields: ctx
+func (_m *InterviewValidate) ValidateGetInterviewComments(ctx *gin.Context) (*dto.GetInterviewCommentsRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.GetInterviewCommentsRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetInterviewCommentsRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetInterviewCommentsRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetInterviewCommentsRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetInterviewerAppointments provides a mock function with given fields: ctx
+func (_m *InterviewValidate) ValidateGetInterviewerAppointments(ctx *gin.Context) (*dto.GetInterviewerAppointmentsRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.GetInterviewerAppointmentsRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetInterviewerAppointmentsRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetInterviewerAppointmentsRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetInterviewerAppointmentsRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateUnarchiveInterviewAppointment provides a mock function with given fields: ctx
+func (_m *InterviewValidate) ValidateUnarchiveInterviewAppointment(ctx *gin.Context) (*dto.UnarchiveInterviewAppointmentRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UnarchiveInterviewAppointmentRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UnarchiveInterviewAppointmentRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UnarchiveInterviewAppointmentRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UnarchiveInterviewAppointmentRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ValidateUpdateInterviewAppointment provides a mock function with given fields: ctx
 func (_m *InterviewValidate) ValidateUpdateInterviewAppointment(ctx *gin.Context) (*dto.UpdateInterviewAppointmentRequest, error) {
 	ret := _m.Called(ctx)
@@ -193,6 +379,32 @@ func (_m *InterviewValidate) ValidateUpdateInterviewComment(ctx *gin.Context) (*
 	return r0, r1
 }
 
+// ValidateUpdateWorkflow provides a mock function with given fields: ctx
+func (_m *InterviewValidate) ValidateUpdateWorkflow(ctx *gin.Context) (*dto.UpdateWorkflowRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UpdateWorkflowRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UpdateWorkflowRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UpdateWorkflowRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UpdateWorkflowRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewInterviewValidate interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,126 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// LoginAttemptRepository is an autogenerated mock type for the LoginAttemptRepository type
+type LoginAttemptRepository struct {
+	mock.Mock
+}
+
+// Block provides a mock function with given fields: ctx, key, blockedUntil, expiresAt
+func (_m *LoginAttemptRepository) Block(ctx context.Context, key string, blockedUntil time.Time, expiresAt time.Time) error {
+	ret := _m.Called(ctx, key, blockedUntil, expiresAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, key, blockedUntil, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *LoginAttemptRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, key
+func (_m *LoginAttemptRepository) Get(ctx context.Context, key string) (*domains.LoginAttempt, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 *domains.LoginAttempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.LoginAttempt, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.LoginAttempt); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.LoginAttempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordFailure provides a mock function with given fields: ctx, key, clientIP, expiresAt
+func (_m *LoginAttemptRepository) RecordFailure(ctx context.Context, key string, clientIP string, expiresAt time.Time) (*domains.LoginAttempt, error) {
+	ret := _m.Called(ctx, key, clientIP, expiresAt)
+
+	var r0 *domains.LoginAttempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*domains.LoginAttempt, error)); ok {
+		return rf(ctx, key, clientIP, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *domains.LoginAttempt); ok {
+		r0 = rf(ctx, key, clientIP, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.LoginAttempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, key, clientIP, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reset provides a mock function with given fields: ctx, key
+func (_m *LoginAttemptRepository) Reset(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewLoginAttemptRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewLoginAttemptRepository creates a new instance of LoginAttemptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewLoginAttemptRepository(t mockConstructorTestingTNewLoginAttemptRepository) *LoginAttemptRepository {
+	mock := &LoginAttemptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -12,11 +12,53 @@ type Middlewares struct {
 	mock.Mock
 }
 
+// APIKeyScope provides a mock function with given fields: scope
+func (_m *Middlewares) APIKeyScope(scope string) gin.HandlerFunc {
+	ret := _m.Called(scope)
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func(string) gin.HandlerFunc); ok {
+		r0 = rf(scope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
 // AdminMiddleware provides a mock function with given fields: ctx
 func (_m *Middlewares) AdminMiddleware(ctx *gin.Context) {
 	_m.Called(ctx)
 }
 
+// Idempotent provides a mock function with given fields: ctx
+func (_m *Middlewares) Idempotent(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// RequestID provides a mock function with given fields: ctx
+func (_m *Middlewares) RequestID(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// RequirePermission provides a mock function with given fields: permission
+func (_m *Middlewares) RequirePermission(permission string) gin.HandlerFunc {
+	ret := _m.Called(permission)
+
+	var r0 gin.HandlerFunc
+	if rf, ok := ret.Get(0).(func(string) gin.HandlerFunc); ok {
+		r0 = rf(permission)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+
+	return r0
+}
+
 // StaffMiddleware provides a mock function with given fields: ctx
 func (_m *Middlewares) StaffMiddleware(ctx *gin.Context) {
 	_m.Called(ctx)
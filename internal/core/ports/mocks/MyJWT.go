@@ -15,29 +15,6 @@ type MyJWT struct {
 	mock.Mock
 }
 
-// NewWithClaims provides a mock function with given fields: method, claims, opts
-func (_m *MyJWT) NewWithClaims(method jwt.SigningMethod, claims domains.Claims, opts ...jwt.TokenOption) *jwt.Token {
-	_va := make([]interface{}, len(opts))
-	for _i := range opts {
-		_va[_i] = opts[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, method, claims)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 *jwt.Token
-	if rf, ok := ret.Get(0).(func(jwt.SigningMethod, domains.Claims, ...jwt.TokenOption) *jwt.Token); ok {
-		r0 = rf(method, claims, opts...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*jwt.Token)
-		}
-	}
-
-	return r0
-}
-
 // ParseToken provides a mock function with given fields: token
 func (_m *MyJWT) ParseToken(token *jwt.Token) (interface{}, error) {
 	ret := _m.Called(token)
@@ -97,6 +74,46 @@ func (_m *MyJWT) ParseWithClaims(tokenString string, claims *domains.Claims, key
 	return r0, r1
 }
 
+// PublicKeys provides a mock function with given fields:
+func (_m *MyJWT) PublicKeys() []domains.JSONWebKey {
+	ret := _m.Called()
+
+	var r0 []domains.JSONWebKey
+	if rf, ok := ret.Get(0).(func() []domains.JSONWebKey); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.JSONWebKey)
+		}
+	}
+
+	return r0
+}
+
+// SignClaims provides a mock function with given fields: claims
+func (_m *MyJWT) SignClaims(claims domains.Claims) (string, error) {
+	ret := _m.Called(claims)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domains.Claims) (string, error)); ok {
+		return rf(claims)
+	}
+	if rf, ok := ret.Get(0).(func(domains.Claims) string); ok {
+		r0 = rf(claims)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(domains.Claims) error); ok {
+		r1 = rf(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewMyJWT interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OIDCStateRepository is an autogenerated mock type for the OIDCStateRepository type
+type OIDCStateRepository struct {
+	mock.Mock
+}
+
+// Consume provides a mock function with given fields: ctx, stateHash
+func (_m *OIDCStateRepository) Consume(ctx context.Context, stateHash string) (*domains.OIDCState, error) {
+	ret := _m.Called(ctx, stateHash)
+
+	var r0 *domains.OIDCState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.OIDCState, error)); ok {
+		return rf(ctx, stateHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.OIDCState); ok {
+		r0 = rf(ctx, stateHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.OIDCState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, stateHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *OIDCStateRepository) Create(ctx context.Context, params *domains.CreateOIDCStateParams) (*domains.OIDCState, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.OIDCState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateOIDCStateParams) (*domains.OIDCState, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateOIDCStateParams) *domains.OIDCState); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.OIDCState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreateOIDCStateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *OIDCStateRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewOIDCStateRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewOIDCStateRepository creates a new instance of OIDCStateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewOIDCStateRepository(t mockConstructorTestingTNewOIDCStateRepository) *OIDCStateRepository {
+	mock := &OIDCStateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,126 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PasswordResetTokenRepository is an autogenerated mock type for the PasswordResetTokenRepository type
+type PasswordResetTokenRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *PasswordResetTokenRepository) Create(ctx context.Context, params *domains.CreatePasswordResetTokenParams) (*domains.PasswordResetToken, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.PasswordResetToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreatePasswordResetTokenParams) (*domains.PasswordResetToken, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreatePasswordResetTokenParams) *domains.PasswordResetToken); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.PasswordResetToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreatePasswordResetTokenParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *PasswordResetTokenRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByTokenHash provides a mock function with given fields: ctx, tokenHash
+func (_m *PasswordResetTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domains.PasswordResetToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	var r0 *domains.PasswordResetToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.PasswordResetToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.PasswordResetToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.PasswordResetToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvalidateUserTokens provides a mock function with given fields: ctx, userID
+func (_m *PasswordResetTokenRepository) InvalidateUserTokens(ctx context.Context, userID primitive.ObjectID) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkUsed provides a mock function with given fields: ctx, id
+func (_m *PasswordResetTokenRepository) MarkUsed(ctx context.Context, id primitive.ObjectID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewPasswordResetTokenRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewPasswordResetTokenRepository creates a new instance of PasswordResetTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewPasswordResetTokenRepository(t mockConstructorTestingTNewPasswordResetTokenRepository) *PasswordResetTokenRepository {
+	mock := &PasswordResetTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
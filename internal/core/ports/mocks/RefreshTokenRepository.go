@@ -0,0 +1,164 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RefreshTokenRepository is an autogenerated mock type for the RefreshTokenRepository type
+type RefreshTokenRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *RefreshTokenRepository) Create(ctx context.Context, params *domains.CreateRefreshTokenParams) (*domains.RefreshToken, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateRefreshTokenParams) (*domains.RefreshToken, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateRefreshTokenParams) *domains.RefreshToken); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreateRefreshTokenParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *RefreshTokenRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByTokenHash provides a mock function with given fields: ctx, tokenHash
+func (_m *RefreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domains.RefreshToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	var r0 *domains.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.RefreshToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.RefreshToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.RefreshToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsSessionRevoked provides a mock function with given fields: ctx, sessionID
+func (_m *RefreshTokenRepository) IsSessionRevoked(ctx context.Context, sessionID primitive.ObjectID) (bool, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (bool, error)); ok {
+		return rf(ctx, sessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) bool); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkUsed provides a mock function with given fields: ctx, id
+func (_m *RefreshTokenRepository) MarkUsed(ctx context.Context, id primitive.ObjectID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeSession provides a mock function with given fields: ctx, sessionID
+func (_m *RefreshTokenRepository) RevokeSession(ctx context.Context, sessionID primitive.ObjectID) error {
+	ret := _m.Called(ctx, sessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeUserSessions provides a mock function with given fields: ctx, userID, exceptSessionID
+func (_m *RefreshTokenRepository) RevokeUserSessions(ctx context.Context, userID primitive.ObjectID, exceptSessionID primitive.ObjectID) error {
+	ret := _m.Called(ctx, userID, exceptSessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, userID, exceptSessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRefreshTokenRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRefreshTokenRepository creates a new instance of RefreshTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRefreshTokenRepository(t mockConstructorTestingTNewRefreshTokenRepository) *RefreshTokenRepository {
+	mock := &RefreshTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,63 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScorecardHandler is an autogenerated mock type for the ScorecardHandler type
+type ScorecardHandler struct {
+	mock.Mock
+}
+
+// ArchiveScorecardTemplate provides a mock function with given fields: ctx
+func (_m *ScorecardHandler) ArchiveScorecardTemplate(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// CreateScorecardTemplate provides a mock function with given fields: ctx
+func (_m *ScorecardHandler) CreateScorecardTemplate(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetScorecardTemplate provides a mock function with given fields: ctx
+func (_m *ScorecardHandler) GetScorecardTemplate(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetScorecardTemplates provides a mock function with given fields: ctx
+func (_m *ScorecardHandler) GetScorecardTemplates(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetScorecards provides a mock function with given fields: ctx
+func (_m *ScorecardHandler) GetScorecards(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// SubmitScorecard provides a mock function with given fields: ctx
+func (_m *ScorecardHandler) SubmitScorecard(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// UpdateScorecard provides a mock function with given fields: ctx
+func (_m *ScorecardHandler) UpdateScorecard(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+type mockConstructorTestingTNewScorecardHandler interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewScorecardHandler creates a new instance of ScorecardHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewScorecardHandler(t mockConstructorTestingTNewScorecardHandler) *ScorecardHandler {
+	mock := &ScorecardHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,164 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ScorecardRepository is an autogenerated mock type for the ScorecardRepository type
+type ScorecardRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *ScorecardRepository) Create(ctx context.Context, params *domains.CreateScorecardParams) (*domains.Scorecard, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.Scorecard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateScorecardParams) (*domains.Scorecard, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateScorecardParams) *domains.Scorecard); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Scorecard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreateScorecardParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteByAppointments provides a mock function with given fields: ctx, appointmentIDs
+func (_m *ScorecardRepository) DeleteByAppointments(ctx context.Context, appointmentIDs []primitive.ObjectID) error {
+	ret := _m.Called(ctx, appointmentIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID) error); ok {
+		r0 = rf(ctx, appointmentIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *ScorecardRepository) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ScorecardRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.Scorecard, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.Scorecard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (*domains.Scorecard, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) *domains.Scorecard); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Scorecard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByAppointment provides a mock function with given fields: ctx, appointmentID
+func (_m *ScorecardRepository) GetByAppointment(ctx context.Context, appointmentID primitive.ObjectID) ([]domains.Scorecard, error) {
+	ret := _m.Called(ctx, appointmentID)
+
+	var r0 []domains.Scorecard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) ([]domains.Scorecard, error)); ok {
+		return rf(ctx, appointmentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) []domains.Scorecard); ok {
+		r0 = rf(ctx, appointmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.Scorecard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, appointmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, params
+func (_m *ScorecardRepository) Update(ctx context.Context, params *domains.UpdateScorecardParams) (*domains.Scorecard, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.Scorecard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateScorecardParams) (*domains.Scorecard, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateScorecardParams) *domains.Scorecard); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Scorecard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.UpdateScorecardParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewScorecardRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewScorecardRepository creates a new instance of ScorecardRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewScorecardRepository(t mockConstructorTestingTNewScorecardRepository) *ScorecardRepository {
+	mock := &ScorecardRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
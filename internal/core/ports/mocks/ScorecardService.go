@@ -0,0 +1,213 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+	dto "robinhood-assignment/internal/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScorecardService is an autogenerated mock type for the ScorecardService type
+type ScorecardService struct {
+	mock.Mock
+}
+
+// ArchiveScorecardTemplate provides a mock function with given fields: ctx, req
+func (_m *ScorecardService) ArchiveScorecardTemplate(ctx context.Context, req *dto.ArchiveScorecardTemplateRequest) (*domains.ScorecardTemplate, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.ScorecardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ArchiveScorecardTemplateRequest) (*domains.ScorecardTemplate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ArchiveScorecardTemplateRequest) *domains.ScorecardTemplate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.ScorecardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.ArchiveScorecardTemplateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateScorecardTemplate provides a mock function with given fields: ctx, req
+func (_m *ScorecardService) CreateScorecardTemplate(ctx context.Context, req *dto.CreateScorecardTemplateRequest) (*domains.ScorecardTemplate, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.ScorecardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateScorecardTemplateRequest) (*domains.ScorecardTemplate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateScorecardTemplateRequest) *domains.ScorecardTemplate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.ScorecardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.CreateScorecardTemplateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetScorecardTemplate provides a mock function with given fields: ctx, id
+func (_m *ScorecardService) GetScorecardTemplate(ctx context.Context, id string) (*domains.ScorecardTemplate, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.ScorecardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.ScorecardTemplate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.ScorecardTemplate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.ScorecardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetScorecardTemplates provides a mock function with given fields: ctx, req
+func (_m *ScorecardService) GetScorecardTemplates(ctx context.Context, req *dto.GetScorecardTemplatesRequest) ([]domains.ScorecardTemplate, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []domains.ScorecardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetScorecardTemplatesRequest) ([]domains.ScorecardTemplate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetScorecardTemplatesRequest) []domains.ScorecardTemplate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.ScorecardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetScorecardTemplatesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetScorecards provides a mock function with given fields: ctx, req
+func (_m *ScorecardService) GetScorecards(ctx context.Context, req *dto.GetScorecardsRequest) ([]domains.Scorecard, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []domains.Scorecard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetScorecardsRequest) ([]domains.Scorecard, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetScorecardsRequest) []domains.Scorecard); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.Scorecard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetScorecardsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubmitScorecard provides a mock function with given fields: ctx, req
+func (_m *ScorecardService) SubmitScorecard(ctx context.Context, req *dto.SubmitScorecardRequest) (*domains.Scorecard, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.Scorecard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SubmitScorecardRequest) (*domains.Scorecard, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.SubmitScorecardRequest) *domains.Scorecard); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Scorecard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.SubmitScorecardRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateScorecard provides a mock function with given fields: ctx, req
+func (_m *ScorecardService) UpdateScorecard(ctx context.Context, req *dto.UpdateScorecardRequest) (*domains.Scorecard, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.Scorecard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateScorecardRequest) (*domains.Scorecard, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateScorecardRequest) *domains.Scorecard); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Scorecard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.UpdateScorecardRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewScorecardService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewScorecardService creates a new instance of ScorecardService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewScorecardService(t mockConstructorTestingTNewScorecardService) *ScorecardService {
+	mock := &ScorecardService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,136 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ScorecardTemplateRepository is an autogenerated mock type for the ScorecardTemplateRepository type
+type ScorecardTemplateRepository struct {
+	mock.Mock
+}
+
+// Archive provides a mock function with given fields: ctx, id
+func (_m *ScorecardTemplateRepository) Archive(ctx context.Context, id primitive.ObjectID) (*domains.ScorecardTemplate, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.ScorecardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (*domains.ScorecardTemplate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) *domains.ScorecardTemplate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.ScorecardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, params
+func (_m *ScorecardTemplateRepository) Create(ctx context.Context, params *domains.CreateScorecardTemplateParams) (*domains.ScorecardTemplate, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.ScorecardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateScorecardTemplateParams) (*domains.ScorecardTemplate, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.CreateScorecardTemplateParams) *domains.ScorecardTemplate); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.ScorecardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.CreateScorecardTemplateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ScorecardTemplateRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.ScorecardTemplate, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.ScorecardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (*domains.ScorecardTemplate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) *domains.ScorecardTemplate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.ScorecardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, params
+func (_m *ScorecardTemplateRepository) GetAll(ctx context.Context, params *domains.GetScorecardTemplatesParams) ([]domains.ScorecardTemplate, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domains.ScorecardTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetScorecardTemplatesParams) ([]domains.ScorecardTemplate, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetScorecardTemplatesParams) []domains.ScorecardTemplate); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.ScorecardTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.GetScorecardTemplatesParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewScorecardTemplateRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewScorecardTemplateRepository creates a new instance of ScorecardTemplateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewScorecardTemplateRepository(t mockConstructorTestingTNewScorecardTemplateRepository) *ScorecardTemplateRepository {
+	mock := &ScorecardTemplateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
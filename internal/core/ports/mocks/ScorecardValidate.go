@@ -0,0 +1,211 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "robinhood-assignment/internal/dto"
+
+	gin "github.com/gin-gonic/gin"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScorecardValidate is an autogenerated mock type for the ScorecardValidate type
+type ScorecardValidate struct {
+	mock.Mock
+}
+
+// ValidateArchiveScorecardTemplate provides a mock function with given fields: ctx
+func (_m *ScorecardValidate) ValidateArchiveScorecardTemplate(ctx *gin.Context) (*dto.ArchiveScorecardTemplateRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.ArchiveScorecardTemplateRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.ArchiveScorecardTemplateRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.ArchiveScorecardTemplateRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ArchiveScorecardTemplateRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateCreateScorecardTemplate provides a mock function with given fields: ctx
+func (_m *ScorecardValidate) ValidateCreateScorecardTemplate(ctx *gin.Context) (*dto.CreateScorecardTemplateRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.CreateScorecardTemplateRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.CreateScorecardTemplateRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.CreateScorecardTemplateRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CreateScorecardTemplateRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetScorecardTemplate provides a mock function with given fields: ctx
+func (_m *ScorecardValidate) ValidateGetScorecardTemplate(ctx *gin.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetScorecardTemplates provides a mock function with given fields: ctx
+func (_m *ScorecardValidate) ValidateGetScorecardTemplates(ctx *gin.Context) (*dto.GetScorecardTemplatesRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.GetScorecardTemplatesRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetScorecardTemplatesRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetScorecardTemplatesRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetScorecardTemplatesRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetScorecards provides a mock function with given fields: ctx
+func (_m *ScorecardValidate) ValidateGetScorecards(ctx *gin.Context) (*dto.GetScorecardsRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.GetScorecardsRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetScorecardsRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetScorecardsRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetScorecardsRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateSubmitScorecard provides a mock function with given fields: ctx
+func (_m *ScorecardValidate) ValidateSubmitScorecard(ctx *gin.Context) (*dto.SubmitScorecardRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.SubmitScorecardRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.SubmitScorecardRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.SubmitScorecardRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.SubmitScorecardRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateUpdateScorecard provides a mock function with given fields: ctx
+func (_m *ScorecardValidate) ValidateUpdateScorecard(ctx *gin.Context) (*dto.UpdateScorecardRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UpdateScorecardRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UpdateScorecardRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UpdateScorecardRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UpdateScorecardRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewScorecardValidate interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewScorecardValidate creates a new instance of ScorecardValidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewScorecardValidate(t mockConstructorTestingTNewScorecardValidate) *ScorecardValidate {
+	mock := &ScorecardValidate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserHandler is an autogenerated mock type for the UserHandler type
+type UserHandler struct {
+	mock.Mock
+}
+
+// ChangePassword provides a mock function with given fields: ctx
+func (_m *UserHandler) ChangePassword(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// ConfirmMFA provides a mock function with given fields: ctx
+func (_m *UserHandler) ConfirmMFA(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// CreateServiceAccount provides a mock function with given fields: ctx
+func (_m *UserHandler) CreateServiceAccount(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// DeactivateUser provides a mock function with given fields: ctx
+func (_m *UserHandler) DeactivateUser(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// DisableMFA provides a mock function with given fields: ctx
+func (_m *UserHandler) DisableMFA(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// EnrollMFA provides a mock function with given fields: ctx
+func (_m *UserHandler) EnrollMFA(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetMe provides a mock function with given fields: ctx
+func (_m *UserHandler) GetMe(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetUser provides a mock function with given fields: ctx
+func (_m *UserHandler) GetUser(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// GetUsers provides a mock function with given fields: ctx
+func (_m *UserHandler) GetUsers(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// ReactivateUser provides a mock function with given fields: ctx
+func (_m *UserHandler) ReactivateUser(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// UnlockUser provides a mock function with given fields: ctx
+func (_m *UserHandler) UnlockUser(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// UpdateMe provides a mock function with given fields: ctx
+func (_m *UserHandler) UpdateMe(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// UpdateUser provides a mock function with given fields: ctx
+func (_m *UserHandler) UpdateUser(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+// UpdateUserRole provides a mock function with given fields: ctx
+func (_m *UserHandler) UpdateUserRole(ctx *gin.Context) {
+	_m.Called(ctx)
+}
+
+type mockConstructorTestingTNewUserHandler interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUserHandler creates a new instance of UserHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUserHandler(t mockConstructorTestingTNewUserHandler) *UserHandler {
+	mock := &UserHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
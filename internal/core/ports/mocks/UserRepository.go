@@ -42,6 +42,34 @@ func (_m *UserRepository) Create(ctx context.Context, params *domains.CreateUser
 	return r0, r1
 }
 
+// DisableTOTP provides a mock function with given fields: ctx, id
+func (_m *UserRepository) DisableTOTP(ctx context.Context, id primitive.ObjectID) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableTOTP provides a mock function with given fields: ctx, id, secret, recoveryCodeHashes
+func (_m *UserRepository) EnableTOTP(ctx context.Context, id primitive.ObjectID, secret string, recoveryCodeHashes []string) error {
+	ret := _m.Called(ctx, id, secret, recoveryCodeHashes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string, []string) error); ok {
+		r0 = rf(ctx, id, secret, recoveryCodeHashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *UserRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.User, error) {
 	ret := _m.Called(ctx, id)
@@ -68,6 +96,110 @@ func (_m *UserRepository) Get(ctx context.Context, id primitive.ObjectID) (*doma
 	return r0, r1
 }
 
+// GetAll provides a mock function with given fields: ctx, params
+func (_m *UserRepository) GetAll(ctx context.Context, params *domains.GetUsersParams) ([]domains.User, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domains.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetUsersParams) ([]domains.User, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.GetUsersParams) []domains.User); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.GetUsersParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByEmail provides a mock function with given fields: ctx, email
+func (_m *UserRepository) GetByEmail(ctx context.Context, email string) (*domains.User, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 *domains.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByIDs provides a mock function with given fields: ctx, ids
+func (_m *UserRepository) GetByIDs(ctx context.Context, ids []primitive.ObjectID) ([]domains.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []domains.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID) ([]domains.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID) []domains.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []primitive.ObjectID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByOIDCSubject provides a mock function with given fields: ctx, subject
+func (_m *UserRepository) GetByOIDCSubject(ctx context.Context, subject string) (*domains.User, error) {
+	ret := _m.Called(ctx, subject)
+
+	var r0 *domains.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.User, error)); ok {
+		return rf(ctx, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.User); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByUsername provides a mock function with given fields: ctx, username
 func (_m *UserRepository) GetByUsername(ctx context.Context, username string) (*domains.User, error) {
 	ret := _m.Called(ctx, username)
@@ -94,6 +226,94 @@ func (_m *UserRepository) GetByUsername(ctx context.Context, username string) (*
 	return r0, r1
 }
 
+// SetPendingTOTPSecret provides a mock function with given fields: ctx, id, secret
+func (_m *UserRepository) SetPendingTOTPSecret(ctx context.Context, id primitive.ObjectID, secret string) error {
+	ret := _m.Called(ctx, id, secret)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string) error); ok {
+		r0 = rf(ctx, id, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, params
+func (_m *UserRepository) Update(ctx context.Context, params *domains.UpdateUserParams) (*domains.User, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateUserParams) (*domains.User, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateUserParams) *domains.User); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.UpdateUserParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseRecoveryCode provides a mock function with given fields: ctx, id, codeHash
+func (_m *UserRepository) UseRecoveryCode(ctx context.Context, id primitive.ObjectID, codeHash string) (bool, error) {
+	ret := _m.Called(ctx, id, codeHash)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string) (bool, error)); ok {
+		return rf(ctx, id, codeHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string) bool); ok {
+		r0 = rf(ctx, id, codeHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID, string) error); ok {
+		r1 = rf(ctx, id, codeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseTOTPStep provides a mock function with given fields: ctx, id, step
+func (_m *UserRepository) UseTOTPStep(ctx context.Context, id primitive.ObjectID, step int64) (bool, error) {
+	ret := _m.Called(ctx, id, step)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, int64) (bool, error)); ok {
+		return rf(ctx, id, step)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, int64) bool); ok {
+		r0 = rf(ctx, id, step)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID, int64) error); ok {
+		r1 = rf(ctx, id, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewUserRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,271 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+	dto "robinhood-assignment/internal/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserService is an autogenerated mock type for the UserService type
+type UserService struct {
+	mock.Mock
+}
+
+// ChangePassword provides a mock function with given fields: ctx, req
+func (_m *UserService) ChangePassword(ctx context.Context, req *dto.ChangePasswordRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.ChangePasswordRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConfirmMFA provides a mock function with given fields: ctx, req
+func (_m *UserService) ConfirmMFA(ctx context.Context, req *dto.MFACodeRequest) ([]string, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.MFACodeRequest) ([]string, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.MFACodeRequest) []string); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.MFACodeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateServiceAccount provides a mock function with given fields: ctx, req
+func (_m *UserService) CreateServiceAccount(ctx context.Context, req *dto.CreateServiceAccountRequest) (*domains.User, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateServiceAccountRequest) (*domains.User, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.CreateServiceAccountRequest) *domains.User); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.CreateServiceAccountRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeactivateUser provides a mock function with given fields: ctx, req
+func (_m *UserService) DeactivateUser(ctx context.Context, req *dto.UpdateUserStatusRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateUserStatusRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DisableMFA provides a mock function with given fields: ctx, req
+func (_m *UserService) DisableMFA(ctx context.Context, req *dto.MFACodeRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.MFACodeRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnrollMFA provides a mock function with given fields: ctx, userID
+func (_m *UserService) EnrollMFA(ctx context.Context, userID string) (*domains.TOTPEnrollment, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *domains.TOTPEnrollment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.TOTPEnrollment, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.TOTPEnrollment); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.TOTPEnrollment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *UserService) GetUser(ctx context.Context, id string) (*domains.User, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domains.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domains.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domains.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUsers provides a mock function with given fields: ctx, req
+func (_m *UserService) GetUsers(ctx context.Context, req *dto.GetUsersRequest) ([]domains.User, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 []domains.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetUsersRequest) ([]domains.User, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.GetUsersRequest) []domains.User); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domains.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.GetUsersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReactivateUser provides a mock function with given fields: ctx, req
+func (_m *UserService) ReactivateUser(ctx context.Context, req *dto.UpdateUserStatusRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateUserStatusRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnlockUser provides a mock function with given fields: ctx, req
+func (_m *UserService) UnlockUser(ctx context.Context, req *dto.UpdateUserStatusRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateUserStatusRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateUser provides a mock function with given fields: ctx, req
+func (_m *UserService) UpdateUser(ctx context.Context, req *dto.UpdateUserRequest) (*domains.User, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *domains.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateUserRequest) (*domains.User, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateUserRequest) *domains.User); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *dto.UpdateUserRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateUserRole provides a mock function with given fields: ctx, req
+func (_m *UserService) UpdateUserRole(ctx context.Context, req *dto.UpdateUserRoleRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dto.UpdateUserRoleRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewUserService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUserService creates a new instance of UserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUserService(t mockConstructorTestingTNewUserService) *UserService {
+	mock := &UserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
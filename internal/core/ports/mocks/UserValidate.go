@@ -0,0 +1,313 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "robinhood-assignment/internal/dto"
+
+	gin "github.com/gin-gonic/gin"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserValidate is an autogenerated mock type for the UserValidate type
+type UserValidate struct {
+	mock.Mock
+}
+
+// ValidateChangePassword provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateChangePassword(ctx *gin.Context) (*dto.ChangePasswordRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.ChangePasswordRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.ChangePasswordRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.ChangePasswordRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ChangePasswordRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateCreateServiceAccount provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateCreateServiceAccount(ctx *gin.Context) (*dto.CreateServiceAccountRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.CreateServiceAccountRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.CreateServiceAccountRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.CreateServiceAccountRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CreateServiceAccountRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetMe provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateGetMe(ctx *gin.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetUser provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateGetUser(ctx *gin.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateGetUsers provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateGetUsers(ctx *gin.Context) (*dto.GetUsersRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.GetUsersRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.GetUsersRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.GetUsersRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetUsersRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateMFACode provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateMFACode(ctx *gin.Context) (*dto.MFACodeRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.MFACodeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.MFACodeRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.MFACodeRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.MFACodeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateUnlockUser provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateUnlockUser(ctx *gin.Context) (*dto.UpdateUserStatusRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UpdateUserStatusRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UpdateUserStatusRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UpdateUserStatusRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UpdateUserStatusRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateUpdateMe provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateUpdateMe(ctx *gin.Context) (*dto.UpdateUserRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UpdateUserRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UpdateUserRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UpdateUserRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UpdateUserRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateUpdateUser provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateUpdateUser(ctx *gin.Context) (*dto.UpdateUserRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UpdateUserRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UpdateUserRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UpdateUserRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UpdateUserRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateUpdateUserRole provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateUpdateUserRole(ctx *gin.Context) (*dto.UpdateUserRoleRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UpdateUserRoleRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UpdateUserRoleRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UpdateUserRoleRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UpdateUserRoleRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateUpdateUserStatus provides a mock function with given fields: ctx
+func (_m *UserValidate) ValidateUpdateUserStatus(ctx *gin.Context) (*dto.UpdateUserStatusRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *dto.UpdateUserStatusRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*gin.Context) (*dto.UpdateUserStatusRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(*gin.Context) *dto.UpdateUserStatusRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.UpdateUserStatusRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gin.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewUserValidate interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewUserValidate creates a new instance of UserValidate. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewUserValidate(t mockConstructorTestingTNewUserValidate) *UserValidate {
+	mock := &UserValidate{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+// Code generated by mockery v2.28.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domains "robinhood-assignment/internal/core/domains"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WorkflowRepository is an autogenerated mock type for the WorkflowRepository type
+type WorkflowRepository struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx
+func (_m *WorkflowRepository) Get(ctx context.Context) (*domains.Workflow, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *domains.Workflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domains.Workflow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domains.Workflow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Workflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, params
+func (_m *WorkflowRepository) Update(ctx context.Context, params *domains.UpdateWorkflowParams) (*domains.Workflow, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domains.Workflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateWorkflowParams) (*domains.Workflow, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domains.UpdateWorkflowParams) *domains.Workflow); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domains.Workflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domains.UpdateWorkflowParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewWorkflowRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewWorkflowRepository creates a new instance of WorkflowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewWorkflowRepository(t mockConstructorTestingTNewWorkflowRepository) *WorkflowRepository {
+	mock := &WorkflowRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 	"robinhood-assignment/internal/core/domains"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -10,15 +11,140 @@ import (
 type UserRepository interface {
 	Get(ctx context.Context, id primitive.ObjectID) (*domains.User, error)
 	GetByUsername(ctx context.Context, username string) (*domains.User, error)
+	GetByEmail(ctx context.Context, email string) (*domains.User, error)
+	GetByOIDCSubject(ctx context.Context, subject string) (*domains.User, error)
 	Create(ctx context.Context, params *domains.CreateUserParams) (*domains.User, error)
+	GetAll(ctx context.Context, params *domains.GetUsersParams) ([]domains.User, error)
+	GetByIDs(ctx context.Context, ids []primitive.ObjectID) ([]domains.User, error)
+	Update(ctx context.Context, params *domains.UpdateUserParams) (*domains.User, error)
+	SetPendingTOTPSecret(ctx context.Context, id primitive.ObjectID, secret string) error
+	EnableTOTP(ctx context.Context, id primitive.ObjectID, secret string, recoveryCodeHashes []string) error
+	DisableTOTP(ctx context.Context, id primitive.ObjectID) error
+	UseTOTPStep(ctx context.Context, id primitive.ObjectID, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, id primitive.ObjectID, codeHash string) (bool, error)
 }
 
 type InterviewAppointmentRepository interface {
-	GetAll(ctx context.Context, offset uint32, limit uint32) ([]domains.InterviewAppointment, error)
+	EnsureIndexes(ctx context.Context) error
+	GetAll(ctx context.Context, params *domains.GetInterviewAppointmentsParams) ([]domains.InterviewAppointment, *int64, error)
 	Get(ctx context.Context, id primitive.ObjectID) (*domains.InterviewAppointment, error)
 	Create(ctx context.Context, params *domains.CreateInterviewAppointmentParams) (*domains.CreateInterviewAppointment, error)
 	Update(ctx context.Context, params *domains.UpdateInterviewAppointmentParams) (*domains.InterviewAppointment, error)
-	ArchiveInterviewAppointment(ctx context.Context, id primitive.ObjectID) error
-	AddComment(ctx context.Context, params *domains.AddInterviewCommentParams) error
-	UpdateComment(ctx context.Context, params *domains.UpdateInterviewCommentParams) error
+	GetArchived(ctx context.Context, id primitive.ObjectID) (*domains.InterviewAppointment, error)
+	ArchiveInterviewAppointment(ctx context.Context, params *domains.ArchiveInterviewAppointmentParams) error
+	UnarchiveInterviewAppointment(ctx context.Context, id primitive.ObjectID) error
+	GetArchivedIDsBefore(ctx context.Context, before time.Time) ([]primitive.ObjectID, error)
+	DeleteArchived(ctx context.Context, ids []primitive.ObjectID, before time.Time) ([]primitive.ObjectID, error)
+	GetStatusesInUse(ctx context.Context) ([]string, error)
+	UpdateInterviewers(ctx context.Context, params *domains.UpdateInterviewersParams) error
+	FindConflicts(ctx context.Context, params *domains.FindInterviewConflictsParams) ([]domains.InterviewAppointment, error)
+	GetByInterviewer(ctx context.Context, params *domains.GetInterviewerAppointmentsParams) ([]domains.InterviewAppointment, error)
+	GetBlindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]domains.InterviewAppointment, error)
+}
+
+type InterviewCommentRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	GetByAppointment(ctx context.Context, params *domains.GetInterviewCommentsParams) ([]domains.InterviewComment, error)
+	SearchAppointmentIDs(ctx context.Context, params *domains.SearchInterviewCommentsParams) ([]primitive.ObjectID, error)
+	Get(ctx context.Context, id primitive.ObjectID) (*domains.InterviewComment, error)
+	ExistsByAuthor(ctx context.Context, appointmentID primitive.ObjectID, userID primitive.ObjectID) (bool, error)
+	Create(ctx context.Context, params *domains.AddInterviewCommentParams) (*domains.AddInterviewComment, error)
+	Update(ctx context.Context, params *domains.UpdateInterviewCommentParams) error
+	Delete(ctx context.Context, params *domains.DeleteInterviewCommentParams) error
+	Purge(ctx context.Context, appointmentID primitive.ObjectID, id primitive.ObjectID) error
+	DeleteByAppointments(ctx context.Context, appointmentIDs []primitive.ObjectID) error
+	MigrateEmbeddedComments(ctx context.Context) (int64, error)
+}
+
+type RefreshTokenRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Create(ctx context.Context, params *domains.CreateRefreshTokenParams) (*domains.RefreshToken, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*domains.RefreshToken, error)
+	MarkUsed(ctx context.Context, id primitive.ObjectID) error
+	RevokeSession(ctx context.Context, sessionID primitive.ObjectID) error
+	RevokeUserSessions(ctx context.Context, userID primitive.ObjectID, exceptSessionID primitive.ObjectID) error
+	IsSessionRevoked(ctx context.Context, sessionID primitive.ObjectID) (bool, error)
+}
+
+type LoginAttemptRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Get(ctx context.Context, key string) (*domains.LoginAttempt, error)
+	RecordFailure(ctx context.Context, key string, clientIP string, expiresAt time.Time) (*domains.LoginAttempt, error)
+	Block(ctx context.Context, key string, blockedUntil time.Time, expiresAt time.Time) error
+	Reset(ctx context.Context, key string) error
+}
+
+type PasswordResetTokenRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Create(ctx context.Context, params *domains.CreatePasswordResetTokenParams) (*domains.PasswordResetToken, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*domains.PasswordResetToken, error)
+	MarkUsed(ctx context.Context, id primitive.ObjectID) error
+	InvalidateUserTokens(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type APIKeyRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Create(ctx context.Context, params *domains.CreateAPIKeyParams) (*domains.APIKey, error)
+	Get(ctx context.Context, id primitive.ObjectID) (*domains.APIKey, error)
+	GetByKeyHash(ctx context.Context, keyHash string) (*domains.APIKey, error)
+	GetByUserID(ctx context.Context, userID primitive.ObjectID) ([]domains.APIKey, error)
+	Revoke(ctx context.Context, id primitive.ObjectID) error
+	Touch(ctx context.Context, id primitive.ObjectID, usedAt time.Time) error
+}
+
+type OIDCStateRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Create(ctx context.Context, params *domains.CreateOIDCStateParams) (*domains.OIDCState, error)
+	Consume(ctx context.Context, stateHash string) (*domains.OIDCState, error)
+}
+
+type IdempotencyKeyRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Create(ctx context.Context, params *domains.CreateIdempotencyKeyParams) (*domains.IdempotencyKey, error)
+	Get(ctx context.Context, userID primitive.ObjectID, key string) (*domains.IdempotencyKey, error)
+	SaveResponse(ctx context.Context, params *domains.SaveIdempotentResponseParams) error
+	Delete(ctx context.Context, id primitive.ObjectID) error
+}
+
+type AuditEventRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	Create(ctx context.Context, params *domains.CreateAuditEventParams) error
+	GetAll(ctx context.Context, params *domains.GetAuditEventsParams) ([]domains.AuditEvent, error)
+	RedactTarget(ctx context.Context, targetID primitive.ObjectID) error
+	RedactAppointments(ctx context.Context, appointmentIDs []primitive.ObjectID) error
+}
+
+type AuthSettingRepository interface {
+	Get(ctx context.Context) (*domains.AuthSetting, error)
+	Update(ctx context.Context, params *domains.UpdateAuthSettingParams) (*domains.AuthSetting, error)
+}
+
+type CandidateRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	GetAll(ctx context.Context, params *domains.GetCandidatesParams) ([]domains.Candidate, error)
+	Get(ctx context.Context, id primitive.ObjectID) (*domains.Candidate, error)
+	GetWithInterviews(ctx context.Context, id primitive.ObjectID) (*domains.Candidate, error)
+	Create(ctx context.Context, params *domains.CreateCandidateParams) (*domains.Candidate, error)
+	Update(ctx context.Context, params *domains.UpdateCandidateParams) (*domains.Candidate, error)
+}
+
+type WorkflowRepository interface {
+	Get(ctx context.Context) (*domains.Workflow, error)
+	Update(ctx context.Context, params *domains.UpdateWorkflowParams) (*domains.Workflow, error)
+}
+
+type ScorecardTemplateRepository interface {
+	GetAll(ctx context.Context, params *domains.GetScorecardTemplatesParams) ([]domains.ScorecardTemplate, error)
+	Get(ctx context.Context, id primitive.ObjectID) (*domains.ScorecardTemplate, error)
+	Create(ctx context.Context, params *domains.CreateScorecardTemplateParams) (*domains.ScorecardTemplate, error)
+	Archive(ctx context.Context, id primitive.ObjectID) (*domains.ScorecardTemplate, error)
+}
+
+type ScorecardRepository interface {
+	EnsureIndexes(ctx context.Context) error
+	GetByAppointment(ctx context.Context, appointmentID primitive.ObjectID) ([]domains.Scorecard, error)
+	Get(ctx context.Context, id primitive.ObjectID) (*domains.Scorecard, error)
+	Create(ctx context.Context, params *domains.CreateScorecardParams) (*domains.Scorecard, error)
+	Update(ctx context.Context, params *domains.UpdateScorecardParams) (*domains.Scorecard, error)
+	DeleteByAppointments(ctx context.Context, appointmentIDs []primitive.ObjectID) error
 }
@@ -8,15 +8,78 @@ import (
 
 type AuthServie interface {
 	CreateStaff(ctx context.Context, req *dto.CreateStaffRequest) error
-	Login(ctx context.Context, req *dto.LoginRequest) (string, error)
+	Login(ctx context.Context, req *dto.LoginRequest) (*domains.AuthToken, error)
+	RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*domains.AuthToken, error)
+	Logout(ctx context.Context, req *dto.LogoutRequest) error
+	EnrollMFA(ctx context.Context, req *dto.MFAEnrollRequest) (*domains.TOTPEnrollment, error)
+	VerifyMFA(ctx context.Context, req *dto.MFAVerifyRequest) (*domains.AuthToken, error)
+	ForgotPassword(ctx context.Context, req *dto.ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error
+	GetJWKS() []domains.JSONWebKey
+	StartOIDCLogin(ctx context.Context) (string, error)
+	CompleteOIDCLogin(ctx context.Context, req *dto.OIDCCallbackRequest) (*domains.AuthToken, error)
+	GetAuthSetting(ctx context.Context) (*domains.AuthSetting, error)
+	UpdateAuthSetting(ctx context.Context, req *dto.UpdateAuthSettingRequest) (*domains.AuthSetting, error)
+}
+
+type UserService interface {
+	GetUsers(ctx context.Context, req *dto.GetUsersRequest) ([]domains.User, error)
+	GetUser(ctx context.Context, id string) (*domains.User, error)
+	UpdateUser(ctx context.Context, req *dto.UpdateUserRequest) (*domains.User, error)
+	UpdateUserRole(ctx context.Context, req *dto.UpdateUserRoleRequest) error
+	DeactivateUser(ctx context.Context, req *dto.UpdateUserStatusRequest) error
+	ReactivateUser(ctx context.Context, req *dto.UpdateUserStatusRequest) error
+	UnlockUser(ctx context.Context, req *dto.UpdateUserStatusRequest) error
+	ChangePassword(ctx context.Context, req *dto.ChangePasswordRequest) error
+	EnrollMFA(ctx context.Context, userID string) (*domains.TOTPEnrollment, error)
+	ConfirmMFA(ctx context.Context, req *dto.MFACodeRequest) ([]string, error)
+	DisableMFA(ctx context.Context, req *dto.MFACodeRequest) error
+	CreateServiceAccount(ctx context.Context, req *dto.CreateServiceAccountRequest) (*domains.User, error)
+}
+
+type APIKeyService interface {
+	GetAPIKeys(ctx context.Context, userID string) ([]domains.APIKey, error)
+	CreateAPIKey(ctx context.Context, req *dto.CreateAPIKeyRequest) (*domains.IssuedAPIKey, error)
+	RevokeAPIKey(ctx context.Context, req *dto.RevokeAPIKeyRequest) error
 }
 
 type InterviewService interface {
-	GetInterviewAppointments(ctx context.Context, offset uint32, limit uint32) ([]domains.InterviewAppointment, error)
-	GetInterviewAppointment(ctx context.Context, id string) (*domains.InterviewAppointment, error)
+	GetInterviewAppointments(ctx context.Context, req *dto.GetInterviewAppointmentsRequest) (*domains.InterviewAppointmentPage, error)
+	GetInterviewAppointment(ctx context.Context, req *dto.GetInterviewAppointmentRequest) (*domains.InterviewAppointment, error)
 	CreateInterviewAppointment(ctx context.Context, req *dto.CreateInterviewAppointmentRequest) (*domains.InterviewAppointment, error)
-	UpdateInterviewAppointment(ctx context.Context, req *dto.UpdateInterviewAppointmentRequest) error
-	ArchiveInterviewAppointment(ctx context.Context, id string) error
+	UpdateInterviewAppointment(ctx context.Context, req *dto.UpdateInterviewAppointmentRequest) (int64, error)
+	ArchiveInterviewAppointment(ctx context.Context, req *dto.ArchiveInterviewAppointmentRequest) error
+	UnarchiveInterviewAppointment(ctx context.Context, req *dto.UnarchiveInterviewAppointmentRequest) error
+	PurgeArchivedInterviewAppointments(ctx context.Context) (int64, error)
+	AssignInterviewers(ctx context.Context, req *dto.AssignInterviewersRequest) error
+	GetInterviewerAppointments(ctx context.Context, req *dto.GetInterviewerAppointmentsRequest) ([]domains.InterviewAppointment, error)
+	GetInterviewComments(ctx context.Context, req *dto.GetInterviewCommentsRequest) ([]domains.InterviewComment, error)
 	AddInterviewComment(ctx context.Context, req *dto.AddInterviewCommentRequest) error
-	UpdateInterviewComment(ctx context.Context, req *dto.UpdateInterviewCommentRequest) error
+	UpdateInterviewComment(ctx context.Context, req *dto.UpdateInterviewCommentRequest) (int64, error)
+	DeleteInterviewComment(ctx context.Context, req *dto.DeleteInterviewCommentRequest) error
+	GetInterviewCommentRevisions(ctx context.Context, req *dto.GetInterviewCommentRevisionsRequest) ([]domains.InterviewCommentRevision, error)
+	GetWorkflow(ctx context.Context) (*domains.Workflow, error)
+	UpdateWorkflow(ctx context.Context, req *dto.UpdateWorkflowRequest) (*domains.Workflow, error)
+	GetInterviewActivity(ctx context.Context, req *dto.GetInterviewActivityRequest) ([]domains.AuditEvent, error)
+}
+
+type CandidateService interface {
+	GetCandidates(ctx context.Context, req *dto.GetCandidatesRequest) ([]domains.Candidate, error)
+	GetCandidate(ctx context.Context, id string) (*domains.Candidate, error)
+	CreateCandidate(ctx context.Context, req *dto.CreateCandidateRequest) (*domains.Candidate, error)
+	UpdateCandidate(ctx context.Context, req *dto.UpdateCandidateRequest) (*domains.Candidate, error)
+}
+
+type ScorecardService interface {
+	GetScorecardTemplates(ctx context.Context, req *dto.GetScorecardTemplatesRequest) ([]domains.ScorecardTemplate, error)
+	GetScorecardTemplate(ctx context.Context, id string) (*domains.ScorecardTemplate, error)
+	CreateScorecardTemplate(ctx context.Context, req *dto.CreateScorecardTemplateRequest) (*domains.ScorecardTemplate, error)
+	ArchiveScorecardTemplate(ctx context.Context, req *dto.ArchiveScorecardTemplateRequest) (*domains.ScorecardTemplate, error)
+	GetScorecards(ctx context.Context, req *dto.GetScorecardsRequest) ([]domains.Scorecard, error)
+	SubmitScorecard(ctx context.Context, req *dto.SubmitScorecardRequest) (*domains.Scorecard, error)
+	UpdateScorecard(ctx context.Context, req *dto.UpdateScorecardRequest) (*domains.Scorecard, error)
+}
+
+type AuditService interface {
+	GetAuditEvents(ctx context.Context, req *dto.GetAuditEventsRequest) ([]domains.AuditEvent, error)
 }
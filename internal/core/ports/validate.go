@@ -9,14 +9,71 @@ import (
 type AuthValidate interface {
 	ValidateLogin(ctx *gin.Context) (*dto.LoginRequest, error)
 	ValidateCreateStaff(ctx *gin.Context) (*dto.CreateStaffRequest, error)
+	ValidateRefreshToken(ctx *gin.Context) (*dto.RefreshTokenRequest, error)
+	ValidateLogout(ctx *gin.Context) (*dto.LogoutRequest, error)
+	ValidateMFAEnroll(ctx *gin.Context) (*dto.MFAEnrollRequest, error)
+	ValidateMFAVerify(ctx *gin.Context) (*dto.MFAVerifyRequest, error)
+	ValidateForgotPassword(ctx *gin.Context) (*dto.ForgotPasswordRequest, error)
+	ValidateResetPassword(ctx *gin.Context) (*dto.ResetPasswordRequest, error)
+	ValidateOIDCCallback(ctx *gin.Context) (*dto.OIDCCallbackRequest, error)
+	ValidateUpdateAuthSetting(ctx *gin.Context) (*dto.UpdateAuthSettingRequest, error)
+}
+
+type UserValidate interface {
+	ValidateGetUsers(ctx *gin.Context) (*dto.GetUsersRequest, error)
+	ValidateGetUser(ctx *gin.Context) (string, error)
+	ValidateUpdateUser(ctx *gin.Context) (*dto.UpdateUserRequest, error)
+	ValidateUpdateUserRole(ctx *gin.Context) (*dto.UpdateUserRoleRequest, error)
+	ValidateUpdateUserStatus(ctx *gin.Context) (*dto.UpdateUserStatusRequest, error)
+	ValidateUnlockUser(ctx *gin.Context) (*dto.UpdateUserStatusRequest, error)
+	ValidateGetMe(ctx *gin.Context) (string, error)
+	ValidateUpdateMe(ctx *gin.Context) (*dto.UpdateUserRequest, error)
+	ValidateChangePassword(ctx *gin.Context) (*dto.ChangePasswordRequest, error)
+	ValidateMFACode(ctx *gin.Context) (*dto.MFACodeRequest, error)
+	ValidateCreateServiceAccount(ctx *gin.Context) (*dto.CreateServiceAccountRequest, error)
+}
+
+type APIKeyValidate interface {
+	ValidateGetAPIKeys(ctx *gin.Context) (string, error)
+	ValidateCreateAPIKey(ctx *gin.Context) (*dto.CreateAPIKeyRequest, error)
+	ValidateRevokeAPIKey(ctx *gin.Context) (*dto.RevokeAPIKeyRequest, error)
 }
 
 type InterviewValidate interface {
 	ValidateGetInterviewAppointments(ctx *gin.Context) (*dto.GetInterviewAppointmentsRequest, error)
-	ValidateGetInterviewAppointment(ctx *gin.Context) (string, error)
+	ValidateGetInterviewAppointment(ctx *gin.Context) (*dto.GetInterviewAppointmentRequest, error)
 	ValidateCreateInterviewAppointment(ctx *gin.Context) (*dto.CreateInterviewAppointmentRequest, error)
 	ValidateUpdateInterviewAppointment(ctx *gin.Context) (*dto.UpdateInterviewAppointmentRequest, error)
-	ValidateArchiveInterviewAppointment(ctx *gin.Context) (string, error)
+	ValidateArchiveInterviewAppointment(ctx *gin.Context) (*dto.ArchiveInterviewAppointmentRequest, error)
+	ValidateUnarchiveInterviewAppointment(ctx *gin.Context) (*dto.UnarchiveInterviewAppointmentRequest, error)
+	ValidateAssignInterviewers(ctx *gin.Context) (*dto.AssignInterviewersRequest, error)
+	ValidateGetInterviewerAppointments(ctx *gin.Context) (*dto.GetInterviewerAppointmentsRequest, error)
+	ValidateGetInterviewComments(ctx *gin.Context) (*dto.GetInterviewCommentsRequest, error)
 	ValidateAddInterviewComment(ctx *gin.Context) (*dto.AddInterviewCommentRequest, error)
 	ValidateUpdateInterviewComment(ctx *gin.Context) (*dto.UpdateInterviewCommentRequest, error)
+	ValidateDeleteInterviewComment(ctx *gin.Context) (*dto.DeleteInterviewCommentRequest, error)
+	ValidateGetInterviewCommentRevisions(ctx *gin.Context) (*dto.GetInterviewCommentRevisionsRequest, error)
+	ValidateUpdateWorkflow(ctx *gin.Context) (*dto.UpdateWorkflowRequest, error)
+	ValidateGetInterviewActivity(ctx *gin.Context) (*dto.GetInterviewActivityRequest, error)
+}
+
+type CandidateValidate interface {
+	ValidateGetCandidates(ctx *gin.Context) (*dto.GetCandidatesRequest, error)
+	ValidateGetCandidate(ctx *gin.Context) (string, error)
+	ValidateCreateCandidate(ctx *gin.Context) (*dto.CreateCandidateRequest, error)
+	ValidateUpdateCandidate(ctx *gin.Context) (*dto.UpdateCandidateRequest, error)
+}
+
+type ScorecardValidate interface {
+	ValidateGetScorecardTemplates(ctx *gin.Context) (*dto.GetScorecardTemplatesRequest, error)
+	ValidateGetScorecardTemplate(ctx *gin.Context) (string, error)
+	ValidateCreateScorecardTemplate(ctx *gin.Context) (*dto.CreateScorecardTemplateRequest, error)
+	ValidateArchiveScorecardTemplate(ctx *gin.Context) (*dto.ArchiveScorecardTemplateRequest, error)
+	ValidateGetScorecards(ctx *gin.Context) (*dto.GetScorecardsRequest, error)
+	ValidateSubmitScorecard(ctx *gin.Context) (*dto.SubmitScorecardRequest, error)
+	ValidateUpdateScorecard(ctx *gin.Context) (*dto.UpdateScorecardRequest, error)
+}
+
+type AuditValidate interface {
+	ValidateGetAuditEvents(ctx *gin.Context) (*dto.GetAuditEventsRequest, error)
 }
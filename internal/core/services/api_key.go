@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type apiKeyService struct {
+	apiKeyRepo     ports.APIKeyRepository
+	userRepo       ports.UserRepository
+	auditEventRepo ports.AuditEventRepository
+}
+
+func NewAPIKeyService(apiKeyRepo ports.APIKeyRepository, userRepo ports.UserRepository, auditEventRepo ports.AuditEventRepository) ports.APIKeyService {
+	return &apiKeyService{
+		apiKeyRepo:     apiKeyRepo,
+		userRepo:       userRepo,
+		auditEventRepo: auditEventRepo,
+	}
+}
+
+func (s *apiKeyService) GetAPIKeys(ctx context.Context, userID string) ([]domains.APIKey, error) {
+	id, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	data, err := s.apiKeyRepo.GetByUserID(ctx, id)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get api keys.")
+	}
+	return data, nil
+}
+
+// CreateAPIKey issues a key for the owner in req.UserID. Users can always
+// issue personal keys; keys for someone else can only be issued to service
+// accounts.
+func (s *apiKeyService) CreateAPIKey(ctx context.Context, req *dto.CreateAPIKeyRequest) (*domains.IssuedAPIKey, error) {
+	ownerID, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	createdBy, err := primitive.ObjectIDFromHex(req.CreatedBy)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	owner, err := s.userRepo.Get(ctx, ownerID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if owner == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "User not found.")
+	}
+	if ownerID != createdBy && !owner.IsServiceAccount {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "API keys can only be issued to service accounts")
+	}
+	prefix, key, err := helpers.GenerateAPIKey()
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	params := &domains.CreateAPIKeyParams{
+		UserID:    ownerID,
+		Name:      req.Name,
+		Prefix:    prefix,
+		KeyHash:   helpers.HashToken(key),
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+		CreatedBy: createdBy,
+	}
+	data, err := s.apiKeyRepo.Create(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	diff := auditDiff{}
+	diff.add("name", nil, data.Name)
+	diff.add("prefix", nil, data.Prefix)
+	diff.add("scopes", nil, data.Scopes)
+	diff.add("expiresAt", nil, data.ExpiresAt)
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    createdBy,
+		Action:     constants.AUDIT_API_KEY_CREATE,
+		TargetType: constants.AUDIT_TARGET_API_KEY,
+		TargetID:   data.ID,
+		Changes:    diff,
+		Metadata:   map[string]string{"ownerId": ownerID.Hex()},
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return &domains.IssuedAPIKey{APIKey: *data, Key: key}, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, req *dto.RevokeAPIKeyRequest) error {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	ownerID, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	revokedBy, err := primitive.ObjectIDFromHex(req.RevokedBy)
+	if err != nil {
+		return helpers.InternalError
+	}
+	data, err := s.apiKeyRepo.Get(ctx, id)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if data == nil || data.UserID != ownerID {
+		return helpers.NewCustomError(http.StatusNotFound, "API key not found.")
+	}
+	if data.RevokedAt != nil {
+		return nil
+	}
+	if err := s.apiKeyRepo.Revoke(ctx, id); err != nil {
+		return helpers.InternalError
+	}
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    revokedBy,
+		Action:     constants.AUDIT_API_KEY_REVOKE,
+		TargetType: constants.AUDIT_TARGET_API_KEY,
+		TargetID:   id,
+		Metadata:   map[string]string{"ownerId": ownerID.Hex(), "prefix": data.Prefix},
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return nil
+}
@@ -0,0 +1,167 @@
+package services_test
+
+import (
+	"errors"
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/core/services"
+	"robinhood-assignment/internal/dto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testAPIKeyService struct {
+	apiKeyRepo     *mocks.APIKeyRepository
+	userRepo       *mocks.UserRepository
+	auditEventRepo *mocks.AuditEventRepository
+	service        ports.APIKeyService
+}
+
+func newTestAPIKeyService(t *testing.T) testAPIKeyService {
+	apiKeyRepo := mocks.NewAPIKeyRepository(t)
+	userRepo := mocks.NewUserRepository(t)
+	auditEventRepo := mocks.NewAuditEventRepository(t)
+	service := services.NewAPIKeyService(apiKeyRepo, userRepo, auditEventRepo)
+	return testAPIKeyService{apiKeyRepo, userRepo, auditEventRepo, service}
+}
+
+func TestGetAPIKeys(t *testing.T) {
+	t.Run("get api keys success", func(t *testing.T) {
+		tsvc := newTestAPIKeyService(t)
+		expected := []domains.APIKey{{ID: primitive.NewObjectID(), UserID: userId, Name: "sync"}}
+		tsvc.apiKeyRepo.On("GetByUserID", ctx, userId).Return(expected, nil)
+		got, err := tsvc.service.GetAPIKeys(ctx, userId.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get api keys error", func(t *testing.T) {
+		tsvc := newTestAPIKeyService(t)
+		tsvc.apiKeyRepo.On("GetByUserID", ctx, userId).Return([]domains.APIKey{}, errors.New("some error"))
+		got, err := tsvc.service.GetAPIKeys(ctx, userId.Hex())
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get api keys."), err)
+	})
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	expiresAt := time.Now().Add(24 * time.Hour)
+	t.Run("create personal api key success", func(t *testing.T) {
+		tsvc := newTestAPIKeyService(t)
+		req := &dto.CreateAPIKeyRequest{
+			UserID:    userId.Hex(),
+			Name:      "sync",
+			Scopes:    []string{constants.SCOPE_INTERVIEWS_READ},
+			ExpiresAt: &expiresAt,
+			CreatedBy: userId.Hex(),
+		}
+		var params *domains.CreateAPIKeyParams
+		created := &domains.APIKey{ID: primitive.NewObjectID(), UserID: userId, Name: "sync", Prefix: "rh_1a2b3c4d", Scopes: req.Scopes, ExpiresAt: &expiresAt}
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		tsvc.apiKeyRepo.On("Create", ctx, mock.MatchedBy(func(p *domains.CreateAPIKeyParams) bool {
+			params = p
+			return p.UserID == userId && p.CreatedBy == userId && p.Name == "sync" && p.ExpiresAt == &expiresAt
+		})).Return(created, nil)
+		tsvc.auditEventRepo.On("Create", ctx, &domains.CreateAuditEventParams{
+			ActorID:    userId,
+			Action:     constants.AUDIT_API_KEY_CREATE,
+			TargetType: constants.AUDIT_TARGET_API_KEY,
+			TargetID:   created.ID,
+			Changes: []domains.AuditChange{
+				{Field: "name", After: "sync"},
+				{Field: "prefix", After: "rh_1a2b3c4d"},
+				{Field: "scopes", After: req.Scopes},
+				{Field: "expiresAt", After: &expiresAt},
+			},
+			Metadata: map[string]string{"ownerId": userId.Hex()},
+		}).Return(nil)
+		got, err := tsvc.service.CreateAPIKey(ctx, req)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(got.Key, params.Prefix+"_"))
+		assert.Equal(t, helpers.HashToken(got.Key), params.KeyHash)
+	})
+	t.Run("create api key for service account success", func(t *testing.T) {
+		tsvc := newTestAPIKeyService(t)
+		serviceAccount := domains.User{ID: primitive.NewObjectID(), Username: "sync-bot", Role: constants.STAFF_ROLE, IsServiceAccount: true}
+		req := &dto.CreateAPIKeyRequest{
+			UserID:    serviceAccount.ID.Hex(),
+			Name:      "sync",
+			Scopes:    []string{constants.SCOPE_INTERVIEWS_WRITE},
+			CreatedBy: adminId.Hex(),
+		}
+		tsvc.userRepo.On("Get", ctx, serviceAccount.ID).Return(&serviceAccount, nil)
+		tsvc.apiKeyRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateAPIKeyParams")).Return(&domains.APIKey{UserID: serviceAccount.ID}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+			return p.ActorID == adminId && p.Action == constants.AUDIT_API_KEY_CREATE && p.Metadata["ownerId"] == serviceAccount.ID.Hex()
+		})).Return(nil)
+		got, err := tsvc.service.CreateAPIKey(ctx, req)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, got.Key)
+	})
+	t.Run("create api key error when issuing for another human user", func(t *testing.T) {
+		tsvc := newTestAPIKeyService(t)
+		req := &dto.CreateAPIKeyRequest{
+			UserID:    userId.Hex(),
+			Name:      "sync",
+			Scopes:    []string{constants.SCOPE_INTERVIEWS_READ},
+			CreatedBy: adminId.Hex(),
+		}
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		got, err := tsvc.service.CreateAPIKey(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "API keys can only be issued to service accounts"), err)
+	})
+	t.Run("create api key error when user not found", func(t *testing.T) {
+		tsvc := newTestAPIKeyService(t)
+		req := &dto.CreateAPIKeyRequest{
+			UserID:    userId.Hex(),
+			Name:      "sync",
+			Scopes:    []string{constants.SCOPE_INTERVIEWS_READ},
+			CreatedBy: adminId.Hex(),
+		}
+		tsvc.userRepo.On("Get", ctx, userId).Return(nil, nil)
+		got, err := tsvc.service.CreateAPIKey(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusNotFound, "User not found."), err)
+	})
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	keyId := primitive.NewObjectID()
+	t.Run("revoke api key success", func(t *testing.T) {
+		tsvc := newTestAPIKeyService(t)
+		tsvc.apiKeyRepo.On("Get", ctx, keyId).Return(&domains.APIKey{ID: keyId, UserID: userId, Prefix: "rh_1a2b3c4d"}, nil)
+		tsvc.apiKeyRepo.On("Revoke", ctx, keyId).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_API_KEY_REVOKE,
+			TargetType: constants.AUDIT_TARGET_API_KEY,
+			TargetID:   keyId,
+			Metadata:   map[string]string{"ownerId": userId.Hex(), "prefix": "rh_1a2b3c4d"},
+		}).Return(nil)
+		err := tsvc.service.RevokeAPIKey(ctx, &dto.RevokeAPIKeyRequest{ID: keyId.Hex(), UserID: userId.Hex(), RevokedBy: adminId.Hex()})
+		assert.NoError(t, err)
+	})
+	t.Run("revoke api key error when key belongs to another user", func(t *testing.T) {
+		tsvc := newTestAPIKeyService(t)
+		tsvc.apiKeyRepo.On("Get", ctx, keyId).Return(&domains.APIKey{ID: keyId, UserID: adminId}, nil)
+		err := tsvc.service.RevokeAPIKey(ctx, &dto.RevokeAPIKeyRequest{ID: keyId.Hex(), UserID: userId.Hex(), RevokedBy: adminId.Hex()})
+		assert.Equal(t, helpers.NewCustomError(http.StatusNotFound, "API key not found."), err)
+	})
+	t.Run("revoke api key already revoked", func(t *testing.T) {
+		tsvc := newTestAPIKeyService(t)
+		revokedAt := time.Now()
+		tsvc.apiKeyRepo.On("Get", ctx, keyId).Return(&domains.APIKey{ID: keyId, UserID: userId, RevokedAt: &revokedAt}, nil)
+		err := tsvc.service.RevokeAPIKey(ctx, &dto.RevokeAPIKeyRequest{ID: keyId.Hex(), UserID: userId.Hex(), RevokedBy: adminId.Hex()})
+		assert.NoError(t, err)
+		tsvc.auditEventRepo.AssertNotCalled(t, "Create", ctx, mock.Anything)
+	})
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"reflect"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type auditService struct {
+	auditEventRepo ports.AuditEventRepository
+}
+
+func NewAuditService(auditEventRepo ports.AuditEventRepository) ports.AuditService {
+	return &auditService{auditEventRepo: auditEventRepo}
+}
+
+// GetAuditEvents returns a page of the audit log matching the filters of
+// req, newest first.
+func (s *auditService) GetAuditEvents(ctx context.Context, req *dto.GetAuditEventsRequest) ([]domains.AuditEvent, error) {
+	params := &domains.GetAuditEventsParams{
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		RequestID:  req.RequestID,
+		From:       req.From,
+		To:         req.To,
+		Limit:      int64(req.Limit) + 1,
+	}
+	ids := []struct {
+		value string
+		to    *primitive.ObjectID
+	}{
+		{req.ActorID, &params.ActorID},
+		{req.TargetID, &params.TargetID},
+		{req.AppointmentID, &params.AppointmentID},
+	}
+	for _, id := range ids {
+		if id.value == "" {
+			continue
+		}
+		objId, err := primitive.ObjectIDFromHex(id.value)
+		if err != nil {
+			return nil, helpers.InternalError
+		}
+		*id.to = objId
+	}
+	if req.Cursor != "" {
+		createdAt, eventId, err := decodeAuditCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		params.BeforeCreatedAt, params.BeforeID = createdAt, eventId
+	}
+	data, err := s.auditEventRepo.GetAll(ctx, params)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get audit events.")
+	}
+	return data, nil
+}
+
+// decodeAuditCursor returns the time and id of the last event of the previous
+// page.
+func decodeAuditCursor(cursor string) (time.Time, primitive.ObjectID, error) {
+	createdAt, eventId, err := helpers.DecodeCreatedAtCursor(cursor, true)
+	if err != nil {
+		return time.Time{}, primitive.NilObjectID, errInvalidCursor
+	}
+	id, err := primitive.ObjectIDFromHex(eventId)
+	if err != nil {
+		return time.Time{}, primitive.NilObjectID, errInvalidCursor
+	}
+	return createdAt, id, nil
+}
+
+// recordAudit adds an event to the audit log. The change it describes is
+// already saved by then, so a failure is only logged.
+func recordAudit(ctx context.Context, auditEventRepo ports.AuditEventRepository, params *domains.CreateAuditEventParams) {
+	if err := auditEventRepo.Create(ctx, params); err != nil {
+		log.Printf("failed to record audit event %s: %s\n", params.Action, err.Error())
+	}
+}
+
+// auditDiff collects the fields that differ between two versions of a
+// target. Empty values, such as a nil and an empty list, count as equal.
+type auditDiff []domains.AuditChange
+
+func (d *auditDiff) add(field string, before interface{}, after interface{}) {
+	if isEmptyAuditValue(before) && isEmptyAuditValue(after) {
+		return
+	}
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	change := domains.AuditChange{Field: field}
+	if !isEmptyAuditValue(before) {
+		change.Before = before
+	}
+	if !isEmptyAuditValue(after) {
+		change.After = after
+	}
+	*d = append(*d, change)
+}
+
+func isEmptyAuditValue(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map:
+		return value.Len() == 0
+	case reflect.Bool:
+		// false is a value worth showing next to true.
+		return false
+	}
+	return value.IsZero()
+}
@@ -0,0 +1,94 @@
+package services_test
+
+import (
+	"errors"
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/core/services"
+	"robinhood-assignment/internal/dto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testAuditService struct {
+	auditEventRepo *mocks.AuditEventRepository
+	service        ports.AuditService
+}
+
+func newTestAuditService(t *testing.T) testAuditService {
+	auditEventRepo := mocks.NewAuditEventRepository(t)
+
+	service := services.NewAuditService(auditEventRepo)
+	return testAuditService{auditEventRepo, service}
+}
+
+func TestGetAuditEvents(t *testing.T) {
+	t.Run("get audit events success", func(t *testing.T) {
+		tsvc := newTestAuditService(t)
+		actorId := primitive.NewObjectID()
+		appointmentId := primitive.NewObjectID()
+		from := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+		req := &dto.GetAuditEventsRequest{
+			ActorID:       actorId.Hex(),
+			Action:        constants.AUDIT_INTERVIEW_UPDATE,
+			TargetType:    constants.AUDIT_TARGET_INTERVIEW,
+			AppointmentID: appointmentId.Hex(),
+			From:          &from,
+			Limit:         10,
+		}
+		params := &domains.GetAuditEventsParams{
+			ActorID:       actorId,
+			Action:        req.Action,
+			TargetType:    req.TargetType,
+			AppointmentID: appointmentId,
+			From:          &from,
+			Limit:         11,
+		}
+		expected := []domains.AuditEvent{{ID: primitive.NewObjectID(), ActorID: actorId, Action: req.Action}}
+		tsvc.auditEventRepo.On("GetAll", ctx, params).Return(expected, nil)
+		got, err := tsvc.service.GetAuditEvents(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get audit events after cursor", func(t *testing.T) {
+		tsvc := newTestAuditService(t)
+		createdAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		beforeId := primitive.NewObjectID()
+		req := &dto.GetAuditEventsRequest{Cursor: helpers.NewCreatedAtCursor(createdAt, beforeId.Hex(), true), Limit: 20}
+		params := &domains.GetAuditEventsParams{BeforeCreatedAt: createdAt, BeforeID: beforeId, Limit: 21}
+		tsvc.auditEventRepo.On("GetAll", ctx, params).Return([]domains.AuditEvent{}, nil)
+		got, err := tsvc.service.GetAuditEvents(ctx, req)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("get audit events error when cursor is invalid", func(t *testing.T) {
+		tsvc := newTestAuditService(t)
+		req := &dto.GetAuditEventsRequest{Cursor: "xxxxxxx", Limit: 20}
+		got, err := tsvc.service.GetAuditEvents(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter"), err)
+	})
+	t.Run("get audit events error when invalid id format", func(t *testing.T) {
+		tsvc := newTestAuditService(t)
+		req := &dto.GetAuditEventsRequest{TargetID: "xxxxxxx", Limit: 20}
+		got, err := tsvc.service.GetAuditEvents(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+	t.Run("get audit events error when query fail", func(t *testing.T) {
+		tsvc := newTestAuditService(t)
+		req := &dto.GetAuditEventsRequest{Limit: 20}
+		expected := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get audit events.")
+		tsvc.auditEventRepo.On("GetAll", ctx, &domains.GetAuditEventsParams{Limit: 21}).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetAuditEvents(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
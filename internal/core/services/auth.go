@@ -2,28 +2,64 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"robinhood-assignment/config"
 	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
 	"robinhood-assignment/internal/core/domains"
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/dto"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type authService struct {
-	userRepo ports.UserRepository
-	myBcrypt ports.MyBcrypt
-	myJWT    ports.MyJWT
+	userRepo               ports.UserRepository
+	refreshTokenRepo       ports.RefreshTokenRepository
+	passwordResetTokenRepo ports.PasswordResetTokenRepository
+	loginAttemptRepo       ports.LoginAttemptRepository
+	oidcStateRepo          ports.OIDCStateRepository
+	authSettingRepo        ports.AuthSettingRepository
+	auditEventRepo         ports.AuditEventRepository
+	myBcrypt               ports.MyBcrypt
+	myJWT                  ports.MyJWT
+	mailer                 ports.Mailer
+	oidcProvider           ports.OIDCProvider
 }
 
-func NewAuthService(userRepo ports.UserRepository, myBcrypt ports.MyBcrypt, myJWT ports.MyJWT) ports.AuthServie {
-	return &authService{userRepo, myBcrypt, myJWT}
+// NewAuthService accepts a nil oidcProvider when single sign-on is not
+// configured.
+func NewAuthService(userRepo ports.UserRepository, refreshTokenRepo ports.RefreshTokenRepository, passwordResetTokenRepo ports.PasswordResetTokenRepository, loginAttemptRepo ports.LoginAttemptRepository, oidcStateRepo ports.OIDCStateRepository, authSettingRepo ports.AuthSettingRepository, auditEventRepo ports.AuditEventRepository, myBcrypt ports.MyBcrypt, myJWT ports.MyJWT, mailer ports.Mailer, oidcProvider ports.OIDCProvider) ports.AuthServie {
+	return &authService{userRepo, refreshTokenRepo, passwordResetTokenRepo, loginAttemptRepo, oidcStateRepo, authSettingRepo, auditEventRepo, myBcrypt, myJWT, mailer, oidcProvider}
 }
 
+// dummyPasswordHash is compared against when the username does not exist so
+// that unknown and existing usernames take the same time to reject.
+const dummyPasswordHash = "$2a$10$r8y8trGVjEctsA7..uadLu60GX5BNghyQWi2Pi47nS6Ygvs7jL9oO"
+
+var errInvalidCredentials = helpers.NewCustomError(http.StatusUnauthorized, "Invalid username or password")
+
+var errTooManyLoginAttempts = helpers.NewCustomError(http.StatusTooManyRequests, "Too many failed login attempts, please try again later")
+
+var errInvalidMFAToken = helpers.NewCustomError(http.StatusUnauthorized, "Invalid mfa token")
+
+// Login methods, as recorded in the audit log. A passed second factor is
+// recorded as mfaMethodTOTP or mfaMethodRecoveryCode instead of loginMethodMFA.
+const (
+	loginMethodPassword = "password"
+	loginMethodOIDC     = "oidc"
+	loginMethodMFA      = "mfa"
+)
+
 func (a *authService) CreateStaff(ctx context.Context, req *dto.CreateStaffRequest) error {
+	adminId, err := primitive.ObjectIDFromHex(req.CreatedBy)
+	if err != nil {
+		return helpers.InternalError
+	}
 	user, err := a.userRepo.GetByUsername(ctx, req.Username)
 	if err != nil {
 		return helpers.InternalError
@@ -44,33 +80,446 @@ func (a *authService) CreateStaff(ctx context.Context, req *dto.CreateStaffReque
 		ImageUrl: req.ImageUrl,
 		Role:     req.Role,
 	}
-	if _, err := a.userRepo.Create(ctx, params); err != nil {
+	created, err := a.userRepo.Create(ctx, params)
+	if err != nil {
 		return helpers.NewCustomError(http.StatusConflict, "Create staff fail")
 	}
+	diff := auditDiff{}
+	diff.add("name", nil, req.Name)
+	diff.add("email", nil, req.Email)
+	diff.add("username", nil, req.Username)
+	diff.add("imageUrl", nil, req.ImageUrl)
+	diff.add("role", nil, req.Role)
+	recordAudit(ctx, a.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    adminId,
+		Action:     constants.AUDIT_STAFF_CREATE,
+		TargetType: constants.AUDIT_TARGET_USER,
+		TargetID:   created.ID,
+		Changes:    diff,
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
 	return nil
 }
 
-func (a *authService) Login(ctx context.Context, req *dto.LoginRequest) (string, error) {
+func (a *authService) Login(ctx context.Context, req *dto.LoginRequest) (*domains.AuthToken, error) {
+	setting, err := a.authSettingRepo.Get(ctx)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if setting.PasswordLoginDisabled {
+		return nil, helpers.NewCustomError(http.StatusForbidden, "Password login is disabled, please sign in with single sign-on")
+	}
+	attempt := loginAttempt{method: loginMethodPassword, username: req.Username, requestID: req.RequestID, clientIP: req.ClientIP}
+	guards := loginGuards(req)
+	for _, g := range guards {
+		counter, err := a.loginAttemptRepo.Get(ctx, g.key)
+		if err != nil {
+			return nil, helpers.InternalError
+		}
+		if counter != nil && counter.BlockedUntil != nil && counter.BlockedUntil.After(time.Now()) {
+			a.recordLogin(ctx, attempt, nil, constants.AUDIT_LOGIN_FAILED, "locked")
+			return nil, errTooManyLoginAttempts
+		}
+	}
 	user, err := a.userRepo.GetByUsername(ctx, req.Username)
 	if err != nil {
-		return "", helpers.InternalError
+		return nil, helpers.InternalError
+	}
+	canLogin := user != nil && !user.IsServiceAccount
+	passwordHash := dummyPasswordHash
+	if canLogin {
+		passwordHash = user.Password
+	}
+	if err := a.myBcrypt.CompareHashAndPassword(passwordHash, req.Password); err != nil || !canLogin {
+		if err := a.recordLoginFailure(ctx, guards); err != nil {
+			return nil, err
+		}
+		a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_FAILED, "invalid_credentials")
+		return nil, errInvalidCredentials
+	}
+	if err := a.loginAttemptRepo.Reset(ctx, usernameAttemptKey(req.Username)); err != nil {
+		return nil, helpers.InternalError
+	}
+	if user.IsDeactivated {
+		a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_FAILED, "deactivated")
+		return nil, helpers.NewCustomError(http.StatusForbidden, "Account is deactivated")
+	}
+	if mfaRequired(user) {
+		a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_MFA_CHALLENGED, "")
+		return a.issueMFAChallenge(user)
 	}
-	if user == nil {
-		return "", helpers.NewCustomError(http.StatusNotFound, "Username not found")
+	a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_SUCCEEDED, "")
+	return a.issueToken(ctx, user, primitive.NewObjectID())
+}
+
+// loginAttempt describes one login step for the audit log. method is how
+// the user proved who they are: password, oidc, or a second factor.
+type loginAttempt struct {
+	method    string
+	username  string
+	requestID string
+	clientIP  string
+}
+
+// recordLogin adds a login step to the audit log. user is nil when it is
+// not known who tried to log in, and reason says why a login failed.
+func (a *authService) recordLogin(ctx context.Context, attempt loginAttempt, user *domains.User, action string, reason string) {
+	params := &domains.CreateAuditEventParams{
+		Action:     action,
+		TargetType: constants.AUDIT_TARGET_USER,
+		Metadata:   map[string]string{"method": attempt.method},
+		RequestID:  attempt.requestID,
+		IPAddress:  attempt.clientIP,
+	}
+	if attempt.username != "" {
+		params.Metadata["username"] = attempt.username
+	}
+	if user != nil {
+		params.ActorID = user.ID
+		params.TargetID = user.ID
+		params.Metadata["username"] = user.Username
+	}
+	if reason != "" {
+		params.Metadata["reason"] = reason
+	}
+	recordAudit(ctx, a.auditEventRepo, params)
+}
+
+func (a *authService) EnrollMFA(ctx context.Context, req *dto.MFAEnrollRequest) (*domains.TOTPEnrollment, error) {
+	user, err := a.parseMFAToken(ctx, req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+	return startTOTPEnrollment(ctx, a.userRepo, user)
+}
+
+// VerifyMFA completes the second login step. A user who was sent here to
+// enroll confirms the pending secret instead and receives recovery codes
+// together with the tokens. Wrong codes count against the same lockout as
+// wrong passwords.
+func (a *authService) VerifyMFA(ctx context.Context, req *dto.MFAVerifyRequest) (*domains.AuthToken, error) {
+	attempt := loginAttempt{method: loginMethodMFA, requestID: req.RequestID, clientIP: req.ClientIP}
+	user, err := a.parseMFAToken(ctx, req.MFAToken)
+	if err == errInvalidMFAToken {
+		a.recordLogin(ctx, attempt, nil, constants.AUDIT_LOGIN_FAILED, "invalid_mfa_token")
+	}
+	if err != nil {
+		return nil, err
+	}
+	guards := []loginGuard{{"mfa:" + user.ID.Hex(), config.Get().Auth.LoginMaxAttempts, ""}}
+	counter, err := a.loginAttemptRepo.Get(ctx, guards[0].key)
+	if err != nil {
+		return nil, helpers.InternalError
 	}
-	if err := a.myBcrypt.CompareHashAndPassword(user.Password, req.Password); err != nil {
-		return "", helpers.NewCustomError(http.StatusUnauthorized, "Password is incorrect")
+	if counter != nil && counter.BlockedUntil != nil && counter.BlockedUntil.After(time.Now()) {
+		a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_FAILED, "locked")
+		return nil, errTooManyLoginAttempts
 	}
-	token := a.myJWT.NewWithClaims(jwt.SigningMethodHS256, domains.Claims{
-		UserID: user.ID.Hex(),
-		Role:   user.Role,
+	var recoveryCodes []string
+	if user.MFAEnabled {
+		attempt.method, err = verifySecondFactor(ctx, a.userRepo, user, req.Code)
+	} else {
+		attempt.method = mfaMethodTOTP
+		recoveryCodes, err = confirmTOTPEnrollment(ctx, a.userRepo, user, req.Code)
+	}
+	if err == errInvalidMFACode {
+		if err := a.recordLoginFailure(ctx, guards); err != nil {
+			return nil, err
+		}
+		attempt.method = loginMethodMFA
+		a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_FAILED, "invalid_code")
+		return nil, errInvalidMFACode
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := a.loginAttemptRepo.Reset(ctx, guards[0].key); err != nil {
+		return nil, helpers.InternalError
+	}
+	a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_SUCCEEDED, "")
+	token, err := a.issueToken(ctx, user, primitive.NewObjectID())
+	if err != nil {
+		return nil, err
+	}
+	token.RecoveryCodes = recoveryCodes
+	return token, nil
+}
+
+func (a *authService) RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*domains.AuthToken, error) {
+	refreshToken, err := a.refreshTokenRepo.GetByTokenHash(ctx, helpers.HashToken(req.RefreshToken))
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if refreshToken == nil || refreshToken.RevokedAt != nil || refreshToken.ExpiresAt.Before(time.Now()) {
+		return nil, helpers.NewCustomError(http.StatusUnauthorized, "Invalid refresh token")
+	}
+	if refreshToken.UsedAt != nil {
+		return nil, a.revokeReusedSession(ctx, refreshToken.SessionID)
+	}
+	if err := a.refreshTokenRepo.MarkUsed(ctx, refreshToken.ID); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, a.revokeReusedSession(ctx, refreshToken.SessionID)
+		}
+		return nil, helpers.InternalError
+	}
+	user, err := a.userRepo.Get(ctx, refreshToken.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if user == nil || user.IsDeactivated {
+		return nil, helpers.NewCustomError(http.StatusUnauthorized, "Invalid refresh token")
+	}
+	return a.issueToken(ctx, user, refreshToken.SessionID)
+}
+
+func (a *authService) GetJWKS() []domains.JSONWebKey {
+	return a.myJWT.PublicKeys()
+}
+
+func (a *authService) Logout(ctx context.Context, req *dto.LogoutRequest) error {
+	sessionID, err := primitive.ObjectIDFromHex(req.SessionID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if err := a.refreshTokenRepo.RevokeSession(ctx, sessionID); err != nil {
+		return helpers.InternalError
+	}
+	return nil
+}
+
+// ForgotPassword always succeeds for unknown or deactivated emails so the
+// endpoint cannot be used to discover which addresses have an account.
+func (a *authService) ForgotPassword(ctx context.Context, req *dto.ForgotPasswordRequest) error {
+	user, err := a.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if user == nil || user.IsDeactivated {
+		return nil
+	}
+	token, err := helpers.GenerateRandomToken()
+	if err != nil {
+		return helpers.InternalError
+	}
+	params := &domains.CreatePasswordResetTokenParams{
+		UserID:    user.ID,
+		TokenHash: helpers.HashToken(token),
+		ExpiresAt: time.Now().Add(config.Get().Auth.PasswordResetTTL),
+	}
+	if _, err := a.passwordResetTokenRepo.Create(ctx, params); err != nil {
+		return helpers.InternalError
+	}
+	mail := &domains.Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body:    passwordResetMailBody(user.Name, token),
+	}
+	if err := a.mailer.Send(ctx, mail); err != nil {
+		return helpers.InternalError
+	}
+	return nil
+}
+
+func (a *authService) ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error {
+	invalidToken := helpers.NewCustomError(http.StatusBadRequest, "Invalid or expired reset token")
+	resetToken, err := a.passwordResetTokenRepo.GetByTokenHash(ctx, helpers.HashToken(req.Token))
+	if err != nil {
+		return helpers.InternalError
+	}
+	if resetToken == nil || resetToken.UsedAt != nil || resetToken.ExpiresAt.Before(time.Now()) {
+		return invalidToken
+	}
+	if err := a.passwordResetTokenRepo.MarkUsed(ctx, resetToken.ID); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return invalidToken
+		}
+		return helpers.InternalError
+	}
+	user, err := a.userRepo.Get(ctx, resetToken.UserID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if user == nil || user.IsDeactivated {
+		return invalidToken
+	}
+	passHash, err := a.myBcrypt.GenerateFromPassword(req.Password, config.Get().Auth.BcryptCost)
+	if err != nil {
+		return helpers.InternalError
+	}
+	params := &domains.UpdateUserParams{
+		ID:       user.ID,
+		Password: *passHash,
+	}
+	if _, err := a.userRepo.Update(ctx, params); err != nil {
+		return helpers.InternalError
+	}
+	if err := a.passwordResetTokenRepo.InvalidateUserTokens(ctx, user.ID); err != nil {
+		return helpers.InternalError
+	}
+	if err := a.refreshTokenRepo.RevokeUserSessions(ctx, user.ID, primitive.NilObjectID); err != nil {
+		return helpers.InternalError
+	}
+	return nil
+}
+
+func passwordResetMailBody(name, token string) string {
+	ttl := config.Get().Auth.PasswordResetTTL
+	if url := config.Get().Auth.PasswordResetURL; url != "" {
+		return fmt.Sprintf("Hi %s,\n\nOpen the link below to reset your password. It expires in %s.\n\n%s%s\n", name, ttl, url, token)
+	}
+	return fmt.Sprintf("Hi %s,\n\nUse the token below to reset your password. It expires in %s.\n\n%s\n", name, ttl, token)
+}
+
+type loginGuard struct {
+	key         string
+	maxAttempts int
+	// clientIP is kept with the failures so that unlocking the guard can
+	// also clear the IP guards they were counted against.
+	clientIP string
+}
+
+func loginGuards(req *dto.LoginRequest) []loginGuard {
+	guards := []loginGuard{{usernameAttemptKey(req.Username), config.Get().Auth.LoginMaxAttempts, req.ClientIP}}
+	if req.ClientIP != "" {
+		guards = append(guards, loginGuard{ipAttemptKey(req.ClientIP), config.Get().Auth.LoginMaxAttemptsPerIP, ""})
+	}
+	return guards
+}
+
+func usernameAttemptKey(username string) string {
+	return "username:" + username
+}
+
+func ipAttemptKey(ip string) string {
+	return "ip:" + ip
+}
+
+// recordLoginFailure counts the failure against every guard. Each failure
+// blocks the next attempt for an exponentially growing delay, and reaching the
+// guard's limit locks it out for the lockout duration, after which the counter
+// starts over.
+func (a *authService) recordLoginFailure(ctx context.Context, guards []loginGuard) error {
+	cfg := config.Get().Auth
+	now := time.Now()
+	for _, g := range guards {
+		attempt, err := a.loginAttemptRepo.RecordFailure(ctx, g.key, g.clientIP, now.Add(cfg.LoginAttemptWindow))
+		if err != nil {
+			return helpers.InternalError
+		}
+		var blockedUntil, expiresAt time.Time
+		if g.maxAttempts > 0 && attempt.Failures >= g.maxAttempts {
+			blockedUntil = now.Add(cfg.LoginLockoutDuration)
+			expiresAt = blockedUntil
+		} else {
+			blockedUntil = now.Add(loginDelay(attempt.Failures))
+			expiresAt = attempt.ExpiresAt
+			if blockedUntil.After(expiresAt) {
+				expiresAt = blockedUntil
+			}
+		}
+		if !blockedUntil.After(now) {
+			continue
+		}
+		if err := a.loginAttemptRepo.Block(ctx, g.key, blockedUntil, expiresAt); err != nil {
+			return helpers.InternalError
+		}
+	}
+	return nil
+}
+
+func loginDelay(failures int) time.Duration {
+	cfg := config.Get().Auth
+	delay := cfg.LoginBaseDelay
+	for i := 1; i < failures && delay < cfg.LoginMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > cfg.LoginMaxDelay {
+		delay = cfg.LoginMaxDelay
+	}
+	return delay
+}
+
+func (a *authService) issueMFAChallenge(user *domains.User) (*domains.AuthToken, error) {
+	expiresAt := time.Now().Add(config.Get().Auth.MFAPendingTTL)
+	tokenString, err := a.myJWT.SignClaims(domains.Claims{
+		UserID:  user.ID.Hex(),
+		Purpose: constants.MFA_PENDING_PURPOSE,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	return &domains.AuthToken{
+		MFAToken:          tokenString,
+		MFAEnrollRequired: !user.MFAEnabled,
+		ExpiresAt:         expiresAt,
+	}, nil
+}
+
+func (a *authService) parseMFAToken(ctx context.Context, tokenString string) (*domains.User, error) {
+	claims := &domains.Claims{}
+	if _, err := a.myJWT.ParseWithClaims(tokenString, claims, a.myJWT.ParseToken); err != nil {
+		return nil, errInvalidMFAToken
+	}
+	if claims.Purpose != constants.MFA_PENDING_PURPOSE {
+		return nil, errInvalidMFAToken
+	}
+	userID, err := primitive.ObjectIDFromHex(claims.UserID)
+	if err != nil {
+		return nil, errInvalidMFAToken
+	}
+	user, err := a.userRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if user == nil || user.IsDeactivated {
+		return nil, errInvalidMFAToken
+	}
+	return user, nil
+}
+
+// revokeReusedSession kills the whole session once a rotated refresh token is
+// presented again, since either the client or an attacker holds a stolen copy.
+func (a *authService) revokeReusedSession(ctx context.Context, sessionID primitive.ObjectID) error {
+	if err := a.refreshTokenRepo.RevokeSession(ctx, sessionID); err != nil {
+		return helpers.InternalError
+	}
+	return helpers.NewCustomError(http.StatusUnauthorized, "Refresh token reuse detected")
+}
+
+func (a *authService) issueToken(ctx context.Context, user *domains.User, sessionID primitive.ObjectID) (*domains.AuthToken, error) {
+	now := time.Now()
+	expiresAt := now.Add(config.Get().Auth.AccessTokenTTL)
+	tokenString, err := a.myJWT.SignClaims(domains.Claims{
+		UserID:    user.ID.Hex(),
+		Role:      user.Role,
+		SessionID: sessionID.Hex(),
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(60 * time.Minute)),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 		},
 	})
-	tokenString, err := token.SignedString([]byte(config.Get().Auth.JwtSecret))
 	if err != nil {
-		return "", helpers.InternalError
+		return nil, helpers.InternalError
+	}
+	refreshToken, err := helpers.GenerateRandomToken()
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	params := &domains.CreateRefreshTokenParams{
+		UserID:    user.ID,
+		SessionID: sessionID,
+		TokenHash: helpers.HashToken(refreshToken),
+		ExpiresAt: now.Add(config.Get().Auth.RefreshTokenTTL),
+	}
+	if _, err := a.refreshTokenRepo.Create(ctx, params); err != nil {
+		return nil, helpers.InternalError
 	}
-	return tokenString, nil
+	return &domains.AuthToken{
+		AccessToken:  tokenString,
+		RefreshToken: refreshToken,
+		ExpiresAt:    expiresAt,
+	}, nil
 }
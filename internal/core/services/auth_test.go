@@ -11,28 +11,47 @@ import (
 	"robinhood-assignment/internal/core/ports/mocks"
 	"robinhood-assignment/internal/core/services"
 	"robinhood-assignment/internal/dto"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type testAuthService struct {
-	userRepo *mocks.UserRepository
-	myBcrypt *mocks.MyBcrypt
-	myJWT    *mocks.MyJWT
-	service  ports.AuthServie
+	userRepo               *mocks.UserRepository
+	refreshTokenRepo       *mocks.RefreshTokenRepository
+	passwordResetTokenRepo *mocks.PasswordResetTokenRepository
+	loginAttemptRepo       *mocks.LoginAttemptRepository
+	oidcStateRepo          *mocks.OIDCStateRepository
+	authSettingRepo        *mocks.AuthSettingRepository
+	auditEventRepo         *mocks.AuditEventRepository
+	myBcrypt               *mocks.MyBcrypt
+	myJWT                  *mocks.MyJWT
+	mailer                 *mocks.Mailer
+	oidcProvider           *mocks.OIDCProvider
+	service                ports.AuthServie
 }
 
 func newTestAuthService(t *testing.T) testAuthService {
 	userRepo := mocks.NewUserRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
 	myBcrypt := mocks.NewMyBcrypt(t)
 	myJWT := mocks.NewMyJWT(t)
+	passwordResetTokenRepo := mocks.NewPasswordResetTokenRepository(t)
+	loginAttemptRepo := mocks.NewLoginAttemptRepository(t)
+	mailer := mocks.NewMailer(t)
+	oidcStateRepo := mocks.NewOIDCStateRepository(t)
+	authSettingRepo := mocks.NewAuthSettingRepository(t)
+	auditEventRepo := mocks.NewAuditEventRepository(t)
+	oidcProvider := mocks.NewOIDCProvider(t)
 
-	service := services.NewAuthService(userRepo, myBcrypt, myJWT)
-	return testAuthService{userRepo, myBcrypt, myJWT, service}
+	service := services.NewAuthService(userRepo, refreshTokenRepo, passwordResetTokenRepo, loginAttemptRepo, oidcStateRepo, authSettingRepo, auditEventRepo, myBcrypt, myJWT, mailer, oidcProvider)
+	return testAuthService{userRepo, refreshTokenRepo, passwordResetTokenRepo, loginAttemptRepo, oidcStateRepo, authSettingRepo, auditEventRepo, myBcrypt, myJWT, mailer, oidcProvider, service}
 }
 
 var (
@@ -61,12 +80,13 @@ func TestCreateStaff(t *testing.T) {
 		tsvc := newTestAuthService(t)
 		passHash := "mockhashpassword"
 		req := &dto.CreateStaffRequest{
-			Name:     name,
-			Email:    email,
-			Username: username,
-			Password: password,
-			ImageUrl: imageUrl,
-			Role:     constants.STAFF_ROLE,
+			Name:      name,
+			Email:     email,
+			Username:  username,
+			Password:  password,
+			ImageUrl:  imageUrl,
+			Role:      constants.STAFF_ROLE,
+			CreatedBy: adminId.Hex(),
 		}
 		params := &domains.CreateUserParams{
 			Name:     req.Name,
@@ -79,18 +99,22 @@ func TestCreateStaff(t *testing.T) {
 		tsvc.userRepo.On("GetByUsername", ctx, username).Return(nil, nil)
 		tsvc.myBcrypt.On("GenerateFromPassword", password, bcryptCost).Return(&passHash, nil)
 		tsvc.userRepo.On("Create", ctx, params).Return(&user, nil)
+		tsvc.auditEventRepo.On("Create", ctx, mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+			return p.Action == constants.AUDIT_STAFF_CREATE && p.ActorID == adminId && p.TargetID == userId
+		})).Return(nil)
 		err := tsvc.service.CreateStaff(ctx, req)
 		assert.NoError(t, err)
 	})
 	t.Run("create staff error when input duplicate username", func(t *testing.T) {
 		tsvc := newTestAuthService(t)
 		req := &dto.CreateStaffRequest{
-			Name:     name,
-			Email:    email,
-			Username: username,
-			Password: password,
-			ImageUrl: imageUrl,
-			Role:     constants.STAFF_ROLE,
+			Name:      name,
+			Email:     email,
+			Username:  username,
+			Password:  password,
+			ImageUrl:  imageUrl,
+			Role:      constants.STAFF_ROLE,
+			CreatedBy: adminId.Hex(),
 		}
 		expected := helpers.NewCustomError(http.StatusConflict, "Duplicate username")
 		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&user, nil)
@@ -100,12 +124,13 @@ func TestCreateStaff(t *testing.T) {
 	t.Run("create staff error when get user fail", func(t *testing.T) {
 		tsvc := newTestAuthService(t)
 		req := &dto.CreateStaffRequest{
-			Name:     name,
-			Email:    email,
-			Username: username,
-			Password: password,
-			ImageUrl: imageUrl,
-			Role:     constants.STAFF_ROLE,
+			Name:      name,
+			Email:     email,
+			Username:  username,
+			Password:  password,
+			ImageUrl:  imageUrl,
+			Role:      constants.STAFF_ROLE,
+			CreatedBy: adminId.Hex(),
 		}
 		expected := helpers.InternalError
 		tsvc.userRepo.On("GetByUsername", ctx, username).Return(nil, errors.New("some error"))
@@ -115,12 +140,13 @@ func TestCreateStaff(t *testing.T) {
 	t.Run("create staff error when generate password hash fail", func(t *testing.T) {
 		tsvc := newTestAuthService(t)
 		req := &dto.CreateStaffRequest{
-			Name:     name,
-			Email:    email,
-			Username: username,
-			Password: password,
-			ImageUrl: imageUrl,
-			Role:     constants.STAFF_ROLE,
+			Name:      name,
+			Email:     email,
+			Username:  username,
+			Password:  password,
+			ImageUrl:  imageUrl,
+			Role:      constants.STAFF_ROLE,
+			CreatedBy: adminId.Hex(),
 		}
 		expected := helpers.InternalError
 		tsvc.userRepo.On("GetByUsername", ctx, username).Return(nil, nil)
@@ -132,12 +158,13 @@ func TestCreateStaff(t *testing.T) {
 		tsvc := newTestAuthService(t)
 		passHash := "mockhashpassword"
 		req := &dto.CreateStaffRequest{
-			Name:     name,
-			Email:    email,
-			Username: username,
-			Password: password,
-			ImageUrl: imageUrl,
-			Role:     constants.STAFF_ROLE,
+			Name:      name,
+			Email:     email,
+			Username:  username,
+			Password:  password,
+			ImageUrl:  imageUrl,
+			Role:      constants.STAFF_ROLE,
+			CreatedBy: adminId.Hex(),
 		}
 		params := &domains.CreateUserParams{
 			Name:     req.Name,
@@ -156,32 +183,51 @@ func TestCreateStaff(t *testing.T) {
 	})
 }
 
+// matchLoginAudit matches the audit event recorded for a password login.
+func matchLoginAudit(action string, reason string) interface{} {
+	return mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+		return p.Action == action && p.Metadata["method"] == "password" && p.Metadata["username"] == username && p.Metadata["reason"] == reason
+	})
+}
+
+// matchAuthAudit matches the audit event recorded for any login step.
+func matchAuthAudit(action string, method string, reason string) interface{} {
+	return mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+		return p.Action == action && p.Metadata["method"] == method && p.Metadata["reason"] == reason
+	})
+}
+
 func TestLogin(t *testing.T) {
 	t.Setenv("BCRYPT_COST", "8")
 	t.Setenv("JWT_SECRET", "mock-jwt-secret")
 	config.New()
+	usernameKey := "username:" + username
+	invalidCredentials := helpers.NewCustomError(http.StatusUnauthorized, "Invalid username or password")
+	matchClaims := mock.MatchedBy(func(claims domains.Claims) bool {
+		_, err := primitive.ObjectIDFromHex(claims.SessionID)
+		return claims.UserID == user.ID.Hex() && claims.Role == user.Role && err == nil
+	})
 	t.Run("login success", func(t *testing.T) {
 		tsvc := newTestAuthService(t)
 		req := &dto.LoginRequest{
 			Username: username,
 			Password: password,
 		}
-		claims := domains.Claims{
-			UserID: user.ID.Hex(),
-			Role:   user.Role,
-			RegisteredClaims: jwt.RegisteredClaims{
-				ExpiresAt: jwt.NewNumericDate(time.Now().Add(60 * time.Minute)),
-			},
-		}
-		token := jwt.New(jwt.SigningMethodHS256)
-		expected, err := token.SignedString([]byte(config.Get().Auth.JwtSecret))
+		expected := "jwt-token"
 
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
 		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&user, nil)
 		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(nil)
-		tsvc.myJWT.On("NewWithClaims", jwt.SigningMethodHS256, claims).Return(token, nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, usernameKey).Return(nil)
+		tsvc.myJWT.On("SignClaims", matchClaims).Return("jwt-token", nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(&domains.RefreshToken{}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_SUCCEEDED, "")).Return(nil)
 		got, err := tsvc.service.Login(ctx, req)
 		assert.NoError(t, err)
-		assert.Equal(t, expected, got)
+		assert.Equal(t, expected, got.AccessToken)
+		assert.NotEmpty(t, got.RefreshToken)
+		assert.WithinDuration(t, time.Now().Add(60*time.Minute), got.ExpiresAt, time.Second)
 	})
 	t.Run("login error when username not found", func(t *testing.T) {
 		tsvc := newTestAuthService(t)
@@ -189,26 +235,53 @@ func TestLogin(t *testing.T) {
 			Username: username,
 			Password: password,
 		}
-		expectedRes := ""
-		expectedErr := helpers.NewCustomError(http.StatusNotFound, "Username not found")
+		expectedErr := invalidCredentials
 
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
 		tsvc.userRepo.On("GetByUsername", ctx, username).Return(nil, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", mock.AnythingOfType("string"), password).Return(errors.New("some error"))
+		tsvc.loginAttemptRepo.On("RecordFailure", ctx, usernameKey, "", mock.AnythingOfType("time.Time")).Return(&domains.LoginAttempt{Failures: 1, ExpiresAt: time.Now().Add(15 * time.Minute)}, nil)
+		tsvc.loginAttemptRepo.On("Block", ctx, usernameKey, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_FAILED, "invalid_credentials")).Return(nil)
 		res, err := tsvc.service.Login(ctx, req)
-		assert.Equal(t, expectedRes, res)
+		assert.Nil(t, res)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("login error when user is a service account", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: "",
+		}
+		serviceAccount := user
+		serviceAccount.Password = ""
+		serviceAccount.IsServiceAccount = true
+
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
+		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&serviceAccount, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", mock.MatchedBy(func(hash string) bool { return hash != "" }), "").Return(errors.New("some error"))
+		tsvc.loginAttemptRepo.On("RecordFailure", ctx, usernameKey, "", mock.AnythingOfType("time.Time")).Return(&domains.LoginAttempt{Failures: 1, ExpiresAt: time.Now().Add(15 * time.Minute)}, nil)
+		tsvc.loginAttemptRepo.On("Block", ctx, usernameKey, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_FAILED, "invalid_credentials")).Return(nil)
+		res, err := tsvc.service.Login(ctx, req)
+		assert.Nil(t, res)
+		assert.Equal(t, invalidCredentials, err)
+	})
 	t.Run("login error when get user fail", func(t *testing.T) {
 		tsvc := newTestAuthService(t)
 		req := &dto.LoginRequest{
 			Username: username,
 			Password: password,
 		}
-		expectedRes := ""
 		expectedErr := helpers.InternalError
 
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
 		tsvc.userRepo.On("GetByUsername", ctx, username).Return(nil, errors.New("some error"))
 		res, err := tsvc.service.Login(ctx, req)
-		assert.Equal(t, expectedRes, res)
+		assert.Nil(t, res)
 		assert.Equal(t, expectedErr, err)
 	})
 	t.Run("login error when compare password fail", func(t *testing.T) {
@@ -217,36 +290,589 @@ func TestLogin(t *testing.T) {
 			Username: username,
 			Password: password,
 		}
-		expectedRes := ""
-		expectedErr := helpers.NewCustomError(http.StatusUnauthorized, "Password is incorrect")
+		expectedErr := invalidCredentials
 
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
 		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&user, nil)
 		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(errors.New("some error"))
+		tsvc.loginAttemptRepo.On("RecordFailure", ctx, usernameKey, "", mock.AnythingOfType("time.Time")).Return(&domains.LoginAttempt{Failures: 1, ExpiresAt: time.Now().Add(15 * time.Minute)}, nil)
+		tsvc.loginAttemptRepo.On("Block", ctx, usernameKey, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_FAILED, "invalid_credentials")).Return(nil)
 		res, err := tsvc.service.Login(ctx, req)
-		assert.Equal(t, expectedRes, res)
+		assert.Nil(t, res)
 		assert.Equal(t, expectedErr, err)
 	})
-	t.Run("call NewWithClaims func with correct parameter", func(t *testing.T) {
+	t.Run("login error when account is deactivated", func(t *testing.T) {
 		tsvc := newTestAuthService(t)
 		req := &dto.LoginRequest{
 			Username: username,
 			Password: password,
 		}
-		claims := domains.Claims{
-			UserID: user.ID.Hex(),
-			Role:   user.Role,
-			RegisteredClaims: jwt.RegisteredClaims{
-				ExpiresAt: jwt.NewNumericDate(time.Now().Add(60 * time.Minute)),
-			},
+		deactivatedUser := user
+		deactivatedUser.IsDeactivated = true
+		expectedErr := helpers.NewCustomError(http.StatusForbidden, "Account is deactivated")
+
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
+		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&deactivatedUser, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, usernameKey).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_FAILED, "deactivated")).Return(nil)
+		res, err := tsvc.service.Login(ctx, req)
+		assert.Nil(t, res)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("login error when create refresh token fail", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: password,
+		}
+		expectedErr := helpers.InternalError
+
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
+		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&user, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, usernameKey).Return(nil)
+		tsvc.myJWT.On("SignClaims", matchClaims).Return("jwt-token", nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(nil, errors.New("some error"))
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_SUCCEEDED, "")).Return(nil)
+		res, err := tsvc.service.Login(ctx, req)
+		assert.Nil(t, res)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("login error when sign token fail", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: password,
+		}
+		expectedErr := helpers.InternalError
+
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
+		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&user, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, usernameKey).Return(nil)
+		tsvc.myJWT.On("SignClaims", matchClaims).Return("", errors.New("some error"))
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_SUCCEEDED, "")).Return(nil)
+		res, err := tsvc.service.Login(ctx, req)
+		assert.Nil(t, res)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("call SignClaims func with correct parameter", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: password,
 		}
-		token := jwt.New(jwt.SigningMethodHS256)
 
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
 		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&user, nil)
 		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(nil)
-		tsvc.myJWT.On("NewWithClaims", jwt.SigningMethodHS256, claims).Return(token, nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, usernameKey).Return(nil)
+		tsvc.myJWT.On("SignClaims", matchClaims).Return("jwt-token", nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(&domains.RefreshToken{}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_SUCCEEDED, "")).Return(nil)
 		tsvc.service.Login(ctx, req)
 		tsvc.userRepo.AssertCalled(t, "GetByUsername", ctx, username)
 		tsvc.myBcrypt.AssertCalled(t, "CompareHashAndPassword", user.Password, password)
-		tsvc.myJWT.AssertCalled(t, "NewWithClaims", jwt.SigningMethodHS256, claims)
+		tsvc.myJWT.AssertCalled(t, "SignClaims", matchClaims)
+	})
+	t.Run("login error when username is blocked", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: password,
+		}
+		blockedUntil := time.Now().Add(time.Minute)
+		expectedErr := helpers.NewCustomError(http.StatusTooManyRequests, "Too many failed login attempts, please try again later")
+
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(&domains.LoginAttempt{Key: usernameKey, Failures: 5, BlockedUntil: &blockedUntil}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_FAILED, "locked")).Return(nil)
+		res, err := tsvc.service.Login(ctx, req)
+		assert.Nil(t, res)
+		assert.Equal(t, expectedErr, err)
+		tsvc.userRepo.AssertNotCalled(t, "GetByUsername", ctx, username)
+	})
+	t.Run("login error when client ip is blocked", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: password,
+			ClientIP: "10.0.0.1",
+		}
+		blockedUntil := time.Now().Add(time.Minute)
+		expectedErr := helpers.NewCustomError(http.StatusTooManyRequests, "Too many failed login attempts, please try again later")
+
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, "ip:10.0.0.1").Return(&domains.LoginAttempt{Key: "ip:10.0.0.1", Failures: 20, BlockedUntil: &blockedUntil}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_FAILED, "locked")).Return(nil)
+		res, err := tsvc.service.Login(ctx, req)
+		assert.Nil(t, res)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("login lock out after max attempts", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: password,
+			ClientIP: "10.0.0.1",
+		}
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, "ip:10.0.0.1").Return(nil, nil)
+		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&user, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(errors.New("some error"))
+		tsvc.loginAttemptRepo.On("RecordFailure", ctx, usernameKey, "10.0.0.1", mock.AnythingOfType("time.Time")).Return(&domains.LoginAttempt{Failures: 5, ExpiresAt: time.Now().Add(15 * time.Minute)}, nil)
+		tsvc.loginAttemptRepo.On("RecordFailure", ctx, "ip:10.0.0.1", "", mock.AnythingOfType("time.Time")).Return(&domains.LoginAttempt{Failures: 3, ExpiresAt: time.Now().Add(15 * time.Minute)}, nil)
+		tsvc.loginAttemptRepo.On("Block", ctx, usernameKey, mock.MatchedBy(func(until time.Time) bool {
+			return until.After(time.Now().Add(14 * time.Minute))
+		}), mock.AnythingOfType("time.Time")).Return(nil)
+		tsvc.loginAttemptRepo.On("Block", ctx, "ip:10.0.0.1", mock.MatchedBy(func(until time.Time) bool {
+			return until.Before(time.Now().Add(5 * time.Second))
+		}), mock.AnythingOfType("time.Time")).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_FAILED, "invalid_credentials")).Return(nil)
+		res, err := tsvc.service.Login(ctx, req)
+		assert.Nil(t, res)
+		assert.Equal(t, invalidCredentials, err)
+	})
+	t.Run("login error when password login is disabled", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: password,
+		}
+		expectedErr := helpers.NewCustomError(http.StatusForbidden, "Password login is disabled, please sign in with single sign-on")
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{PasswordLoginDisabled: true}, nil)
+		res, err := tsvc.service.Login(ctx, req)
+		assert.Nil(t, res)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestRefreshToken(t *testing.T) {
+	t.Setenv("JWT_SECRET", "mock-jwt-secret")
+	config.New()
+	rawToken := "mock-refresh-token"
+	sessionId := primitive.NewObjectID()
+	newRefreshToken := func() *domains.RefreshToken {
+		return &domains.RefreshToken{
+			ID:        primitive.NewObjectID(),
+			UserID:    user.ID,
+			SessionID: sessionId,
+			TokenHash: helpers.HashToken(rawToken),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	}
+	t.Run("refresh token success", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.RefreshTokenRequest{RefreshToken: rawToken}
+		refreshToken := newRefreshToken()
+		expected := "jwt-token"
+		matchClaims := mock.MatchedBy(func(claims domains.Claims) bool {
+			return claims.UserID == user.ID.Hex() && claims.SessionID == sessionId.Hex()
+		})
+		matchParams := mock.MatchedBy(func(params *domains.CreateRefreshTokenParams) bool {
+			return params.SessionID == sessionId && params.TokenHash != refreshToken.TokenHash
+		})
+
+		tsvc.refreshTokenRepo.On("GetByTokenHash", ctx, refreshToken.TokenHash).Return(refreshToken, nil)
+		tsvc.refreshTokenRepo.On("MarkUsed", ctx, refreshToken.ID).Return(nil)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&user, nil)
+		tsvc.myJWT.On("SignClaims", matchClaims).Return(expected, nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, matchParams).Return(&domains.RefreshToken{}, nil)
+		got, err := tsvc.service.RefreshToken(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got.AccessToken)
+		assert.NotEqual(t, rawToken, got.RefreshToken)
+	})
+	t.Run("refresh token error when token not found", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.RefreshTokenRequest{RefreshToken: rawToken}
+		expected := helpers.NewCustomError(http.StatusUnauthorized, "Invalid refresh token")
+		tsvc.refreshTokenRepo.On("GetByTokenHash", ctx, helpers.HashToken(rawToken)).Return(nil, nil)
+		got, err := tsvc.service.RefreshToken(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("refresh token error when token expired", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.RefreshTokenRequest{RefreshToken: rawToken}
+		refreshToken := newRefreshToken()
+		refreshToken.ExpiresAt = time.Now().Add(-time.Minute)
+		expected := helpers.NewCustomError(http.StatusUnauthorized, "Invalid refresh token")
+		tsvc.refreshTokenRepo.On("GetByTokenHash", ctx, refreshToken.TokenHash).Return(refreshToken, nil)
+		got, err := tsvc.service.RefreshToken(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("refresh token error when session revoked", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.RefreshTokenRequest{RefreshToken: rawToken}
+		refreshToken := newRefreshToken()
+		refreshToken.RevokedAt = &now
+		expected := helpers.NewCustomError(http.StatusUnauthorized, "Invalid refresh token")
+		tsvc.refreshTokenRepo.On("GetByTokenHash", ctx, refreshToken.TokenHash).Return(refreshToken, nil)
+		got, err := tsvc.service.RefreshToken(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("refresh token revoke session when token reused", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.RefreshTokenRequest{RefreshToken: rawToken}
+		refreshToken := newRefreshToken()
+		refreshToken.UsedAt = &now
+		expected := helpers.NewCustomError(http.StatusUnauthorized, "Refresh token reuse detected")
+		tsvc.refreshTokenRepo.On("GetByTokenHash", ctx, refreshToken.TokenHash).Return(refreshToken, nil)
+		tsvc.refreshTokenRepo.On("RevokeSession", ctx, sessionId).Return(nil)
+		got, err := tsvc.service.RefreshToken(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("refresh token revoke session when token used concurrently", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.RefreshTokenRequest{RefreshToken: rawToken}
+		refreshToken := newRefreshToken()
+		expected := helpers.NewCustomError(http.StatusUnauthorized, "Refresh token reuse detected")
+		tsvc.refreshTokenRepo.On("GetByTokenHash", ctx, refreshToken.TokenHash).Return(refreshToken, nil)
+		tsvc.refreshTokenRepo.On("MarkUsed", ctx, refreshToken.ID).Return(mongo.ErrNoDocuments)
+		tsvc.refreshTokenRepo.On("RevokeSession", ctx, sessionId).Return(nil)
+		got, err := tsvc.service.RefreshToken(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("refresh token error when account is deactivated", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.RefreshTokenRequest{RefreshToken: rawToken}
+		refreshToken := newRefreshToken()
+		deactivatedUser := user
+		deactivatedUser.IsDeactivated = true
+		expected := helpers.NewCustomError(http.StatusUnauthorized, "Invalid refresh token")
+		tsvc.refreshTokenRepo.On("GetByTokenHash", ctx, refreshToken.TokenHash).Return(refreshToken, nil)
+		tsvc.refreshTokenRepo.On("MarkUsed", ctx, refreshToken.ID).Return(nil)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&deactivatedUser, nil)
+		got, err := tsvc.service.RefreshToken(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("refresh token error when query fail", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.RefreshTokenRequest{RefreshToken: rawToken}
+		expected := helpers.InternalError
+		tsvc.refreshTokenRepo.On("GetByTokenHash", ctx, helpers.HashToken(rawToken)).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.RefreshToken(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestLogout(t *testing.T) {
+	t.Run("logout success", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		sessionId := primitive.NewObjectID()
+		req := &dto.LogoutRequest{SessionID: sessionId.Hex()}
+		tsvc.refreshTokenRepo.On("RevokeSession", ctx, sessionId).Return(nil)
+		err := tsvc.service.Logout(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("logout error when invalid session id", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LogoutRequest{SessionID: "xxxxx"}
+		err := tsvc.service.Logout(ctx, req)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+	t.Run("logout error when revoke fail", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		sessionId := primitive.NewObjectID()
+		req := &dto.LogoutRequest{SessionID: sessionId.Hex()}
+		tsvc.refreshTokenRepo.On("RevokeSession", ctx, sessionId).Return(errors.New("some error"))
+		err := tsvc.service.Logout(ctx, req)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestGetJWKS(t *testing.T) {
+	t.Run("get jwks success", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		expected := []domains.JSONWebKey{
+			{Kty: "OKP", Kid: "2023-07", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "public-key"},
+		}
+		tsvc.myJWT.On("PublicKeys").Return(expected)
+		got := tsvc.service.GetJWKS()
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestForgotPassword(t *testing.T) {
+	t.Setenv("PASSWORD_RESET_TTL", "30m")
+	t.Setenv("PASSWORD_RESET_URL", "https://example.com/reset-password?token=")
+	config.New()
+	t.Run("forgot password success", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.ForgotPasswordRequest{Email: email}
+		var token string
+		tsvc.userRepo.On("GetByEmail", ctx, email).Return(&user, nil)
+		tsvc.passwordResetTokenRepo.On("Create", ctx, mock.MatchedBy(func(params *domains.CreatePasswordResetTokenParams) bool {
+			return params.UserID == user.ID && params.ExpiresAt.After(time.Now().Add(29*time.Minute))
+		})).Return(&domains.PasswordResetToken{}, nil)
+		tsvc.mailer.On("Send", ctx, mock.MatchedBy(func(mail *domains.Mail) bool {
+			return mail.To == email && strings.Contains(mail.Body, "https://example.com/reset-password?token=")
+		})).Run(func(args mock.Arguments) {
+			body := args.Get(1).(*domains.Mail).Body
+			i := strings.Index(body, "token=") + len("token=")
+			token = strings.TrimSpace(body[i:])
+		}).Return(nil)
+		err := tsvc.service.ForgotPassword(ctx, req)
+		assert.NoError(t, err)
+		params := tsvc.passwordResetTokenRepo.Calls[0].Arguments.Get(1).(*domains.CreatePasswordResetTokenParams)
+		assert.Equal(t, helpers.HashToken(token), params.TokenHash)
+	})
+	t.Run("forgot password success when email not found", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.ForgotPasswordRequest{Email: email}
+		tsvc.userRepo.On("GetByEmail", ctx, email).Return(nil, nil)
+		err := tsvc.service.ForgotPassword(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("forgot password error when send mail fail", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.ForgotPasswordRequest{Email: email}
+		tsvc.userRepo.On("GetByEmail", ctx, email).Return(&user, nil)
+		tsvc.passwordResetTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreatePasswordResetTokenParams")).Return(&domains.PasswordResetToken{}, nil)
+		tsvc.mailer.On("Send", ctx, mock.AnythingOfType("*domains.Mail")).Return(errors.New("some error"))
+		err := tsvc.service.ForgotPassword(ctx, req)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	t.Setenv("BCRYPT_COST", "8")
+	config.New()
+	token := "reset-token"
+	newPassword := "0987654321"
+	newPassHash := "new-hashed-password"
+	resetToken := domains.PasswordResetToken{
+		ID:        primitive.NewObjectID(),
+		UserID:    user.ID,
+		TokenHash: helpers.HashToken(token),
+		ExpiresAt: time.Now().Add(10 * time.Minute),
+	}
+	invalidToken := helpers.NewCustomError(http.StatusBadRequest, "Invalid or expired reset token")
+	t.Run("reset password success", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.ResetPasswordRequest{Token: token, Password: newPassword}
+		tsvc.passwordResetTokenRepo.On("GetByTokenHash", ctx, helpers.HashToken(token)).Return(&resetToken, nil)
+		tsvc.passwordResetTokenRepo.On("MarkUsed", ctx, resetToken.ID).Return(nil)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&user, nil)
+		tsvc.myBcrypt.On("GenerateFromPassword", newPassword, bcryptCost).Return(&newPassHash, nil)
+		tsvc.userRepo.On("Update", ctx, &domains.UpdateUserParams{ID: user.ID, Password: newPassHash}).Return(&user, nil)
+		tsvc.passwordResetTokenRepo.On("InvalidateUserTokens", ctx, user.ID).Return(nil)
+		tsvc.refreshTokenRepo.On("RevokeUserSessions", ctx, user.ID, primitive.NilObjectID).Return(nil)
+		err := tsvc.service.ResetPassword(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("reset password error when token not found", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.ResetPasswordRequest{Token: token, Password: newPassword}
+		tsvc.passwordResetTokenRepo.On("GetByTokenHash", ctx, helpers.HashToken(token)).Return(nil, nil)
+		err := tsvc.service.ResetPassword(ctx, req)
+		assert.Equal(t, invalidToken, err)
+	})
+	t.Run("reset password error when token expired", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.ResetPasswordRequest{Token: token, Password: newPassword}
+		expired := resetToken
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+		tsvc.passwordResetTokenRepo.On("GetByTokenHash", ctx, helpers.HashToken(token)).Return(&expired, nil)
+		err := tsvc.service.ResetPassword(ctx, req)
+		assert.Equal(t, invalidToken, err)
+	})
+	t.Run("reset password error when token already used", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.ResetPasswordRequest{Token: token, Password: newPassword}
+		tsvc.passwordResetTokenRepo.On("GetByTokenHash", ctx, helpers.HashToken(token)).Return(&resetToken, nil)
+		tsvc.passwordResetTokenRepo.On("MarkUsed", ctx, resetToken.ID).Return(mongo.ErrNoDocuments)
+		err := tsvc.service.ResetPassword(ctx, req)
+		assert.Equal(t, invalidToken, err)
+	})
+}
+
+func TestLoginWithMFA(t *testing.T) {
+	t.Setenv("JWT_SECRET", "mock-jwt-secret")
+	t.Setenv("MFA_PENDING_TTL", "5m")
+	t.Setenv("MFA_REQUIRED_FOR_ADMIN", "true")
+	config.New()
+	usernameKey := "username:" + username
+	matchPendingClaims := mock.MatchedBy(func(claims domains.Claims) bool {
+		return claims.UserID == user.ID.Hex() && claims.Purpose == constants.MFA_PENDING_PURPOSE && claims.SessionID == ""
+	})
+	t.Run("login return mfa challenge when mfa enabled", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: password,
+		}
+		mfaUser := user
+		mfaUser.MFAEnabled = true
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
+		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&mfaUser, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, usernameKey).Return(nil)
+		tsvc.myJWT.On("SignClaims", matchPendingClaims).Return("mfa-token", nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_MFA_CHALLENGED, "")).Return(nil)
+		got, err := tsvc.service.Login(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "mfa-token", got.MFAToken)
+		assert.False(t, got.MFAEnrollRequired)
+		assert.Empty(t, got.AccessToken)
+		tsvc.refreshTokenRepo.AssertNotCalled(t, "Create", ctx, mock.Anything)
+	})
+	t.Run("login require enrollment for admin when mfa is mandatory", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.LoginRequest{
+			Username: username,
+			Password: password,
+		}
+		admin := user
+		admin.Role = constants.ADMIN_ROLE
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, usernameKey).Return(nil, nil)
+		tsvc.userRepo.On("GetByUsername", ctx, username).Return(&admin, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, usernameKey).Return(nil)
+		tsvc.myJWT.On("SignClaims", matchPendingClaims).Return("mfa-token", nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchLoginAudit(constants.AUDIT_LOGIN_MFA_CHALLENGED, "")).Return(nil)
+		got, err := tsvc.service.Login(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "mfa-token", got.MFAToken)
+		assert.True(t, got.MFAEnrollRequired)
+	})
+}
+
+func TestVerifyMFA(t *testing.T) {
+	t.Setenv("JWT_SECRET", "mock-jwt-secret")
+	config.New()
+	secret, _ := helpers.GenerateTOTPSecret()
+	mfaKey := "mfa:" + user.ID.Hex()
+	withPendingClaims := func(purpose string) func(args mock.Arguments) {
+		return func(args mock.Arguments) {
+			claims := args.Get(1).(*domains.Claims)
+			claims.UserID = user.ID.Hex()
+			claims.Purpose = purpose
+		}
+	}
+	t.Run("verify mfa success with totp code", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		code, _ := helpers.TOTPCode(secret, time.Now())
+		req := &dto.MFAVerifyRequest{MFAToken: "mfa-token", Code: code}
+		mfaUser := user
+		mfaUser.MFAEnabled = true
+		mfaUser.TOTPSecret = secret
+		tsvc.myJWT.On("ParseWithClaims", "mfa-token", mock.AnythingOfType("*domains.Claims"), mock.Anything).Run(withPendingClaims(constants.MFA_PENDING_PURPOSE)).Return(&jwt.Token{}, nil)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&mfaUser, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, mfaKey).Return(nil, nil)
+		tsvc.userRepo.On("UseTOTPStep", ctx, user.ID, mock.AnythingOfType("int64")).Return(true, nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, mfaKey).Return(nil)
+		tsvc.myJWT.On("SignClaims", mock.AnythingOfType("domains.Claims")).Return("jwt-token", nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(&domains.RefreshToken{}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_SUCCEEDED, "totp", "")).Return(nil)
+		got, err := tsvc.service.VerifyMFA(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "jwt-token", got.AccessToken)
+		assert.Empty(t, got.RecoveryCodes)
+	})
+	t.Run("verify mfa success with recovery code", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.MFAVerifyRequest{MFAToken: "mfa-token", Code: "ABCDE-FGHIJ"}
+		mfaUser := user
+		mfaUser.MFAEnabled = true
+		mfaUser.TOTPSecret = secret
+		tsvc.myJWT.On("ParseWithClaims", "mfa-token", mock.AnythingOfType("*domains.Claims"), mock.Anything).Run(withPendingClaims(constants.MFA_PENDING_PURPOSE)).Return(&jwt.Token{}, nil)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&mfaUser, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, mfaKey).Return(nil, nil)
+		tsvc.userRepo.On("UseRecoveryCode", ctx, user.ID, helpers.HashToken("abcdefghij")).Return(true, nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, mfaKey).Return(nil)
+		tsvc.myJWT.On("SignClaims", mock.AnythingOfType("domains.Claims")).Return("jwt-token", nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(&domains.RefreshToken{}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_SUCCEEDED, "recovery_code", "")).Return(nil)
+		got, err := tsvc.service.VerifyMFA(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "jwt-token", got.AccessToken)
+	})
+	t.Run("verify mfa confirm enrollment and return recovery codes", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		code, _ := helpers.TOTPCode(secret, time.Now())
+		req := &dto.MFAVerifyRequest{MFAToken: "mfa-token", Code: code}
+		enrolling := user
+		enrolling.PendingTOTP = secret
+		tsvc.myJWT.On("ParseWithClaims", "mfa-token", mock.AnythingOfType("*domains.Claims"), mock.Anything).Run(withPendingClaims(constants.MFA_PENDING_PURPOSE)).Return(&jwt.Token{}, nil)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&enrolling, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, mfaKey).Return(nil, nil)
+		var hashes []string
+		tsvc.userRepo.On("EnableTOTP", ctx, user.ID, secret, mock.MatchedBy(func(h []string) bool {
+			hashes = h
+			return len(h) == 10
+		})).Return(nil)
+		tsvc.userRepo.On("UseTOTPStep", ctx, user.ID, mock.AnythingOfType("int64")).Return(true, nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, mfaKey).Return(nil)
+		tsvc.myJWT.On("SignClaims", mock.AnythingOfType("domains.Claims")).Return("jwt-token", nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(&domains.RefreshToken{}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_SUCCEEDED, "totp", "")).Return(nil)
+		got, err := tsvc.service.VerifyMFA(ctx, req)
+		assert.NoError(t, err)
+		assert.Len(t, got.RecoveryCodes, 10)
+		assert.Equal(t, helpers.HashToken(helpers.NormalizeRecoveryCode(got.RecoveryCodes[0])), hashes[0])
+	})
+	t.Run("verify mfa error when code is invalid", func(t *testing.T) {
+		if c, _ := helpers.TOTPCode(secret, time.Now()); c == "000000" {
+			t.Skip("generated code collides with the wrong code")
+		}
+		tsvc := newTestAuthService(t)
+		req := &dto.MFAVerifyRequest{MFAToken: "mfa-token", Code: "000000"}
+		mfaUser := user
+		mfaUser.MFAEnabled = true
+		mfaUser.TOTPSecret = secret
+		tsvc.myJWT.On("ParseWithClaims", "mfa-token", mock.AnythingOfType("*domains.Claims"), mock.Anything).Run(withPendingClaims(constants.MFA_PENDING_PURPOSE)).Return(&jwt.Token{}, nil)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&mfaUser, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, mfaKey).Return(nil, nil)
+		tsvc.userRepo.On("UseTOTPStep", ctx, user.ID, mock.AnythingOfType("int64")).Return(true, nil).Maybe()
+		tsvc.userRepo.On("UseRecoveryCode", ctx, user.ID, mock.AnythingOfType("string")).Return(false, nil).Maybe()
+		tsvc.loginAttemptRepo.On("RecordFailure", ctx, mfaKey, "", mock.AnythingOfType("time.Time")).Return(&domains.LoginAttempt{Failures: 1, ExpiresAt: time.Now().Add(15 * time.Minute)}, nil)
+		tsvc.loginAttemptRepo.On("Block", ctx, mfaKey, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_FAILED, "mfa", "invalid_code")).Return(nil)
+		got, err := tsvc.service.VerifyMFA(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusUnauthorized, "Invalid verification code"), err)
+	})
+	t.Run("verify mfa error when locked", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.MFAVerifyRequest{MFAToken: "mfa-token", Code: "123456"}
+		mfaUser := user
+		mfaUser.MFAEnabled = true
+		blockedUntil := time.Now().Add(10 * time.Minute)
+		tsvc.myJWT.On("ParseWithClaims", "mfa-token", mock.AnythingOfType("*domains.Claims"), mock.Anything).Run(withPendingClaims(constants.MFA_PENDING_PURPOSE)).Return(&jwt.Token{}, nil)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&mfaUser, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, mfaKey).Return(&domains.LoginAttempt{BlockedUntil: &blockedUntil}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_FAILED, "mfa", "locked")).Return(nil)
+		got, err := tsvc.service.VerifyMFA(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusTooManyRequests, "Too many failed login attempts, please try again later"), err)
+		tsvc.userRepo.AssertNotCalled(t, "UseTOTPStep", ctx, user.ID, mock.Anything)
+	})
+	t.Run("verify mfa error when token is not an mfa token", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.MFAVerifyRequest{MFAToken: "access-token", Code: "123456"}
+		tsvc.myJWT.On("ParseWithClaims", "access-token", mock.AnythingOfType("*domains.Claims"), mock.Anything).Run(withPendingClaims("")).Return(&jwt.Token{}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_FAILED, "mfa", "invalid_mfa_token")).Return(nil)
+		got, err := tsvc.service.VerifyMFA(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusUnauthorized, "Invalid mfa token"), err)
 	})
 }
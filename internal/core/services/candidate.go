@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type candidateService struct {
+	candidateRepo  ports.CandidateRepository
+	auditEventRepo ports.AuditEventRepository
+}
+
+func NewCandidateService(candidateRepo ports.CandidateRepository, auditEventRepo ports.AuditEventRepository) ports.CandidateService {
+	return &candidateService{
+		candidateRepo:  candidateRepo,
+		auditEventRepo: auditEventRepo,
+	}
+}
+
+func (s *candidateService) GetCandidates(ctx context.Context, req *dto.GetCandidatesRequest) ([]domains.Candidate, error) {
+	params := &domains.GetCandidatesParams{
+		Search:   req.Search,
+		Position: req.Position,
+		Stage:    req.Stage,
+		Offset:   (req.Page - 1) * req.Limit,
+		Limit:    req.Limit + 1,
+	}
+	data, err := s.candidateRepo.GetAll(ctx, params)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get candidates.")
+	}
+	return data, nil
+}
+
+// GetCandidate returns the candidate together with their interview history.
+func (s *candidateService) GetCandidate(ctx context.Context, id string) (*domains.Candidate, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	data, err := s.candidateRepo.GetWithInterviews(ctx, objID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Candidate not found.")
+	}
+	return data, nil
+}
+
+func (s *candidateService) CreateCandidate(ctx context.Context, req *dto.CreateCandidateRequest) (*domains.Candidate, error) {
+	userId, err := primitive.ObjectIDFromHex(req.CreatedBy)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	stage := req.Stage
+	if stage == "" {
+		stage = constants.CANDIDATE_STAGE_APPLIED
+	}
+	params := &domains.CreateCandidateParams{
+		Name:      req.Name,
+		Email:     req.Email,
+		Phone:     req.Phone,
+		Position:  req.Position,
+		Stage:     stage,
+		CreatedBy: userId,
+	}
+	data, err := s.candidateRepo.Create(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    userId,
+		Action:     constants.AUDIT_CANDIDATE_CREATE,
+		TargetType: constants.AUDIT_TARGET_CANDIDATE,
+		TargetID:   data.ID,
+		Changes:    diffCandidate(&domains.Candidate{}, data),
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return data, nil
+}
+
+func (s *candidateService) UpdateCandidate(ctx context.Context, req *dto.UpdateCandidateRequest) (*domains.Candidate, error) {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	current, err := s.candidateRepo.Get(ctx, id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if current == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Candidate not found.")
+	}
+	params := &domains.UpdateCandidateParams{
+		ID:       id,
+		Name:     req.Name,
+		Email:    req.Email,
+		Phone:    req.Phone,
+		Position: req.Position,
+		Stage:    req.Stage,
+	}
+	data, err := s.candidateRepo.Update(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Candidate not found.")
+	}
+	if changes := diffCandidate(current, data); len(changes) > 0 {
+		recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+			ActorID:    userId,
+			Action:     constants.AUDIT_CANDIDATE_UPDATE,
+			TargetType: constants.AUDIT_TARGET_CANDIDATE,
+			TargetID:   id,
+			Changes:    changes,
+			RequestID:  req.RequestID,
+			IPAddress:  req.ClientIP,
+		})
+	}
+	return data, nil
+}
+
+// diffCandidate lists the fields of a candidate that users can change.
+func diffCandidate(before *domains.Candidate, after *domains.Candidate) []domains.AuditChange {
+	diff := auditDiff{}
+	diff.add("name", before.Name, after.Name)
+	diff.add("email", before.Email, after.Email)
+	diff.add("phone", before.Phone, after.Phone)
+	diff.add("position", before.Position, after.Position)
+	diff.add("stage", before.Stage, after.Stage)
+	return diff
+}
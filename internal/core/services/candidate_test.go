@@ -0,0 +1,215 @@
+package services_test
+
+import (
+	"errors"
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/core/services"
+	"robinhood-assignment/internal/dto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testCandidateService struct {
+	candidateRepo  *mocks.CandidateRepository
+	auditEventRepo *mocks.AuditEventRepository
+	service        ports.CandidateService
+}
+
+func newTestCandidateService(t *testing.T) testCandidateService {
+	candidateRepo := mocks.NewCandidateRepository(t)
+	auditEventRepo := mocks.NewAuditEventRepository(t)
+
+	service := services.NewCandidateService(candidateRepo, auditEventRepo)
+	return testCandidateService{candidateRepo, auditEventRepo, service}
+}
+
+var mockCandidate = domains.Candidate{
+	ID:        primitive.NewObjectID(),
+	Name:      "Candidate 1",
+	Email:     "candidate1@example.com",
+	Position:  "Backend Engineer",
+	Stage:     constants.CANDIDATE_STAGE_SCREENING,
+	CreatedAt: now,
+	UpdatedAt: now,
+}
+
+func TestGetCandidates(t *testing.T) {
+	t.Run("get candidates success", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		req := &dto.GetCandidatesRequest{
+			Page:     2,
+			Limit:    10,
+			Search:   "cand",
+			Position: "Backend Engineer",
+			Stage:    constants.CANDIDATE_STAGE_SCREENING,
+		}
+		params := &domains.GetCandidatesParams{
+			Search:   "cand",
+			Position: "Backend Engineer",
+			Stage:    constants.CANDIDATE_STAGE_SCREENING,
+			Offset:   10,
+			Limit:    11,
+		}
+		expected := []domains.Candidate{mockCandidate}
+		tsvc.candidateRepo.On("GetAll", ctx, params).Return(expected, nil)
+		got, err := tsvc.service.GetCandidates(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get candidates error", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		req := &dto.GetCandidatesRequest{Page: 1, Limit: 20}
+		params := &domains.GetCandidatesParams{Offset: 0, Limit: 21}
+		expected := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get candidates.")
+		tsvc.candidateRepo.On("GetAll", ctx, params).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetCandidates(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestGetCandidate(t *testing.T) {
+	t.Run("get candidate success", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		expected := mockCandidate
+		expected.Interviews = []domains.InterviewAppointment{mockInterviewAppointment1}
+		tsvc.candidateRepo.On("GetWithInterviews", ctx, mockCandidate.ID).Return(&expected, nil)
+		got, err := tsvc.service.GetCandidate(ctx, mockCandidate.ID.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, got)
+	})
+	t.Run("get candidate error when invalid id", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		got, err := tsvc.service.GetCandidate(ctx, "xxxxx")
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+	t.Run("get candidate error when query fail", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		tsvc.candidateRepo.On("GetWithInterviews", ctx, mockCandidate.ID).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetCandidate(ctx, mockCandidate.ID.Hex())
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+	t.Run("get candidate error when not found", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		expected := helpers.NewCustomError(http.StatusNotFound, "Candidate not found.")
+		tsvc.candidateRepo.On("GetWithInterviews", ctx, mockCandidate.ID).Return(nil, nil)
+		got, err := tsvc.service.GetCandidate(ctx, mockCandidate.ID.Hex())
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestCreateCandidate(t *testing.T) {
+	t.Run("create candidate defaults stage to applied", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		req := &dto.CreateCandidateRequest{
+			Name:      "Candidate 1",
+			Email:     "candidate1@example.com",
+			Position:  "Backend Engineer",
+			CreatedBy: adminId.Hex(),
+		}
+		params := &domains.CreateCandidateParams{
+			Name:      req.Name,
+			Email:     req.Email,
+			Position:  req.Position,
+			Stage:     constants.CANDIDATE_STAGE_APPLIED,
+			CreatedBy: adminId,
+		}
+		tsvc.candidateRepo.On("Create", ctx, params).Return(&mockCandidate, nil)
+		tsvc.auditEventRepo.On("Create", ctx, &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_CANDIDATE_CREATE,
+			TargetType: constants.AUDIT_TARGET_CANDIDATE,
+			TargetID:   mockCandidate.ID,
+			Changes: []domains.AuditChange{
+				{Field: "name", After: mockCandidate.Name},
+				{Field: "email", After: mockCandidate.Email},
+				{Field: "position", After: mockCandidate.Position},
+				{Field: "stage", After: mockCandidate.Stage},
+			},
+		}).Return(nil)
+		got, err := tsvc.service.CreateCandidate(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &mockCandidate, got)
+	})
+	t.Run("create candidate error when query fail", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		req := &dto.CreateCandidateRequest{
+			Name:      "Candidate 1",
+			Email:     "candidate1@example.com",
+			Position:  "Backend Engineer",
+			Stage:     constants.CANDIDATE_STAGE_SCREENING,
+			CreatedBy: adminId.Hex(),
+		}
+		params := &domains.CreateCandidateParams{
+			Name:      req.Name,
+			Email:     req.Email,
+			Position:  req.Position,
+			Stage:     constants.CANDIDATE_STAGE_SCREENING,
+			CreatedBy: adminId,
+		}
+		tsvc.candidateRepo.On("Create", ctx, params).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.CreateCandidate(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestUpdateCandidate(t *testing.T) {
+	req := &dto.UpdateCandidateRequest{
+		ID:     mockCandidate.ID.Hex(),
+		Stage:  constants.CANDIDATE_STAGE_ONSITE,
+		UserID: adminId.Hex(),
+	}
+	params := &domains.UpdateCandidateParams{
+		ID:    mockCandidate.ID,
+		Stage: constants.CANDIDATE_STAGE_ONSITE,
+	}
+	t.Run("update candidate success", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		updated := mockCandidate
+		updated.Stage = constants.CANDIDATE_STAGE_ONSITE
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(&mockCandidate, nil)
+		tsvc.candidateRepo.On("Update", ctx, params).Return(&updated, nil)
+		tsvc.auditEventRepo.On("Create", ctx, &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_CANDIDATE_UPDATE,
+			TargetType: constants.AUDIT_TARGET_CANDIDATE,
+			TargetID:   mockCandidate.ID,
+			Changes:    []domains.AuditChange{{Field: "stage", Before: constants.CANDIDATE_STAGE_SCREENING, After: constants.CANDIDATE_STAGE_ONSITE}},
+		}).Return(nil)
+		got, err := tsvc.service.UpdateCandidate(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &updated, got)
+	})
+	t.Run("update candidate without changes is not recorded", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		unchanged := mockCandidate
+		unchanged.Stage = constants.CANDIDATE_STAGE_ONSITE
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(&unchanged, nil)
+		tsvc.candidateRepo.On("Update", ctx, params).Return(&unchanged, nil)
+		got, err := tsvc.service.UpdateCandidate(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &unchanged, got)
+		tsvc.auditEventRepo.AssertNotCalled(t, "Create", ctx, mock.Anything)
+	})
+	t.Run("update candidate error when not found", func(t *testing.T) {
+		tsvc := newTestCandidateService(t)
+		expected := helpers.NewCustomError(http.StatusNotFound, "Candidate not found.")
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(nil, nil)
+		got, err := tsvc.service.UpdateCandidate(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+		tsvc.candidateRepo.AssertNotCalled(t, "Update", ctx, mock.Anything)
+	})
+}
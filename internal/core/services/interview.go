@@ -2,37 +2,238 @@ package services
 
 import (
 	"context"
+	"log"
 	"net/http"
+	"robinhood-assignment/config"
 	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
 	"robinhood-assignment/internal/core/domains"
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/dto"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+var errInterviewAppointmentPrecondition = helpers.NewCustomError(http.StatusPreconditionFailed, "Interview appointment was changed by someone else, please reload")
+
+var errInterviewCommentPrecondition = helpers.NewCustomError(http.StatusPreconditionFailed, "Interview comment was changed by someone else, please reload")
+
+var errInvalidCursor = helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter")
+
+// detailCommentsLimit is the number of comments returned with an appointment.
+const detailCommentsLimit = 20
+
 type interviewService struct {
 	interviewAppointmentRepo ports.InterviewAppointmentRepository
 	userRepo                 ports.UserRepository
+	candidateRepo            ports.CandidateRepository
+	workflowRepo             ports.WorkflowRepository
+	scorecardTemplateRepo    ports.ScorecardTemplateRepository
+	scorecardRepo            ports.ScorecardRepository
+	interviewCommentRepo     ports.InterviewCommentRepository
+	auditEventRepo           ports.AuditEventRepository
 }
 
-func NewInterviewService(interviewAppointmentRepo ports.InterviewAppointmentRepository, userRepo ports.UserRepository) ports.InterviewService {
+func NewInterviewService(interviewAppointmentRepo ports.InterviewAppointmentRepository, userRepo ports.UserRepository, candidateRepo ports.CandidateRepository, workflowRepo ports.WorkflowRepository, scorecardTemplateRepo ports.ScorecardTemplateRepository, scorecardRepo ports.ScorecardRepository, interviewCommentRepo ports.InterviewCommentRepository, auditEventRepo ports.AuditEventRepository) ports.InterviewService {
 	return &interviewService{
 		interviewAppointmentRepo: interviewAppointmentRepo,
 		userRepo:                 userRepo,
+		candidateRepo:            candidateRepo,
+		workflowRepo:             workflowRepo,
+		scorecardTemplateRepo:    scorecardTemplateRepo,
+		scorecardRepo:            scorecardRepo,
+		interviewCommentRepo:     interviewCommentRepo,
+		auditEventRepo:           auditEventRepo,
 	}
 }
 
-func (s *interviewService) GetInterviewAppointments(ctx context.Context, offset uint32, limit uint32) ([]domains.InterviewAppointment, error) {
-	data, err := s.interviewAppointmentRepo.GetAll(ctx, offset, limit)
+// GetInterviewAppointments lists appointments matching the filters of req.
+// Without a sort they are listed newest first, or most recently archived
+// first when req.Archived is set. A search in req.Q also matches the
+// comments of an appointment. The list continues from req.Cursor when it is
+// set, and otherwise from req.Page.
+func (s *interviewService) GetInterviewAppointments(ctx context.Context, req *dto.GetInterviewAppointmentsRequest) (*domains.InterviewAppointmentPage, error) {
+	params := &domains.GetInterviewAppointmentsParams{
+		Archived:  req.Archived,
+		Status:    req.Status,
+		Tag:       req.Tag,
+		StartFrom: req.From,
+		StartTo:   req.To,
+		Search:    req.Q,
+		SortBy:    req.Sort,
+		SortDesc:  req.Order == "desc" || (req.Order == "" && req.Sort != "title"),
+		Limit:     req.Limit + 1,
+		WithTotal: req.TotalCount,
+	}
+	if params.SortBy == "" {
+		params.SortBy = "createdAt"
+		if req.Archived {
+			params.SortBy = "archivedAt"
+		}
+	}
+	if req.Cursor != "" {
+		cursor, err := toInterviewAppointmentCursor(req.Cursor, params.SortBy, params.SortDesc)
+		if err != nil {
+			return nil, err
+		}
+		params.Cursor = cursor
+	} else if req.Page > 0 {
+		params.Offset = (req.Page - 1) * req.Limit
+	}
+	if req.CreatedBy != "" {
+		createdBy, err := primitive.ObjectIDFromHex(req.CreatedBy)
+		if err != nil {
+			return nil, helpers.InternalError
+		}
+		params.CreatedBy = createdBy
+	}
+	if req.Q != "" {
+		ids, err := s.searchCommentAppointmentIDs(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		params.SearchIDs = ids
+	}
+	data, total, err := s.interviewAppointmentRepo.GetAll(ctx, params)
 	if err != nil {
 		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview appointment.")
 	}
-	return data, nil
+	page := &domains.InterviewAppointmentPage{Items: data, TotalCount: total}
+	more := uint32(len(data)) > req.Limit
+	if more {
+		page.Items = data[:req.Limit]
+	}
+	hasPrev := params.Cursor != nil || req.Page > 1
+	page.HasNext = more
+	if params.Cursor != nil && params.Cursor.Before {
+		// The repository returns the appointments before the cursor nearest
+		// first.
+		for i, j := 0, len(page.Items)-1; i < j; i, j = i+1, j-1 {
+			page.Items[i], page.Items[j] = page.Items[j], page.Items[i]
+		}
+		hasPrev, page.HasNext = more, true
+	}
+	if len(page.Items) > 0 {
+		if page.HasNext {
+			page.NextCursor = newInterviewAppointmentCursor(page.Items[len(page.Items)-1], params.SortBy, params.SortDesc, false)
+		}
+		if hasPrev {
+			page.PrevCursor = newInterviewAppointmentCursor(page.Items[0], params.SortBy, params.SortDesc, true)
+		}
+	}
+	return page, nil
+}
+
+// searchCommentAppointmentIDs returns the appointments with a comment that
+// matches req.Q. A match on an appointment whose blind feedback is hidden from
+// the caller only counts when the comment is the caller's own.
+func (s *interviewService) searchCommentAppointmentIDs(ctx context.Context, req *dto.GetInterviewAppointmentsRequest) ([]primitive.ObjectID, error) {
+	searchErr := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview appointment.")
+	ids, err := s.interviewCommentRepo.SearchAppointmentIDs(ctx, &domains.SearchInterviewCommentsParams{Query: req.Q})
+	if err != nil {
+		return nil, searchErr
+	}
+	if len(ids) == 0 || constants.HasPermission(req.Role, constants.PERMISSION_FEEDBACK_READ_ANY) {
+		return ids, nil
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	ownIds, err := s.interviewCommentRepo.SearchAppointmentIDs(ctx, &domains.SearchInterviewCommentsParams{Query: req.Q, UserID: userId})
+	if err != nil {
+		return nil, searchErr
+	}
+	own := make(map[primitive.ObjectID]bool, len(ownIds))
+	for _, id := range ownIds {
+		own[id] = true
+	}
+	others := []primitive.ObjectID{}
+	for _, id := range ids {
+		if !own[id] {
+			others = append(others, id)
+		}
+	}
+	if len(others) == 0 {
+		return ids, nil
+	}
+	blind, err := s.interviewAppointmentRepo.GetBlindByIDs(ctx, others)
+	if err != nil {
+		return nil, searchErr
+	}
+	hidden := map[primitive.ObjectID]bool{}
+	for i := range blind {
+		if err := hideBlindFeedback(ctx, s.interviewCommentRepo, &blind[i], userId, req.Role); err != nil {
+			return nil, searchErr
+		}
+		if blind[i].FeedbackHidden {
+			hidden[blind[i].ID] = true
+		}
+	}
+	res := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if !hidden[id] {
+			res = append(res, id)
+		}
+	}
+	return res, nil
 }
-func (s *interviewService) GetInterviewAppointment(ctx context.Context, id string) (*domains.InterviewAppointment, error) {
-	objID, err := primitive.ObjectIDFromHex(id)
+
+// newInterviewAppointmentCursor returns a cursor that continues the list
+// after data, or before it when before is set.
+func newInterviewAppointmentCursor(data domains.InterviewAppointment, sortBy string, desc bool, before bool) string {
+	cursor := helpers.ListCursor{Sort: sortBy, Desc: desc, ID: data.ID.Hex(), Before: before}
+	switch sortBy {
+	case "title":
+		cursor.Value = data.Title
+	case "updatedAt":
+		cursor.Value = data.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case "archivedAt":
+		cursor.Value = data.ArchivedAt.UTC().Format(time.RFC3339Nano)
+	default:
+		cursor.Value = data.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return cursor.Encode()
+}
+
+// toInterviewAppointmentCursor decodes a cursor made by
+// newInterviewAppointmentCursor. A cursor only fits the sort it was made for.
+func toInterviewAppointmentCursor(value string, sortBy string, desc bool) (*domains.InterviewAppointmentCursor, error) {
+	cursor, err := helpers.DecodeListCursor(value)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	if cursor.Sort != sortBy || cursor.Desc != desc {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "cursor does not match sort and order")
+	}
+	id, err := primitive.ObjectIDFromHex(cursor.ID)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	var sortValue interface{} = cursor.Value
+	if sortBy != "title" {
+		t, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+		sortValue = t
+	}
+	return &domains.InterviewAppointmentCursor{SortValue: sortValue, ID: id, Before: cursor.Before}, nil
+}
+
+// GetInterviewAppointment returns an appointment as seen by req.UserID with
+// its time in each status, its scorecard summary and the first page of its
+// comments. On a blind feedback appointment the
+// comments and scorecards of others are left out until the caller has
+// submitted their own feedback.
+func (s *interviewService) GetInterviewAppointment(ctx context.Context, req *dto.GetInterviewAppointmentRequest) (*domains.InterviewAppointment, error) {
+	objID, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
 	if err != nil {
 		return nil, helpers.InternalError
 	}
@@ -43,6 +244,22 @@ func (s *interviewService) GetInterviewAppointment(ctx context.Context, id strin
 	if data == nil {
 		return nil, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
 	}
+	if err := hideBlindFeedback(ctx, s.interviewCommentRepo, data, userId, req.Role); err != nil {
+		return nil, helpers.InternalError
+	}
+	data.TimeInStatus = timeInStatus(data, time.Now())
+	data.ScorecardSummary = summarizeScorecards(data)
+	params := &domains.GetInterviewCommentsParams{
+		AppointmentID: objID,
+		Limit:         detailCommentsLimit,
+	}
+	if data.FeedbackHidden {
+		params.UserID = userId
+	}
+	data.Comments, err = s.interviewCommentRepo.GetByAppointment(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
 	return data, nil
 }
 
@@ -58,97 +275,683 @@ func (s *interviewService) CreateInterviewAppointment(ctx context.Context, req *
 	if user == nil {
 		return nil, helpers.NewCustomError(http.StatusUnauthorized, "Invalid user token")
 	}
+	candidate, err := s.getOpenCandidate(ctx, req.CandidateID)
+	if err != nil {
+		return nil, err
+	}
+	var template *domains.ScorecardTemplate
+	if req.ScorecardTemplateID != "" {
+		template, err = s.getActiveScorecardTemplate(ctx, req.ScorecardTemplateID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	hiringManagerId := userId
+	if req.HiringManagerID != "" {
+		hiringManagerId, err = s.getHiringManager(ctx, req.HiringManagerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	workflow, err := s.workflowRepo.Get(ctx)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
 	params := &domains.CreateInterviewAppointmentParams{
-		Title:       req.Title,
-		Description: req.Description,
-		UserID:      userId,
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          workflow.InitialStatus,
+		Schedule:        toInterviewSchedule(req.StartAt, req.EndAt, req.DurationMinutes, req.Timezone, req.Location, req.MeetingURL),
+		Tags:            req.Tags,
+		CandidateID:     candidate.ID,
+		BlindFeedback:   req.BlindFeedback,
+		HiringManagerID: hiringManagerId,
+		UserID:          userId,
+	}
+	if template != nil {
+		params.ScorecardTemplateID = template.ID
 	}
 	data, err := s.interviewAppointmentRepo.Create(ctx, params)
 	if err != nil {
 		return nil, helpers.InternalError
 	}
-	return &domains.InterviewAppointment{
-		ID:          data.ID,
-		Title:       data.Title,
-		Description: data.Description,
-		Comments:    data.Comments,
-		Status:      data.Status,
-		IsArchived:  data.IsArchived,
+	created := &domains.InterviewAppointment{
+		ID:                  data.ID,
+		Title:               data.Title,
+		Description:         data.Description,
+		Status:              data.Status,
+		StatusHistory:       data.StatusHistory,
+		IsArchived:          data.IsArchived,
+		StartAt:             data.StartAt,
+		EndAt:               data.EndAt,
+		DurationMinutes:     data.DurationMinutes,
+		Timezone:            data.Timezone,
+		Location:            data.Location,
+		MeetingURL:          data.MeetingURL,
+		Tags:                data.Tags,
+		CandidateID:         data.CandidateID,
+		Candidate:           candidate,
+		ScorecardTemplateID: data.ScorecardTemplateID,
+		ScorecardTemplate:   template,
+		BlindFeedback:       data.BlindFeedback,
+		HiringManagerID:     data.HiringManagerID,
 		CreateUser: domains.User{
 			ID:       user.ID,
 			Name:     user.Name,
 			Email:    user.Email,
 			ImageUrl: user.ImageUrl,
 		},
+		Version:   data.Version,
 		CreatedAt: data.CreatedAt,
 		UpdatedAt: data.UpdatedAt,
-	}, nil
+	}
+	created.ScorecardSummary = summarizeScorecards(created)
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:       userId,
+		Action:        constants.AUDIT_INTERVIEW_CREATE,
+		TargetType:    constants.AUDIT_TARGET_INTERVIEW,
+		TargetID:      created.ID,
+		AppointmentID: created.ID,
+		Changes:       diffInterviewAppointment(&domains.InterviewAppointment{}, created),
+		RequestID:     req.RequestID,
+		IPAddress:     req.ClientIP,
+	})
+	return created, nil
 }
 
-func (s *interviewService) UpdateInterviewAppointment(ctx context.Context, req *dto.UpdateInterviewAppointmentRequest) error {
+// UpdateInterviewAppointment applies the changes in req and returns the new
+// version of the appointment. With req.IfMatch set, the appointment must
+// still be at that version. Only the creator and the assigned interviewers
+// can update an appointment; anyone else's needs interview:update:any.
+func (s *interviewService) UpdateInterviewAppointment(ctx context.Context, req *dto.UpdateInterviewAppointmentRequest) (int64, error) {
 	id, err := primitive.ObjectIDFromHex(req.ID)
 	if err != nil {
-		return helpers.InternalError
+		return 0, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return 0, helpers.InternalError
+	}
+	// The current appointment is also what the change is compared against
+	// for the audit log.
+	current, err := s.interviewAppointmentRepo.Get(ctx, id)
+	if err != nil {
+		return 0, helpers.InternalError
+	}
+	if current == nil {
+		return 0, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+	}
+	if !canUpdateInterviewAppointment(current, userId, req.Role) {
+		return 0, helpers.NewCustomError(http.StatusForbidden, "You don't have permission to update this interview appointment")
+	}
+	if req.IfMatch != nil && *req.IfMatch != current.Version {
+		return 0, errInterviewAppointmentPrecondition
+	}
+	// A reschedule without a timezone keeps the appointment's own.
+	timezone := req.Timezone
+	if req.StartAt != nil && timezone == "" {
+		if current.Timezone == "" {
+			return 0, helpers.NewCustomError(http.StatusBadRequest, "timezone: Missing required field")
+		}
+		timezone = current.Timezone
+	}
+	if req.StartAt != nil && req.EndAt != nil {
+		if err := s.checkInterviewerConflicts(ctx, id, current.InterviewerIDs, *req.StartAt, *req.EndAt); err != nil {
+			return 0, err
+		}
+	}
+	var statusChange *domains.InterviewStatusChange
+	if req.Status != "" && req.Status != current.Status {
+		statusChange, err = s.newStatusChange(ctx, current.Status, req.Status, userId)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if req.ScorecardTemplateID != "" && req.ScorecardTemplateID != current.ScorecardTemplateID.Hex() && len(current.Scorecards) > 0 {
+		return 0, helpers.NewCustomError(http.StatusConflict, "Scorecard template cannot be changed after scorecards were submitted")
+	}
+	var candidateId primitive.ObjectID
+	if req.CandidateID != "" {
+		candidate, err := s.getOpenCandidate(ctx, req.CandidateID)
+		if err != nil {
+			return 0, err
+		}
+		candidateId = candidate.ID
+	}
+	var templateId primitive.ObjectID
+	if req.ScorecardTemplateID != "" {
+		template, err := s.getActiveScorecardTemplate(ctx, req.ScorecardTemplateID)
+		if err != nil {
+			return 0, err
+		}
+		templateId = template.ID
+	}
+	var hiringManagerId primitive.ObjectID
+	if req.HiringManagerID != "" {
+		hiringManagerId, err = s.getHiringManager(ctx, req.HiringManagerID)
+		if err != nil {
+			return 0, err
+		}
 	}
 	params := &domains.UpdateInterviewAppointmentParams{
-		ID:          id,
-		Title:       req.Title,
-		Description: req.Description,
-		Status:      req.Status,
+		ID:                  id,
+		Title:               req.Title,
+		Description:         req.Description,
+		StatusChange:        statusChange,
+		Schedule:            toInterviewSchedule(req.StartAt, req.EndAt, req.DurationMinutes, timezone, req.Location, req.MeetingURL),
+		Tags:                req.Tags,
+		CandidateID:         candidateId,
+		ScorecardTemplateID: templateId,
+		BlindFeedback:       req.BlindFeedback,
+		HiringManagerID:     hiringManagerId,
+		Version:             req.IfMatch,
 	}
 	data, err := s.interviewAppointmentRepo.Update(ctx, params)
+	if err != nil {
+		return 0, helpers.InternalError
+	}
+	if data == nil {
+		if req.IfMatch != nil {
+			return 0, errInterviewAppointmentPrecondition
+		}
+		if statusChange != nil {
+			return 0, helpers.NewCustomError(http.StatusConflict, "Interview appointment status was changed by someone else, please reload")
+		}
+		return 0, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+	}
+	if changes := diffInterviewAppointment(current, data); len(changes) > 0 {
+		recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+			ActorID:       userId,
+			Action:        constants.AUDIT_INTERVIEW_UPDATE,
+			TargetType:    constants.AUDIT_TARGET_INTERVIEW,
+			TargetID:      id,
+			AppointmentID: id,
+			Changes:       changes,
+			RequestID:     req.RequestID,
+			IPAddress:     req.ClientIP,
+		})
+	}
+	return data.Version, nil
+}
+
+// timeInStatus adds up how long the appointment spent in each status, in the
+// order the statuses were first entered. Appointments created before status
+// history was recorded count from their creation time.
+func timeInStatus(data *domains.InterviewAppointment, now time.Time) []domains.StatusDuration {
+	durations := []domains.StatusDuration{}
+	add := func(status string, d time.Duration) {
+		if status == "" {
+			return
+		}
+		for i := range durations {
+			if durations[i].Status == status {
+				durations[i].Duration += d
+				return
+			}
+		}
+		durations = append(durations, domains.StatusDuration{Status: status, Duration: d})
+	}
+	status, since := data.Status, data.CreatedAt
+	if len(data.StatusHistory) > 0 {
+		status = data.StatusHistory[0].From
+	}
+	for _, change := range data.StatusHistory {
+		add(status, change.ChangedAt.Sub(since))
+		status, since = change.To, change.ChangedAt
+	}
+	add(status, now.Sub(since))
+	return durations
+}
+
+// canUpdateInterviewAppointment reports whether the user created the
+// appointment, is one of its interviewers, or holds interview:update:any.
+func canUpdateInterviewAppointment(appointment *domains.InterviewAppointment, userId primitive.ObjectID, role string) bool {
+	if appointment.CreateUser.ID == userId || constants.HasPermission(role, constants.PERMISSION_INTERVIEW_UPDATE_ANY) {
+		return true
+	}
+	for _, interviewerId := range appointment.InterviewerIDs {
+		if interviewerId == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// diffInterviewAppointment returns the fields of an appointment that a
+// client can change and that differ between before and after.
+func diffInterviewAppointment(before *domains.InterviewAppointment, after *domains.InterviewAppointment) []domains.AuditChange {
+	diff := auditDiff{}
+	diff.add("title", before.Title, after.Title)
+	diff.add("description", before.Description, after.Description)
+	diff.add("status", before.Status, after.Status)
+	diff.add("startAt", before.StartAt, after.StartAt)
+	diff.add("endAt", before.EndAt, after.EndAt)
+	diff.add("durationMinutes", before.DurationMinutes, after.DurationMinutes)
+	diff.add("timezone", before.Timezone, after.Timezone)
+	diff.add("location", before.Location, after.Location)
+	diff.add("meetingUrl", before.MeetingURL, after.MeetingURL)
+	diff.add("tags", before.Tags, after.Tags)
+	diff.add("candidateId", before.CandidateID, after.CandidateID)
+	diff.add("scorecardTemplateId", before.ScorecardTemplateID, after.ScorecardTemplateID)
+	diff.add("blindFeedback", before.BlindFeedback, after.BlindFeedback)
+	diff.add("hiringManagerId", before.HiringManagerID, after.HiringManagerID)
+	return diff
+}
+
+// newStatusChange checks a status move against the workflow.
+func (s *interviewService) newStatusChange(ctx context.Context, from string, to string, userId primitive.ObjectID) (*domains.InterviewStatusChange, error) {
+	workflow, err := s.workflowRepo.Get(ctx)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if findWorkflowStatus(workflow, to) == nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "status: Unknown status "+to)
+	}
+	current := findWorkflowStatus(workflow, from)
+	if current == nil || !hasTransition(current, to) {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Cannot move interview appointment from "+from+" to "+to)
+	}
+	return &domains.InterviewStatusChange{
+		From:      from,
+		To:        to,
+		UserID:    userId,
+		ChangedAt: time.Now(),
+	}, nil
+}
+
+func (s *interviewService) GetWorkflow(ctx context.Context) (*domains.Workflow, error) {
+	workflow, err := s.workflowRepo.Get(ctx)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	return workflow, nil
+}
+
+// UpdateWorkflow replaces the workflow. Statuses that appointments are still
+// in cannot be removed, or those appointments could never move again.
+func (s *interviewService) UpdateWorkflow(ctx context.Context, req *dto.UpdateWorkflowRequest) (*domains.Workflow, error) {
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	statuses := make([]domains.WorkflowStatus, len(req.Statuses))
+	for i, status := range req.Statuses {
+		statuses[i] = domains.WorkflowStatus{
+			Name:        status.Name,
+			Transitions: status.Transitions,
+		}
+	}
+	inUse, err := s.interviewAppointmentRepo.GetStatusesInUse(ctx)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	workflow := &domains.Workflow{Statuses: statuses}
+	for _, status := range inUse {
+		if findWorkflowStatus(workflow, status) == nil {
+			return nil, helpers.NewCustomError(http.StatusConflict, "statuses: "+status+" is still used by interview appointments")
+		}
+	}
+	current, err := s.workflowRepo.Get(ctx)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	params := &domains.UpdateWorkflowParams{
+		Statuses:      statuses,
+		InitialStatus: req.InitialStatus,
+		UpdatedBy:     userId,
+	}
+	data, err := s.workflowRepo.Update(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	diff := auditDiff{}
+	diff.add("statuses", current.Statuses, data.Statuses)
+	diff.add("initialStatus", current.InitialStatus, data.InitialStatus)
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    userId,
+		Action:     constants.AUDIT_WORKFLOW_UPDATE,
+		TargetType: constants.AUDIT_TARGET_WORKFLOW,
+		Changes:    diff,
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return data, nil
+}
+
+func findWorkflowStatus(workflow *domains.Workflow, name string) *domains.WorkflowStatus {
+	for i := range workflow.Statuses {
+		if workflow.Statuses[i].Name == name {
+			return &workflow.Statuses[i]
+		}
+	}
+	return nil
+}
+
+func hasTransition(status *domains.WorkflowStatus, to string) bool {
+	for _, t := range status.Transitions {
+		if t == to {
+			return true
+		}
+	}
+	return false
+}
+
+// getOpenCandidate loads the candidate an appointment is linked to. New
+// interviews cannot be booked for a candidate who was hired or rejected.
+func (s *interviewService) getOpenCandidate(ctx context.Context, id string) (*domains.Candidate, error) {
+	candidateId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	candidate, err := s.candidateRepo.Get(ctx, candidateId)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if candidate == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Candidate not found.")
+	}
+	if constants.IsClosedCandidateStage(candidate.Stage) {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Candidate is "+candidate.Stage+" and cannot be interviewed")
+	}
+	return candidate, nil
+}
+
+// getActiveScorecardTemplate loads a template for an appointment. Archived
+// templates cannot be linked to appointments any more.
+func (s *interviewService) getActiveScorecardTemplate(ctx context.Context, id string) (*domains.ScorecardTemplate, error) {
+	templateId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	template, err := s.scorecardTemplateRepo.Get(ctx, templateId)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if template == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Scorecard template not found.")
+	}
+	if template.IsArchived {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Scorecard template is archived")
+	}
+	return template, nil
+}
+
+// getHiringManager checks that id is an active user who can log in.
+func (s *interviewService) getHiringManager(ctx context.Context, id string) (primitive.ObjectID, error) {
+	objId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return primitive.NilObjectID, helpers.InternalError
+	}
+	user, err := s.userRepo.Get(ctx, objId)
+	if err != nil {
+		return primitive.NilObjectID, helpers.InternalError
+	}
+	if user == nil || user.IsDeactivated || user.IsServiceAccount {
+		return primitive.NilObjectID, helpers.NewCustomError(http.StatusBadRequest, "hiringManagerId: "+id+" is not an active user")
+	}
+	return user.ID, nil
+}
+
+// AssignInterviewers replaces the interviewer panel of an appointment. Every
+// interviewer must be an active user allowed to conduct interviews, and none
+// may be booked on another appointment overlapping this one.
+func (s *interviewService) AssignInterviewers(ctx context.Context, req *dto.AssignInterviewersRequest) error {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	interviewerIds := make([]primitive.ObjectID, len(req.InterviewerIDs))
+	for i, interviewerId := range req.InterviewerIDs {
+		interviewerIds[i], err = primitive.ObjectIDFromHex(interviewerId)
+		if err != nil {
+			return helpers.InternalError
+		}
+	}
+	data, err := s.interviewAppointmentRepo.Get(ctx, id)
 	if err != nil {
 		return helpers.InternalError
 	}
 	if data == nil {
 		return helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
 	}
+	if len(interviewerIds) > 0 {
+		users, err := s.userRepo.GetByIDs(ctx, interviewerIds)
+		if err != nil {
+			return helpers.InternalError
+		}
+		for _, interviewerId := range interviewerIds {
+			if !isInterviewer(users, interviewerId) {
+				return helpers.NewCustomError(http.StatusBadRequest, "interviewerIds: "+interviewerId.Hex()+" is not an active interviewer")
+			}
+		}
+		if !data.StartAt.IsZero() {
+			if err := s.checkInterviewerConflicts(ctx, id, interviewerIds, data.StartAt, data.EndAt); err != nil {
+				return err
+			}
+		}
+	}
+	params := &domains.UpdateInterviewersParams{
+		ID:             id,
+		InterviewerIDs: interviewerIds,
+	}
+	if err := s.interviewAppointmentRepo.UpdateInterviewers(ctx, params); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+		}
+		return helpers.InternalError
+	}
+	diff := auditDiff{}
+	diff.add("interviewerIds", data.InterviewerIDs, interviewerIds)
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:       userId,
+		Action:        constants.AUDIT_INTERVIEW_ASSIGN,
+		TargetType:    constants.AUDIT_TARGET_INTERVIEW,
+		TargetID:      id,
+		AppointmentID: id,
+		Changes:       diff,
+		RequestID:     req.RequestID,
+		IPAddress:     req.ClientIP,
+	})
 	return nil
 }
 
-func (s *interviewService) ArchiveInterviewAppointment(ctx context.Context, id string) error {
-	objId, err := primitive.ObjectIDFromHex(id)
+// GetInterviewerAppointments lists the appointments of an interviewer that
+// have not ended yet, soonest first.
+func (s *interviewService) GetInterviewerAppointments(ctx context.Context, req *dto.GetInterviewerAppointmentsRequest) ([]domains.InterviewAppointment, error) {
+	interviewerId, err := primitive.ObjectIDFromHex(req.InterviewerID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	params := &domains.GetInterviewerAppointmentsParams{
+		InterviewerID: interviewerId,
+		EndAfter:      time.Now().UTC(),
+		Offset:        (req.Page - 1) * req.Limit,
+		Limit:         req.Limit + 1,
+	}
+	data, err := s.interviewAppointmentRepo.GetByInterviewer(ctx, params)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview appointment.")
+	}
+	return data, nil
+}
+
+// ArchiveInterviewAppointment lets the creator archive their own appointment;
+// archiving anyone else's needs interview:archive:any.
+func (s *interviewService) ArchiveInterviewAppointment(ctx context.Context, req *dto.ArchiveInterviewAppointmentRequest) error {
+	objId, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	data, err := s.interviewAppointmentRepo.Get(ctx, objId)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if data == nil {
+		return helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found or archived")
+	}
+	if data.CreateUser.ID.Hex() != req.UserID && !constants.HasPermission(req.Role, constants.PERMISSION_INTERVIEW_ARCHIVE_ANY) {
+		return helpers.NewCustomError(http.StatusForbidden, "You don't have permission to archive this interview appointment")
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
 	if err != nil {
 		return helpers.InternalError
 	}
-	if err := s.interviewAppointmentRepo.ArchiveInterviewAppointment(ctx, objId); err != nil {
+	params := &domains.ArchiveInterviewAppointmentParams{
+		ID:     objId,
+		UserID: userId,
+	}
+	if err := s.interviewAppointmentRepo.ArchiveInterviewAppointment(ctx, params); err != nil {
 		if err == mongo.ErrNoDocuments {
 			return helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found or archived")
 		}
 		return helpers.InternalError
 	}
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:       userId,
+		Action:        constants.AUDIT_INTERVIEW_ARCHIVE,
+		TargetType:    constants.AUDIT_TARGET_INTERVIEW,
+		TargetID:      objId,
+		AppointmentID: objId,
+		RequestID:     req.RequestID,
+		IPAddress:     req.ClientIP,
+	})
 	return nil
 }
 
-func (s *interviewService) AddInterviewComment(ctx context.Context, req *dto.AddInterviewCommentRequest) error {
-	id, err := primitive.ObjectIDFromHex(req.ID)
+// UnarchiveInterviewAppointment restores an archived appointment. The same
+// ownership rule as archiving applies.
+func (s *interviewService) UnarchiveInterviewAppointment(ctx context.Context, req *dto.UnarchiveInterviewAppointmentRequest) error {
+	objId, err := primitive.ObjectIDFromHex(req.ID)
 	if err != nil {
 		return helpers.InternalError
 	}
-	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	data, err := s.interviewAppointmentRepo.GetArchived(ctx, objId)
 	if err != nil {
 		return helpers.InternalError
 	}
-	params := &domains.AddInterviewCommentParams{
-		ID:      id,
-		Comment: req.Comment,
-		UserID:  userId,
+	if data == nil {
+		return helpers.NewCustomError(http.StatusNotFound, "Archived interview appointment not found")
+	}
+	if data.CreateUser.ID.Hex() != req.UserID && !constants.HasPermission(req.Role, constants.PERMISSION_INTERVIEW_ARCHIVE_ANY) {
+		return helpers.NewCustomError(http.StatusForbidden, "You don't have permission to restore this interview appointment")
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return helpers.InternalError
 	}
-	if err := s.interviewAppointmentRepo.AddComment(ctx, params); err != nil {
+	if err := s.interviewAppointmentRepo.UnarchiveInterviewAppointment(ctx, objId); err != nil {
 		if err == mongo.ErrNoDocuments {
-			return helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+			return helpers.NewCustomError(http.StatusNotFound, "Archived interview appointment not found")
 		}
 		return helpers.InternalError
 	}
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:       userId,
+		Action:        constants.AUDIT_INTERVIEW_UNARCHIVE,
+		TargetType:    constants.AUDIT_TARGET_INTERVIEW,
+		TargetID:      objId,
+		AppointmentID: objId,
+		RequestID:     req.RequestID,
+		IPAddress:     req.ClientIP,
+	})
 	return nil
 }
 
-func (s *interviewService) UpdateInterviewComment(ctx context.Context, req *dto.UpdateInterviewCommentRequest) error {
+// PurgeArchivedInterviewAppointments permanently deletes appointments that
+// have been archived for longer than ARCHIVE_RETENTION, together with their
+// scorecards and comments, and returns how many were deleted. Their audit
+// events are kept without the changed values. A zero retention keeps
+// archived appointments forever.
+func (s *interviewService) PurgeArchivedInterviewAppointments(ctx context.Context) (int64, error) {
+	retention := config.Get().Interview.ArchiveRetention
+	if retention <= 0 {
+		return 0, nil
+	}
+	before := time.Now().Add(-retention)
+	ids, err := s.interviewAppointmentRepo.GetArchivedIDsBefore(ctx, before)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	// Only what was actually deleted is cascaded: an appointment restored
+	// since the lookup keeps its scorecards, comments and activity.
+	deleted, err := s.interviewAppointmentRepo.DeleteArchived(ctx, ids, before)
+	if len(deleted) > 0 {
+		if err := s.scorecardRepo.DeleteByAppointments(ctx, deleted); err != nil {
+			return int64(len(deleted)), err
+		}
+		if err := s.interviewCommentRepo.DeleteByAppointments(ctx, deleted); err != nil {
+			return int64(len(deleted)), err
+		}
+		if err := s.auditEventRepo.RedactAppointments(ctx, deleted); err != nil {
+			return int64(len(deleted)), err
+		}
+	}
+	if err != nil {
+		return int64(len(deleted)), err
+	}
+	return int64(len(deleted)), nil
+}
+
+// GetInterviewComments returns a page of the comments of an appointment,
+// oldest first. On a blind feedback appointment only the caller's own
+// comments are returned until they have submitted their feedback.
+func (s *interviewService) GetInterviewComments(ctx context.Context, req *dto.GetInterviewCommentsRequest) ([]domains.InterviewComment, error) {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	data, err := s.interviewAppointmentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+	}
+	if err := hideBlindFeedback(ctx, s.interviewCommentRepo, data, userId, req.Role); err != nil {
+		return nil, helpers.InternalError
+	}
+	params := &domains.GetInterviewCommentsParams{
+		AppointmentID: id,
+		Limit:         int64(req.Limit) + 1,
+	}
+	if data.FeedbackHidden {
+		params.UserID = userId
+	}
+	if req.Cursor != "" {
+		createdAt, commentId, err := helpers.DecodeCreatedAtCursor(req.Cursor, false)
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+		params.AfterCreatedAt = createdAt
+		params.AfterID, err = primitive.ObjectIDFromHex(commentId)
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+	}
+	comments, err := s.interviewCommentRepo.GetByAppointment(ctx, params)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview comments.")
+	}
+	return comments, nil
+}
+
+func (s *interviewService) AddInterviewComment(ctx context.Context, req *dto.AddInterviewCommentRequest) error {
 	id, err := primitive.ObjectIDFromHex(req.ID)
 	if err != nil {
 		return helpers.InternalError
 	}
-	commentId, err := primitive.ObjectIDFromHex(req.CommentID)
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
 	if err != nil {
 		return helpers.InternalError
 	}
@@ -159,28 +962,393 @@ func (s *interviewService) UpdateInterviewComment(ctx context.Context, req *dto.
 	if data == nil {
 		return helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
 	}
-	var comment *domains.InterviewComment
-	for i := 0; i < len(data.Comments); i++ {
-		if data.Comments[i].ID == commentId {
-			comment = &data.Comments[i]
-		}
+	params := &domains.AddInterviewCommentParams{
+		AppointmentID: id,
+		Comment:       req.Comment,
+		UserID:        userId,
+	}
+	comment, err := s.interviewCommentRepo.Create(ctx, params)
+	if err != nil {
+		return helpers.InternalError
+	}
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:       userId,
+		Action:        constants.AUDIT_COMMENT_CREATE,
+		TargetType:    constants.AUDIT_TARGET_COMMENT,
+		TargetID:      comment.ID,
+		AppointmentID: id,
+		Changes:       []domains.AuditChange{{Field: "comment", After: comment.Comment}},
+		RequestID:     req.RequestID,
+		IPAddress:     req.ClientIP,
+	})
+	return nil
+}
+
+// UpdateInterviewComment replaces the text of a comment and returns its new
+// version. With req.IfMatch set, the comment must still be at that version.
+func (s *interviewService) UpdateInterviewComment(ctx context.Context, req *dto.UpdateInterviewCommentRequest) (int64, error) {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return 0, helpers.InternalError
+	}
+	commentId, err := primitive.ObjectIDFromHex(req.CommentID)
+	if err != nil {
+		return 0, helpers.InternalError
+	}
+	comment, err := s.getInterviewComment(ctx, id, commentId)
+	if err != nil {
+		return 0, err
+	}
+	if !comment.DeletedAt.IsZero() {
+		return 0, helpers.NewCustomError(http.StatusConflict, "Interview comment was deleted")
+	}
+	if comment.UserID.Hex() != req.UserID && !constants.HasPermission(req.Role, constants.PERMISSION_COMMENT_EDIT_ANY) {
+		return 0, helpers.NewCustomError(http.StatusForbidden, "You don't have permission to update this comment")
 	}
-	if comment == nil {
-		return helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+	if req.IfMatch != nil && *req.IfMatch != comment.Version {
+		return 0, errInterviewCommentPrecondition
 	}
-	if comment.User.ID.Hex() != req.UserID {
-		return helpers.NewCustomError(http.StatusForbidden, "You don't have permission to update this comment")
+	if req.Comment == comment.Comment {
+		return comment.Version, nil
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return 0, helpers.InternalError
+	}
+	// The current text becomes a revision, written by the last editor or,
+	// for a comment that was never edited, its author.
+	revision := domains.InterviewCommentRevision{
+		Comment:   comment.Comment,
+		UserID:    comment.UpdatedBy,
+		CreatedAt: comment.UpdatedAt,
+	}
+	if revision.UserID.IsZero() {
+		revision.UserID = comment.UserID
 	}
 	params := domains.UpdateInterviewCommentParams{
-		ID:        id,
-		CommentID: comment.ID,
-		Comment:   req.Comment,
+		CommentID:         comment.ID,
+		Comment:           req.Comment,
+		UserID:            userId,
+		Revision:          revision,
+		PreviousUpdatedAt: comment.UpdatedAt,
+	}
+	if err := s.interviewCommentRepo.Update(ctx, &params); err != nil {
+		if err == mongo.ErrNoDocuments {
+			if req.IfMatch != nil {
+				return 0, errInterviewCommentPrecondition
+			}
+			return 0, helpers.NewCustomError(http.StatusConflict, "Interview comment was changed by someone else, please reload")
+		}
+		return 0, helpers.InternalError
+	}
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:       userId,
+		Action:        constants.AUDIT_COMMENT_UPDATE,
+		TargetType:    constants.AUDIT_TARGET_COMMENT,
+		TargetID:      comment.ID,
+		AppointmentID: id,
+		Changes:       []domains.AuditChange{{Field: "comment", Before: comment.Comment, After: req.Comment}},
+		RequestID:     req.RequestID,
+		IPAddress:     req.ClientIP,
+	})
+	return comment.Version + 1, nil
+}
+
+// GetInterviewCommentRevisions returns the earlier texts of a comment, oldest
+// first. Deleted comments and comments hidden by blind feedback have none.
+func (s *interviewService) GetInterviewCommentRevisions(ctx context.Context, req *dto.GetInterviewCommentRevisionsRequest) ([]domains.InterviewCommentRevision, error) {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	commentId, err := primitive.ObjectIDFromHex(req.CommentID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	data, err := s.interviewAppointmentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+	}
+	comment, err := s.interviewCommentRepo.Get(ctx, commentId)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if comment == nil || comment.AppointmentID != id || !comment.DeletedAt.IsZero() {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+	}
+	if comment.UserID != userId {
+		if err := hideBlindFeedback(ctx, s.interviewCommentRepo, data, userId, req.Role); err != nil {
+			return nil, helpers.InternalError
+		}
+		if data.FeedbackHidden {
+			return nil, helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+		}
+	}
+	revisions := comment.Revisions
+	if len(revisions) == 0 {
+		return []domains.InterviewCommentRevision{}, nil
+	}
+	userIds := []primitive.ObjectID{}
+	for _, revision := range revisions {
+		userIds = append(userIds, revision.UserID)
+	}
+	users, err := s.userRepo.GetByIDs(ctx, userIds)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	for i := range revisions {
+		for j := range users {
+			if users[j].ID == revisions[i].UserID {
+				revisions[i].User = &users[j]
+			}
+		}
+	}
+	return revisions, nil
+}
+
+// DeleteInterviewComment leaves a tombstone in place of a comment. Only the
+// author or a user with comment:delete:any may delete it. With req.Purge the
+// comment is removed completely, which needs comment:purge.
+func (s *interviewService) DeleteInterviewComment(ctx context.Context, req *dto.DeleteInterviewCommentRequest) error {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	commentId, err := primitive.ObjectIDFromHex(req.CommentID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if req.Purge {
+		if !constants.HasPermission(req.Role, constants.PERMISSION_COMMENT_PURGE) {
+			return helpers.NewCustomError(http.StatusForbidden, "You don't have permission to purge comments")
+		}
+		if err := s.interviewCommentRepo.Purge(ctx, id, commentId); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+			}
+			return helpers.InternalError
+		}
+		// A purged comment is gone for good, so its text is also removed from
+		// its earlier events. A soft delete keeps them, like the revisions.
+		if err := s.auditEventRepo.RedactTarget(ctx, commentId); err != nil {
+			log.Printf("failed to redact audit events of comment %s: %s\n", commentId.Hex(), err.Error())
+		}
+		s.recordCommentRemoval(ctx, constants.AUDIT_COMMENT_PURGE, id, commentId, userId, req)
+		return nil
+	}
+	comment, err := s.getInterviewComment(ctx, id, commentId)
+	if err != nil {
+		return err
+	}
+	if !comment.DeletedAt.IsZero() {
+		return helpers.NewCustomError(http.StatusConflict, "Interview comment was already deleted")
+	}
+	if comment.UserID != userId && !constants.HasPermission(req.Role, constants.PERMISSION_COMMENT_DELETE_ANY) {
+		return helpers.NewCustomError(http.StatusForbidden, "You don't have permission to delete this comment")
+	}
+	params := &domains.DeleteInterviewCommentParams{
+		CommentID: commentId,
+		UserID:    userId,
 	}
-	if err := s.interviewAppointmentRepo.UpdateComment(ctx, &params); err != nil {
+	if err := s.interviewCommentRepo.Delete(ctx, params); err != nil {
 		if err == mongo.ErrNoDocuments {
-			return helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+			return helpers.NewCustomError(http.StatusConflict, "Interview comment was already deleted")
 		}
 		return helpers.InternalError
 	}
+	s.recordCommentRemoval(ctx, constants.AUDIT_COMMENT_DELETE, id, commentId, userId, req)
 	return nil
 }
+
+// recordCommentRemoval records a deleted or purged comment.
+func (s *interviewService) recordCommentRemoval(ctx context.Context, action string, id primitive.ObjectID, commentId primitive.ObjectID, userId primitive.ObjectID, req *dto.DeleteInterviewCommentRequest) {
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:       userId,
+		Action:        action,
+		TargetType:    constants.AUDIT_TARGET_COMMENT,
+		TargetID:      commentId,
+		AppointmentID: id,
+		RequestID:     req.RequestID,
+		IPAddress:     req.ClientIP,
+	})
+}
+
+// GetInterviewActivity returns a page of the audit events of an appointment
+// and its comments, newest first. Archived appointments keep their activity.
+// On a blind feedback appointment the changes to comments and scorecards by
+// others are left out until the caller has submitted their own feedback.
+func (s *interviewService) GetInterviewActivity(ctx context.Context, req *dto.GetInterviewActivityRequest) ([]domains.AuditEvent, error) {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	data, err := s.interviewAppointmentRepo.Get(ctx, id)
+	if err == nil && data == nil {
+		data, err = s.interviewAppointmentRepo.GetArchived(ctx, id)
+	}
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+	}
+	if err := hideBlindFeedback(ctx, s.interviewCommentRepo, data, userId, req.Role); err != nil {
+		return nil, helpers.InternalError
+	}
+	params := &domains.GetAuditEventsParams{
+		AppointmentID: id,
+		Limit:         int64(req.Limit) + 1,
+	}
+	if req.Cursor != "" {
+		params.BeforeCreatedAt, params.BeforeID, err = decodeAuditCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	events, err := s.auditEventRepo.GetAll(ctx, params)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview activity.")
+	}
+	if data.FeedbackHidden {
+		for i := range events {
+			isFeedback := events[i].TargetType == constants.AUDIT_TARGET_COMMENT || events[i].TargetType == constants.AUDIT_TARGET_SCORECARD
+			if isFeedback && events[i].ActorID != userId {
+				events[i].Changes = nil
+			}
+		}
+	}
+	return events, nil
+}
+
+// getInterviewComment returns a comment of an appointment that is not
+// archived.
+func (s *interviewService) getInterviewComment(ctx context.Context, id primitive.ObjectID, commentId primitive.ObjectID) (*domains.InterviewComment, error) {
+	data, err := s.interviewAppointmentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+	}
+	comment, err := s.interviewCommentRepo.Get(ctx, commentId)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if comment == nil || comment.AppointmentID != id {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+	}
+	return comment, nil
+}
+
+func toInterviewSchedule(startAt *time.Time, endAt *time.Time, durationMinutes int, timezone string, location string, meetingURL string) domains.InterviewSchedule {
+	schedule := domains.InterviewSchedule{
+		DurationMinutes: durationMinutes,
+		Timezone:        timezone,
+		Location:        location,
+		MeetingURL:      meetingURL,
+	}
+	if startAt != nil && endAt != nil {
+		schedule.StartAt = startAt.UTC()
+		schedule.EndAt = endAt.UTC()
+	}
+	return schedule
+}
+
+// checkInterviewerConflicts returns a 409 error listing the appointments in
+// which any of interviewerIds is already booked between startAt and endAt.
+func (s *interviewService) checkInterviewerConflicts(ctx context.Context, id primitive.ObjectID, interviewerIds []primitive.ObjectID, startAt time.Time, endAt time.Time) error {
+	if len(interviewerIds) == 0 {
+		return nil
+	}
+	params := &domains.FindInterviewConflictsParams{
+		InterviewerIDs: interviewerIds,
+		StartAt:        startAt.UTC(),
+		EndAt:          endAt.UTC(),
+		ExcludeID:      id,
+	}
+	data, err := s.interviewAppointmentRepo.FindConflicts(ctx, params)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	conflicts := make([]dto.InterviewConflict, len(data))
+	for i := 0; i < len(data); i++ {
+		booked := []string{}
+		for _, bookedId := range data[i].InterviewerIDs {
+			for _, interviewerId := range interviewerIds {
+				if bookedId == interviewerId {
+					booked = append(booked, bookedId.Hex())
+				}
+			}
+		}
+		conflicts[i] = dto.InterviewConflict{
+			ID:             data[i].ID.Hex(),
+			Title:          data[i].Title,
+			StartAt:        data[i].StartAt,
+			EndAt:          data[i].EndAt,
+			InterviewerIDs: booked,
+		}
+	}
+	return helpers.NewCustomErrorWithDetails(http.StatusConflict, "Interviewer is already booked at this time", conflicts)
+}
+
+func isInterviewer(users []domains.User, id primitive.ObjectID) bool {
+	for _, user := range users {
+		if user.ID == id {
+			return !user.IsDeactivated && !user.IsServiceAccount && constants.HasPermission(user.Role, constants.PERMISSION_INTERVIEW_CONDUCT)
+		}
+	}
+	return false
+}
+
+// hideBlindFeedback removes the scorecards of other users from a blind
+// feedback appointment and sets FeedbackHidden until userId has submitted
+// their own feedback. Comments are filtered by the caller. The hiring manager
+// and users with feedback:read:any always see everything.
+func hideBlindFeedback(ctx context.Context, commentRepo ports.InterviewCommentRepository, data *domains.InterviewAppointment, userId primitive.ObjectID, role string) error {
+	if !data.BlindFeedback || data.HiringManagerID == userId || constants.HasPermission(role, constants.PERMISSION_FEEDBACK_READ_ANY) {
+		return nil
+	}
+	submitted, err := hasSubmittedFeedback(ctx, commentRepo, data, userId)
+	if err != nil {
+		return err
+	}
+	if submitted {
+		return nil
+	}
+	data.Scorecards = nil
+	data.FeedbackHidden = true
+	return nil
+}
+
+// hasSubmittedFeedback reports whether userId has a scorecard on the
+// appointment, or a comment that was not deleted when it has no scorecard
+// template.
+func hasSubmittedFeedback(ctx context.Context, commentRepo ports.InterviewCommentRepository, data *domains.InterviewAppointment, userId primitive.ObjectID) (bool, error) {
+	if !data.ScorecardTemplateID.IsZero() {
+		for _, scorecard := range data.Scorecards {
+			if scorecard.InterviewerID == userId {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return commentRepo.ExistsByAuthor(ctx, data.ID, userId)
+}
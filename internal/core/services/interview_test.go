@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"robinhood-assignment/config"
 	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
 	"robinhood-assignment/internal/core/domains"
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/core/ports/mocks"
@@ -14,6 +16,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -21,25 +24,44 @@ import (
 type testInterviewService struct {
 	interviewAppointmentRepo *mocks.InterviewAppointmentRepository
 	userRepo                 *mocks.UserRepository
+	candidateRepo            *mocks.CandidateRepository
+	workflowRepo             *mocks.WorkflowRepository
+	scorecardTemplateRepo    *mocks.ScorecardTemplateRepository
+	scorecardRepo            *mocks.ScorecardRepository
+	interviewCommentRepo     *mocks.InterviewCommentRepository
+	auditEventRepo           *mocks.AuditEventRepository
 	service                  ports.InterviewService
 }
 
 func newTestInterviewService(t *testing.T) testInterviewService {
 	interviewAppointmentRepo := mocks.NewInterviewAppointmentRepository(t)
 	userRepo := mocks.NewUserRepository(t)
+	candidateRepo := mocks.NewCandidateRepository(t)
+	workflowRepo := mocks.NewWorkflowRepository(t)
+	scorecardTemplateRepo := mocks.NewScorecardTemplateRepository(t)
+	scorecardRepo := mocks.NewScorecardRepository(t)
+	interviewCommentRepo := mocks.NewInterviewCommentRepository(t)
+	auditEventRepo := mocks.NewAuditEventRepository(t)
 
-	service := services.NewInterviewService(interviewAppointmentRepo, userRepo)
-	return testInterviewService{interviewAppointmentRepo, userRepo, service}
+	service := services.NewInterviewService(interviewAppointmentRepo, userRepo, candidateRepo, workflowRepo, scorecardTemplateRepo, scorecardRepo, interviewCommentRepo, auditEventRepo)
+	return testInterviewService{interviewAppointmentRepo, userRepo, candidateRepo, workflowRepo, scorecardTemplateRepo, scorecardRepo, interviewCommentRepo, auditEventRepo, service}
 }
 
 var (
+	mockWorkflow = domains.Workflow{
+		Statuses: []domains.WorkflowStatus{
+			{Name: "TODO", Transitions: []string{"IN_PROGRESS"}},
+			{Name: "IN_PROGRESS", Transitions: []string{"TODO", "DONE"}},
+			{Name: "DONE", Transitions: []string{}},
+		},
+		InitialStatus: "TODO",
+	}
 	ctx                       = context.Background()
 	now                       = time.Now()
 	mockInterviewAppointment1 = domains.InterviewAppointment{
 		ID:          primitive.NewObjectID(),
 		Title:       "Title 1",
 		Description: "Description 1",
-		Comments:    []domains.InterviewComment{},
 		Status:      "TODO",
 		IsArchived:  false,
 		CreateUser: domains.User{
@@ -58,7 +80,6 @@ var (
 		ID:          primitive.NewObjectID(),
 		Title:       "Title 2",
 		Description: "Description 2",
-		Comments:    []domains.InterviewComment{},
 		Status:      "TODO",
 		IsArchived:  false,
 		CreateUser: domains.User{
@@ -75,24 +96,202 @@ var (
 	}
 )
 
+// matchAudit matches the audit event recorded for action on targetId.
+func matchAudit(action string, targetId primitive.ObjectID) interface{} {
+	return mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+		return p.Action == action && p.TargetID == targetId
+	})
+}
+
 func TestGetInterviewAppointments(t *testing.T) {
 	t.Run("get interview appointments success", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		offset := uint32(0)
-		limit := uint32(3)
+		req := &dto.GetInterviewAppointmentsRequest{Page: 1, Limit: 2}
+		params := &domains.GetInterviewAppointmentsParams{SortBy: "createdAt", SortDesc: true, Offset: 0, Limit: 3}
 		expected := []domains.InterviewAppointment{mockInterviewAppointment1, mockInterviewAppointment2}
-		tsvc.interviewAppointmentRepo.On("GetAll", ctx, offset, limit).Return(expected, nil)
-		got, err := tsvc.service.GetInterviewAppointments(ctx, offset, limit)
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return(expected, nil, nil)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
 		assert.NoError(t, err)
-		assert.Equal(t, expected, got)
+		assert.Equal(t, expected, got.Items)
+	})
+	t.Run("get interview appointments in date range", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		from := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2023, 7, 8, 0, 0, 0, 0, time.UTC)
+		req := &dto.GetInterviewAppointmentsRequest{Page: 2, Limit: 10, From: &from, To: &to}
+		params := &domains.GetInterviewAppointmentsParams{StartFrom: &from, StartTo: &to, SortBy: "createdAt", SortDesc: true, Offset: 10, Limit: 11}
+		expected := []domains.InterviewAppointment{mockInterviewAppointment1}
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return(expected, nil, nil)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got.Items)
+	})
+	t.Run("get interview appointments archived sorted by archive time", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewAppointmentsRequest{Page: 1, Limit: 2, Archived: true}
+		params := &domains.GetInterviewAppointmentsParams{Archived: true, SortBy: "archivedAt", SortDesc: true, Offset: 0, Limit: 3}
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return([]domains.InterviewAppointment{}, nil, nil)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.NoError(t, err)
+		assert.Empty(t, got.Items)
+	})
+	t.Run("get interview appointments with filters and title sort", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		createdBy := primitive.NewObjectID()
+		req := &dto.GetInterviewAppointmentsRequest{Page: 1, Limit: 2, Status: "TODO", CreatedBy: createdBy.Hex(), Tag: "backend", Sort: "title"}
+		params := &domains.GetInterviewAppointmentsParams{
+			Status:    "TODO",
+			CreatedBy: createdBy,
+			Tag:       "backend",
+			SortBy:    "title",
+			SortDesc:  false,
+			Offset:    0,
+			Limit:     3,
+		}
+		expected := []domains.InterviewAppointment{mockInterviewAppointment1}
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return(expected, nil, nil)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got.Items)
+	})
+	t.Run("get interview appointments with search over comments", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewAppointmentsRequest{Page: 1, Limit: 2, Q: "golang", Sort: "updatedAt", Order: "asc", UserID: primitive.NewObjectID().Hex(), Role: constants.ADMIN_ROLE}
+		ids := []primitive.ObjectID{mockInterviewAppointment2.ID}
+		params := &domains.GetInterviewAppointmentsParams{Search: "golang", SearchIDs: ids, SortBy: "updatedAt", SortDesc: false, Offset: 0, Limit: 3}
+		expected := []domains.InterviewAppointment{mockInterviewAppointment1, mockInterviewAppointment2}
+		tsvc.interviewCommentRepo.On("SearchAppointmentIDs", ctx, &domains.SearchInterviewCommentsParams{Query: "golang"}).Return(ids, nil)
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return(expected, nil, nil)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got.Items)
+	})
+	t.Run("get interview appointments error when comment search fail", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewAppointmentsRequest{Page: 1, Limit: 2, Q: "golang", UserID: primitive.NewObjectID().Hex(), Role: constants.INTERVIEWER_ROLE}
+		expected := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview appointment.")
+		tsvc.interviewCommentRepo.On("SearchAppointmentIDs", ctx, &domains.SearchInterviewCommentsParams{Query: "golang"}).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("get interview appointments search leaves out hidden blind comments", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		userId := primitive.NewObjectID()
+		req := &dto.GetInterviewAppointmentsRequest{Page: 1, Limit: 2, Q: "golang", UserID: userId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		hidden := mockInterviewAppointment1
+		hidden.ID = primitive.NewObjectID()
+		hidden.BlindFeedback = true
+		hidden.HiringManagerID = primitive.NewObjectID()
+		hidden.ScorecardTemplateID = primitive.NewObjectID()
+		submitted := hidden
+		submitted.ID = primitive.NewObjectID()
+		submitted.Scorecards = []domains.Scorecard{{ID: primitive.NewObjectID(), InterviewerID: userId}}
+		own := primitive.NewObjectID()
+		open := primitive.NewObjectID()
+		ids := []primitive.ObjectID{hidden.ID, submitted.ID, own, open}
+		params := &domains.GetInterviewAppointmentsParams{Search: "golang", SearchIDs: []primitive.ObjectID{submitted.ID, own, open}, SortBy: "createdAt", SortDesc: true, Offset: 0, Limit: 3}
+		tsvc.interviewCommentRepo.On("SearchAppointmentIDs", ctx, &domains.SearchInterviewCommentsParams{Query: "golang"}).Return(ids, nil)
+		tsvc.interviewCommentRepo.On("SearchAppointmentIDs", ctx, &domains.SearchInterviewCommentsParams{Query: "golang", UserID: userId}).Return([]primitive.ObjectID{own}, nil)
+		tsvc.interviewAppointmentRepo.On("GetBlindByIDs", ctx, []primitive.ObjectID{hidden.ID, submitted.ID, open}).Return([]domains.InterviewAppointment{hidden, submitted}, nil)
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return([]domains.InterviewAppointment{}, nil, nil)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.NoError(t, err)
+		assert.Empty(t, got.Items)
+	})
+	t.Run("get interview appointments error when blind appointments query fail", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		userId := primitive.NewObjectID()
+		req := &dto.GetInterviewAppointmentsRequest{Page: 1, Limit: 2, Q: "golang", UserID: userId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		ids := []primitive.ObjectID{mockInterviewAppointment1.ID}
+		expected := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview appointment.")
+		tsvc.interviewCommentRepo.On("SearchAppointmentIDs", ctx, &domains.SearchInterviewCommentsParams{Query: "golang"}).Return(ids, nil)
+		tsvc.interviewCommentRepo.On("SearchAppointmentIDs", ctx, &domains.SearchInterviewCommentsParams{Query: "golang", UserID: userId}).Return([]primitive.ObjectID{}, nil)
+		tsvc.interviewAppointmentRepo.On("GetBlindByIDs", ctx, ids).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("get interview appointments first page by cursor", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewAppointmentsRequest{Limit: 1, TotalCount: true}
+		params := &domains.GetInterviewAppointmentsParams{SortBy: "createdAt", SortDesc: true, Limit: 2, WithTotal: true}
+		total := int64(2)
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return([]domains.InterviewAppointment{mockInterviewAppointment1, mockInterviewAppointment2}, &total, nil)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.NoError(t, err)
+		next := helpers.ListCursor{Sort: "createdAt", Desc: true, Value: mockInterviewAppointment1.CreatedAt.UTC().Format(time.RFC3339Nano), ID: mockInterviewAppointment1.ID.Hex()}
+		assert.Equal(t, &domains.InterviewAppointmentPage{
+			Items:      []domains.InterviewAppointment{mockInterviewAppointment1},
+			HasNext:    true,
+			NextCursor: next.Encode(),
+			TotalCount: &total,
+		}, got)
+	})
+	t.Run("get interview appointments after cursor", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		createdAt := time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC)
+		cursor := helpers.ListCursor{Sort: "createdAt", Desc: true, Value: createdAt.Format(time.RFC3339Nano), ID: mockInterviewAppointment1.ID.Hex()}
+		req := &dto.GetInterviewAppointmentsRequest{Limit: 2, Cursor: cursor.Encode()}
+		params := &domains.GetInterviewAppointmentsParams{
+			SortBy:   "createdAt",
+			SortDesc: true,
+			Cursor:   &domains.InterviewAppointmentCursor{SortValue: createdAt, ID: mockInterviewAppointment1.ID},
+			Limit:    3,
+		}
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return([]domains.InterviewAppointment{mockInterviewAppointment2}, nil, nil)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.NoError(t, err)
+		prev := helpers.ListCursor{Sort: "createdAt", Desc: true, Value: mockInterviewAppointment2.CreatedAt.UTC().Format(time.RFC3339Nano), ID: mockInterviewAppointment2.ID.Hex(), Before: true}
+		assert.Equal(t, &domains.InterviewAppointmentPage{
+			Items:      []domains.InterviewAppointment{mockInterviewAppointment2},
+			PrevCursor: prev.Encode(),
+		}, got)
+	})
+	t.Run("get interview appointments before cursor", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		cursor := helpers.ListCursor{Sort: "title", Value: "Title 3", ID: mockInterviewAppointment2.ID.Hex(), Before: true}
+		req := &dto.GetInterviewAppointmentsRequest{Limit: 2, Sort: "title", Cursor: cursor.Encode()}
+		params := &domains.GetInterviewAppointmentsParams{
+			SortBy: "title",
+			Cursor: &domains.InterviewAppointmentCursor{SortValue: "Title 3", ID: mockInterviewAppointment2.ID, Before: true},
+			Limit:  3,
+		}
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return([]domains.InterviewAppointment{mockInterviewAppointment2, mockInterviewAppointment1}, nil, nil)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.NoError(t, err)
+		next := helpers.ListCursor{Sort: "title", Value: "Title 2", ID: mockInterviewAppointment2.ID.Hex()}
+		assert.Equal(t, &domains.InterviewAppointmentPage{
+			Items:      []domains.InterviewAppointment{mockInterviewAppointment1, mockInterviewAppointment2},
+			HasNext:    true,
+			NextCursor: next.Encode(),
+		}, got)
+	})
+	t.Run("get interview appointments error when cursor does not match sort", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		cursor := helpers.ListCursor{Sort: "title", Value: "Title 3", ID: mockInterviewAppointment2.ID.Hex()}
+		req := &dto.GetInterviewAppointmentsRequest{Limit: 2, Cursor: cursor.Encode()}
+		expected := helpers.NewCustomError(http.StatusBadRequest, "cursor does not match sort and order")
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("get interview appointments error when cursor is invalid", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		cursor := helpers.ListCursor{Sort: "createdAt", Desc: true, Value: "yesterday", ID: mockInterviewAppointment2.ID.Hex()}
+		req := &dto.GetInterviewAppointmentsRequest{Limit: 2, Cursor: cursor.Encode()}
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter")
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
 	})
 	t.Run("get interview appointments error", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		offset := uint32(0)
-		limit := uint32(3)
+		req := &dto.GetInterviewAppointmentsRequest{Page: 1, Limit: 2}
+		params := &domains.GetInterviewAppointmentsParams{SortBy: "createdAt", SortDesc: true, Offset: 0, Limit: 3}
 		expected := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview appointment.")
-		tsvc.interviewAppointmentRepo.On("GetAll", ctx, offset, limit).Return(nil, expected)
-		got, err := tsvc.service.GetInterviewAppointments(ctx, offset, limit)
+		tsvc.interviewAppointmentRepo.On("GetAll", ctx, params).Return(nil, nil, expected)
+		got, err := tsvc.service.GetInterviewAppointments(ctx, req)
 		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
@@ -103,17 +302,75 @@ func TestGetInterviewAppointment(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		expected := &mockInterviewAppointment1
-		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(expected, nil)
-		got, err := tsvc.service.GetInterviewAppointment(ctx, id)
+		req := &dto.GetInterviewAppointmentRequest{ID: id, UserID: "6476f457e64589e868aac977", Role: constants.VIEWER_ROLE}
+		data := mockInterviewAppointment1
+		comments := []domains.InterviewComment{{ID: primitive.NewObjectID(), AppointmentID: objId, Comment: "comment 1", CreatedAt: now}}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&data, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, &domains.GetInterviewCommentsParams{AppointmentID: objId, Limit: 20}).Return(comments, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		expected := mockInterviewAppointment1
+		expected.Comments = comments
 		assert.NoError(t, err)
-		assert.Equal(t, expected, got)
+		assert.Len(t, got.TimeInStatus, 1)
+		expected.TimeInStatus = got.TimeInStatus
+		assert.Equal(t, &expected, got)
+	})
+	t.Run("get interview appointment with time in status", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.GetInterviewAppointmentRequest{ID: id, UserID: "6476f457e64589e868aac977", Role: constants.VIEWER_ROLE}
+		start := time.Now().Add(-4 * time.Hour)
+		data := mockInterviewAppointment1
+		data.CreatedAt = start
+		data.Status = "TODO"
+		data.StatusHistory = []domains.InterviewStatusChange{
+			{To: "TODO", ChangedAt: start},
+			{From: "TODO", To: "IN_PROGRESS", ChangedAt: start.Add(time.Hour)},
+			{From: "IN_PROGRESS", To: "TODO", ChangedAt: start.Add(3 * time.Hour)},
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&data, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, mock.Anything).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		assert.Len(t, got.TimeInStatus, 2)
+		assert.Equal(t, "TODO", got.TimeInStatus[0].Status)
+		// The current status keeps counting until the appointment is read.
+		assert.InDelta(t, float64(2*time.Hour), float64(got.TimeInStatus[0].Duration), float64(time.Minute))
+		assert.Equal(t, domains.StatusDuration{Status: "IN_PROGRESS", Duration: 2 * time.Hour}, got.TimeInStatus[1])
+	})
+	t.Run("get interview appointment counts time from creation without status history", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.GetInterviewAppointmentRequest{ID: id, UserID: "6476f457e64589e868aac977", Role: constants.VIEWER_ROLE}
+		data := mockInterviewAppointment1
+		data.CreatedAt = time.Now().Add(-time.Hour)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&data, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, mock.Anything).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		assert.Len(t, got.TimeInStatus, 1)
+		assert.Equal(t, data.Status, got.TimeInStatus[0].Status)
+		assert.InDelta(t, float64(time.Hour), float64(got.TimeInStatus[0].Duration), float64(time.Minute))
+	})
+	t.Run("get interview appointment error when comments query fail", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.GetInterviewAppointmentRequest{ID: id, UserID: "6476f457e64589e868aac977", Role: constants.VIEWER_ROLE}
+		data := mockInterviewAppointment1
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&data, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, mock.Anything).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
 	})
 	t.Run("get interview appointment error when invalid id format", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "xxxxx"
+		req := &dto.GetInterviewAppointmentRequest{ID: "xxxxx", UserID: "6476f457e64589e868aac977", Role: constants.VIEWER_ROLE}
 		expected := helpers.InternalError
-		got, err := tsvc.service.GetInterviewAppointment(ctx, id)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
 		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
@@ -121,9 +378,10 @@ func TestGetInterviewAppointment(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.GetInterviewAppointmentRequest{ID: id, UserID: "6476f457e64589e868aac977", Role: constants.VIEWER_ROLE}
 		expected := helpers.InternalError
 		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(nil, errors.New("some error"))
-		got, err := tsvc.service.GetInterviewAppointment(ctx, id)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
 		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
@@ -131,14 +389,103 @@ func TestGetInterviewAppointment(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.GetInterviewAppointmentRequest{ID: id, UserID: "6476f457e64589e868aac977", Role: constants.VIEWER_ROLE}
 		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
 		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(nil, nil)
-		got, err := tsvc.service.GetInterviewAppointment(ctx, id)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
 		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
 }
 
+func TestGetInterviewAppointmentBlindFeedback(t *testing.T) {
+	interviewerId := primitive.NewObjectID()
+	otherId := primitive.NewObjectID()
+	hiringManagerId := primitive.NewObjectID()
+	blindAppointment := func(submitted bool) *domains.InterviewAppointment {
+		data := mockInterviewAppointment1
+		data.BlindFeedback = true
+		data.HiringManagerID = hiringManagerId
+		data.InterviewerIDs = []primitive.ObjectID{interviewerId, otherId}
+		data.ScorecardTemplateID = primitive.NewObjectID()
+		data.Scorecards = []domains.Scorecard{{ID: primitive.NewObjectID(), InterviewerID: otherId}}
+		if submitted {
+			data.Scorecards = append(data.Scorecards, domains.Scorecard{ID: primitive.NewObjectID(), InterviewerID: interviewerId})
+		}
+		return &data
+	}
+	t.Run("hide other feedback before submitting", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		data := blindAppointment(false)
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: interviewerId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, data.ID).Return(data, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, &domains.GetInterviewCommentsParams{AppointmentID: data.ID, UserID: interviewerId, Limit: 20}).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		assert.True(t, got.FeedbackHidden)
+		assert.Empty(t, got.Scorecards)
+	})
+	t.Run("show all feedback after submitting", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		data := blindAppointment(true)
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: interviewerId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, data.ID).Return(data, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, &domains.GetInterviewCommentsParams{AppointmentID: data.ID, Limit: 20}).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		assert.False(t, got.FeedbackHidden)
+		assert.Len(t, got.Scorecards, 2)
+	})
+	t.Run("comment counts as feedback without scorecard template", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		data := blindAppointment(false)
+		data.ScorecardTemplateID = primitive.NilObjectID
+		data.Scorecards = nil
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: interviewerId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, data.ID).Return(data, nil)
+		tsvc.interviewCommentRepo.On("ExistsByAuthor", ctx, data.ID, interviewerId).Return(true, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, &domains.GetInterviewCommentsParams{AppointmentID: data.ID, Limit: 20}).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		assert.False(t, got.FeedbackHidden)
+	})
+	t.Run("hide other feedback without comment or scorecard template", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		data := blindAppointment(false)
+		data.ScorecardTemplateID = primitive.NilObjectID
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: interviewerId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, data.ID).Return(data, nil)
+		tsvc.interviewCommentRepo.On("ExistsByAuthor", ctx, data.ID, interviewerId).Return(false, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, &domains.GetInterviewCommentsParams{AppointmentID: data.ID, UserID: interviewerId, Limit: 20}).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		assert.True(t, got.FeedbackHidden)
+		assert.Empty(t, got.Scorecards)
+	})
+	t.Run("hiring manager sees all feedback", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		data := blindAppointment(false)
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: hiringManagerId.Hex(), Role: constants.STAFF_ROLE}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, data.ID).Return(data, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, &domains.GetInterviewCommentsParams{AppointmentID: data.ID, Limit: 20}).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		assert.False(t, got.FeedbackHidden)
+		assert.Len(t, got.Scorecards, 1)
+	})
+	t.Run("admin sees all feedback", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		data := blindAppointment(false)
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: primitive.NewObjectID().Hex(), Role: constants.ADMIN_ROLE}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, data.ID).Return(data, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, &domains.GetInterviewCommentsParams{AppointmentID: data.ID, Limit: 20}).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		assert.False(t, got.FeedbackHidden)
+		assert.Len(t, got.Scorecards, 1)
+	})
+}
+
 func TestCreateInterviewAppointment(t *testing.T) {
 	t.Run("create interview appointment success", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
@@ -146,13 +493,19 @@ func TestCreateInterviewAppointment(t *testing.T) {
 		req := &dto.CreateInterviewAppointmentRequest{
 			Title:       "Title",
 			Description: "Description",
+			Tags:        []string{"backend"},
+			CandidateID: mockCandidate.ID.Hex(),
 			CreatedBy:   userId,
 		}
 		userObjId, _ := primitive.ObjectIDFromHex(userId)
 		params := &domains.CreateInterviewAppointmentParams{
-			Title:       req.Title,
-			Description: req.Description,
-			UserID:      userObjId,
+			Title:           req.Title,
+			Description:     req.Description,
+			Status:          "TODO",
+			Tags:            req.Tags,
+			CandidateID:     mockCandidate.ID,
+			HiringManagerID: userObjId,
+			UserID:          userObjId,
 		}
 		user := &domains.User{
 			ID:       userObjId,
@@ -167,9 +520,10 @@ func TestCreateInterviewAppointment(t *testing.T) {
 			ID:           primitive.NewObjectID(),
 			Title:        params.Title,
 			Description:  params.Description,
-			Comments:     []domains.InterviewComment{},
 			Status:       "TODO",
 			IsArchived:   false,
+			Tags:         params.Tags,
+			CandidateID:  mockCandidate.ID,
 			CreateUserId: userObjId,
 			CreatedAt:    now,
 			UpdatedAt:    now,
@@ -178,9 +532,11 @@ func TestCreateInterviewAppointment(t *testing.T) {
 			ID:          created.ID,
 			Title:       created.Title,
 			Description: created.Description,
-			Comments:    created.Comments,
 			Status:      created.Status,
 			IsArchived:  created.IsArchived,
+			Tags:        created.Tags,
+			CandidateID: mockCandidate.ID,
+			Candidate:   &mockCandidate,
 			CreateUser: domains.User{
 				ID:       user.ID,
 				Name:     user.Name,
@@ -191,11 +547,55 @@ func TestCreateInterviewAppointment(t *testing.T) {
 			UpdatedAt: created.UpdatedAt,
 		}
 		tsvc.userRepo.On("Get", ctx, userObjId).Return(user, nil)
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(&mockCandidate, nil)
+		tsvc.workflowRepo.On("Get", ctx).Return(&mockWorkflow, nil)
 		tsvc.interviewAppointmentRepo.On("Create", ctx, params).Return(created, nil)
+		tsvc.auditEventRepo.On("Create", ctx, mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+			return p.Action == constants.AUDIT_INTERVIEW_CREATE && p.ActorID == userObjId && p.AppointmentID == created.ID && len(p.Changes) > 0 &&
+				assert.ObjectsAreEqual(domains.AuditChange{Field: "title", After: "Title"}, p.Changes[0])
+		})).Return(nil)
 		got, err := tsvc.service.CreateInterviewAppointment(ctx, req)
 		assert.NoError(t, err)
 		assert.Equal(t, expected, got)
 	})
+	t.Run("create interview appointment error when scorecard template is archived", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.CreateInterviewAppointmentRequest{
+			Title:               "Title",
+			Description:         "Description",
+			CandidateID:         mockCandidate.ID.Hex(),
+			ScorecardTemplateID: mockScorecardTemplate.ID.Hex(),
+			CreatedBy:           adminId.Hex(),
+		}
+		archived := mockScorecardTemplate
+		archived.IsArchived = true
+		expected := helpers.NewCustomError(http.StatusConflict, "Scorecard template is archived")
+		tsvc.userRepo.On("Get", ctx, adminId).Return(&domains.User{ID: adminId}, nil)
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(&mockCandidate, nil)
+		tsvc.scorecardTemplateRepo.On("Get", ctx, mockScorecardTemplate.ID).Return(&archived, nil)
+		got, err := tsvc.service.CreateInterviewAppointment(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("create interview appointment error when hiring manager is deactivated", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		hiringManagerId := primitive.NewObjectID()
+		req := &dto.CreateInterviewAppointmentRequest{
+			Title:           "Title",
+			Description:     "Description",
+			CandidateID:     mockCandidate.ID.Hex(),
+			BlindFeedback:   true,
+			HiringManagerID: hiringManagerId.Hex(),
+			CreatedBy:       adminId.Hex(),
+		}
+		expected := helpers.NewCustomError(http.StatusBadRequest, "hiringManagerId: "+hiringManagerId.Hex()+" is not an active user")
+		tsvc.userRepo.On("Get", ctx, adminId).Return(&domains.User{ID: adminId}, nil)
+		tsvc.userRepo.On("Get", ctx, hiringManagerId).Return(&domains.User{ID: hiringManagerId, IsDeactivated: true}, nil)
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(&mockCandidate, nil)
+		got, err := tsvc.service.CreateInterviewAppointment(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
 	t.Run("create interview appointment error when invalid user id", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		userId := "xxxxx"
@@ -245,13 +645,17 @@ func TestCreateInterviewAppointment(t *testing.T) {
 		req := &dto.CreateInterviewAppointmentRequest{
 			Title:       "Title",
 			Description: "Description",
+			CandidateID: mockCandidate.ID.Hex(),
 			CreatedBy:   userId,
 		}
 		userObjId, _ := primitive.ObjectIDFromHex(userId)
 		params := &domains.CreateInterviewAppointmentParams{
-			Title:       req.Title,
-			Description: req.Description,
-			UserID:      userObjId,
+			Title:           req.Title,
+			Description:     req.Description,
+			Status:          "TODO",
+			CandidateID:     mockCandidate.ID,
+			HiringManagerID: userObjId,
+			UserID:          userObjId,
 		}
 		user := &domains.User{
 			ID:       userObjId,
@@ -264,67 +668,53 @@ func TestCreateInterviewAppointment(t *testing.T) {
 		}
 		expected := helpers.InternalError
 		tsvc.userRepo.On("Get", ctx, userObjId).Return(user, nil)
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(&mockCandidate, nil)
+		tsvc.workflowRepo.On("Get", ctx).Return(&mockWorkflow, nil)
 		tsvc.interviewAppointmentRepo.On("Create", ctx, params).Return(nil, errors.New("some error"))
 		got, err := tsvc.service.CreateInterviewAppointment(ctx, req)
 		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
-}
-
-func TestUpdateInterviewAppointment(t *testing.T) {
-	t.Run("update interview appointment success", func(t *testing.T) {
+	t.Run("create interview appointment error when candidate not found", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "64aaf0156999249a602ff55f"
-		objId, _ := primitive.ObjectIDFromHex(id)
-		req := &dto.UpdateInterviewAppointmentRequest{
-			ID:          id,
+		userId := "6476f457e64589e868aac977"
+		req := &dto.CreateInterviewAppointmentRequest{
 			Title:       "Title",
 			Description: "Description",
-			Status:      "IN_PROGRESS",
-		}
-		params := &domains.UpdateInterviewAppointmentParams{
-			ID:          objId,
-			Title:       req.Title,
-			Description: req.Description,
-			Status:      req.Status,
-		}
-		updated := &domains.InterviewAppointment{
-			ID:          params.ID,
-			Title:       params.Title,
-			Description: params.Description,
-			Comments:    []domains.InterviewComment{},
-			Status:      params.Status,
-			IsArchived:  false,
-			CreateUser: domains.User{
-				ID:       primitive.NewObjectID(),
-				Name:     "User name 1",
-				Email:    "User email 1",
-				Username: "Username 1",
-				Password: "Password 1",
-				ImageUrl: "https://image-url.com",
-				Role:     "ADMIN",
-			},
-			CreatedAt: now,
-			UpdatedAt: now,
+			CandidateID: mockCandidate.ID.Hex(),
+			CreatedBy:   userId,
 		}
-		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(updated, nil)
-		err := tsvc.service.UpdateInterviewAppointment(ctx, req)
-		assert.NoError(t, err)
+		userObjId, _ := primitive.ObjectIDFromHex(userId)
+		expected := helpers.NewCustomError(http.StatusNotFound, "Candidate not found.")
+		tsvc.userRepo.On("Get", ctx, userObjId).Return(&domains.User{ID: userObjId}, nil)
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(nil, nil)
+		got, err := tsvc.service.CreateInterviewAppointment(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
 	})
-	t.Run("update interview appointment error when invalid id format", func(t *testing.T) {
+	t.Run("create interview appointment error when candidate is closed", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "xxxxxx"
-		req := &dto.UpdateInterviewAppointmentRequest{
-			ID:          id,
+		userId := "6476f457e64589e868aac977"
+		req := &dto.CreateInterviewAppointmentRequest{
 			Title:       "Title",
 			Description: "Description",
-			Status:      "IN_PROGRESS",
+			CandidateID: mockCandidate.ID.Hex(),
+			CreatedBy:   userId,
 		}
-		expected := helpers.InternalError
-		err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		userObjId, _ := primitive.ObjectIDFromHex(userId)
+		hired := mockCandidate
+		hired.Stage = constants.CANDIDATE_STAGE_HIRED
+		expected := helpers.NewCustomError(http.StatusConflict, "Candidate is HIRED and cannot be interviewed")
+		tsvc.userRepo.On("Get", ctx, userObjId).Return(&domains.User{ID: userObjId}, nil)
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(&hired, nil)
+		got, err := tsvc.service.CreateInterviewAppointment(ctx, req)
+		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
-	t.Run("update interview appointment error when data not found", func(t *testing.T) {
+}
+
+func TestUpdateInterviewAppointment(t *testing.T) {
+	t.Run("update interview appointment success", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
@@ -333,416 +723,1515 @@ func TestUpdateInterviewAppointment(t *testing.T) {
 			Title:       "Title",
 			Description: "Description",
 			Status:      "IN_PROGRESS",
+			UserID:      adminId.Hex(),
+			Role:        constants.ADMIN_ROLE,
 		}
-		params := &domains.UpdateInterviewAppointmentParams{
-			ID:          objId,
-			Title:       req.Title,
-			Description: req.Description,
-			Status:      req.Status,
-		}
-		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
-		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(nil, nil)
-		err := tsvc.service.UpdateInterviewAppointment(ctx, req)
-		assert.Equal(t, expected, err)
+		var params *domains.UpdateInterviewAppointmentParams
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.workflowRepo.On("Get", ctx).Return(&mockWorkflow, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, mock.MatchedBy(func(p *domains.UpdateInterviewAppointmentParams) bool {
+			params = p
+			return true
+		})).Return(&mockInterviewAppointment1, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, objId, params.ID)
+		assert.Equal(t, req.Title, params.Title)
+		assert.Equal(t, req.Description, params.Description)
+		assert.Equal(t, "TODO", params.StatusChange.From)
+		assert.Equal(t, "IN_PROGRESS", params.StatusChange.To)
+		assert.Equal(t, adminId, params.StatusChange.UserID)
+		assert.WithinDuration(t, time.Now(), params.StatusChange.ChangedAt, time.Minute)
 	})
-	t.Run("update interview appointment error when update query fail", func(t *testing.T) {
+	t.Run("update interview appointment keeps status when unchanged", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
 		req := &dto.UpdateInterviewAppointmentRequest{
-			ID:          id,
-			Title:       "Title",
-			Description: "Description",
-			Status:      "IN_PROGRESS",
+			ID:     id,
+			Title:  "Title",
+			Status: "TODO",
+			UserID: adminId.Hex(),
+			Role:   constants.ADMIN_ROLE,
 		}
 		params := &domains.UpdateInterviewAppointmentParams{
-			ID:          objId,
-			Title:       req.Title,
-			Description: req.Description,
-			Status:      req.Status,
+			ID:    objId,
+			Title: req.Title,
 		}
-		expected := helpers.InternalError
-		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(nil, errors.New("some error"))
-		err := tsvc.service.UpdateInterviewAppointment(ctx, req)
-		assert.Equal(t, expected, err)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(&mockInterviewAppointment1, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
 	})
-}
-
-func TestArchiveInterviewAppointment(t *testing.T) {
-	t.Run("archive interview appointment success", func(t *testing.T) {
+	t.Run("update interview appointment with If-Match", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		tsvc.interviewAppointmentRepo.On("ArchiveInterviewAppointment", ctx, objId).Return(nil)
-		err := tsvc.service.ArchiveInterviewAppointment(ctx, id)
+		version := int64(2)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:      id,
+			Title:   "Title",
+			UserID:  adminId.Hex(),
+			Role:    constants.ADMIN_ROLE,
+			IfMatch: &version,
+		}
+		current := mockInterviewAppointment1
+		current.Version = 2
+		updated := current
+		updated.Version = 3
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID:      objId,
+			Title:   req.Title,
+			Version: &version,
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&current, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(&updated, nil)
+		got, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.NoError(t, err)
+		assert.Equal(t, int64(3), got)
 	})
-	t.Run("archive interview appointment error when invalid id format", func(t *testing.T) {
-		tsvc := newTestInterviewService(t)
-		id := "xxxxxxxxx"
-		expected := helpers.InternalError
-		err := tsvc.service.ArchiveInterviewAppointment(ctx, id)
-		assert.Equal(t, expected, err)
-	})
-	t.Run("archive interview appointment error when data not found", func(t *testing.T) {
+	t.Run("update interview appointment error when If-Match is stale", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found or archived")
-		tsvc.interviewAppointmentRepo.On("ArchiveInterviewAppointment", ctx, objId).Return(mongo.ErrNoDocuments)
-		err := tsvc.service.ArchiveInterviewAppointment(ctx, id)
+		version := int64(1)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:      id,
+			Title:   "Title",
+			UserID:  adminId.Hex(),
+			Role:    constants.ADMIN_ROLE,
+			IfMatch: &version,
+		}
+		current := mockInterviewAppointment1
+		current.Version = 2
+		expected := helpers.NewCustomError(http.StatusPreconditionFailed, "Interview appointment was changed by someone else, please reload")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&current, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
+		tsvc.interviewAppointmentRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
 	})
-	t.Run("archive interview appointment error when query fail", func(t *testing.T) {
+	t.Run("update interview appointment error when changed after If-Match was checked", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		expected := helpers.InternalError
-		tsvc.interviewAppointmentRepo.On("ArchiveInterviewAppointment", ctx, objId).Return(errors.New("some error"))
-		err := tsvc.service.ArchiveInterviewAppointment(ctx, id)
+		version := int64(0)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:      id,
+			Title:   "Title",
+			UserID:  adminId.Hex(),
+			Role:    constants.ADMIN_ROLE,
+			IfMatch: &version,
+		}
+		expected := helpers.NewCustomError(http.StatusPreconditionFailed, "Interview appointment was changed by someone else, please reload")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, mock.Anything).Return(nil, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
-}
-
-func TestAddInterviewComment(t *testing.T) {
-	t.Run("add interview comment success", func(t *testing.T) {
+	t.Run("update interview appointment sets scorecard template", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		userId := "6476f457e64589e868aac97d"
-		userObjId, _ := primitive.ObjectIDFromHex(userId)
-		req := &dto.AddInterviewCommentRequest{
-			ID:      id,
-			Comment: "comment",
-			UserID:  userId,
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:                  id,
+			ScorecardTemplateID: mockScorecardTemplate.ID.Hex(),
+			UserID:              adminId.Hex(),
+			Role:                constants.ADMIN_ROLE,
 		}
-		params := &domains.AddInterviewCommentParams{
-			ID:      objId,
-			Comment: req.Comment,
-			UserID:  userObjId,
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID:                  objId,
+			ScorecardTemplateID: mockScorecardTemplate.ID,
 		}
-		tsvc.interviewAppointmentRepo.On("AddComment", ctx, params).Return(nil)
-		err := tsvc.service.AddInterviewComment(ctx, req)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.scorecardTemplateRepo.On("Get", ctx, mockScorecardTemplate.ID).Return(&mockScorecardTemplate, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(&mockInterviewAppointment1, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.NoError(t, err)
 	})
-	t.Run("add interview comment error when invalid interview appointment id format", func(t *testing.T) {
+	t.Run("update interview appointment error when changing template after scorecards", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "xxxxxxxx"
-		userId := "6476f457e64589e868aac97d"
-		req := &dto.AddInterviewCommentRequest{
-			ID:      id,
-			Comment: "comment",
-			UserID:  userId,
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:                  id,
+			ScorecardTemplateID: mockScorecardTemplate.ID.Hex(),
+			UserID:              adminId.Hex(),
+			Role:                constants.ADMIN_ROLE,
 		}
-		expected := helpers.InternalError
-		err := tsvc.service.AddInterviewComment(ctx, req)
+		current := mockInterviewAppointment1
+		current.ScorecardTemplateID = primitive.NewObjectID()
+		current.Scorecards = []domains.Scorecard{{ID: primitive.NewObjectID()}}
+		expected := helpers.NewCustomError(http.StatusConflict, "Scorecard template cannot be changed after scorecards were submitted")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&current, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
-	t.Run("add interview comment error when invalid user id format", func(t *testing.T) {
+	t.Run("update interview appointment error when transition is not allowed", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
-		userId := "xxxxxxx"
-		req := &dto.AddInterviewCommentRequest{
-			ID:      id,
-			Comment: "comment",
-			UserID:  userId,
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:     id,
+			Status: "TODO",
+			UserID: adminId.Hex(),
+			Role:   constants.ADMIN_ROLE,
 		}
-		expected := helpers.InternalError
-		err := tsvc.service.AddInterviewComment(ctx, req)
+		done := mockInterviewAppointment1
+		done.Status = "DONE"
+		expected := helpers.NewCustomError(http.StatusConflict, "Cannot move interview appointment from DONE to TODO")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&done, nil)
+		tsvc.workflowRepo.On("Get", ctx).Return(&mockWorkflow, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
-	t.Run("add interview comment error when interview appointment not found", func(t *testing.T) {
+	t.Run("update interview appointment error when status is unknown", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		userId := "6476f457e64589e868aac97d"
-		userObjId, _ := primitive.ObjectIDFromHex(userId)
-		req := &dto.AddInterviewCommentRequest{
-			ID:      id,
-			Comment: "comment",
-			UserID:  userId,
-		}
-		params := &domains.AddInterviewCommentParams{
-			ID:      objId,
-			Comment: req.Comment,
-			UserID:  userObjId,
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:     id,
+			Status: "ON_HOLD",
+			UserID: adminId.Hex(),
+			Role:   constants.ADMIN_ROLE,
 		}
-		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
-		tsvc.interviewAppointmentRepo.On("AddComment", ctx, params).Return(mongo.ErrNoDocuments)
-		err := tsvc.service.AddInterviewComment(ctx, req)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "status: Unknown status ON_HOLD")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.workflowRepo.On("Get", ctx).Return(&mockWorkflow, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
-	t.Run("add interview comment error when query fail", func(t *testing.T) {
+	t.Run("update interview appointment error when status changed concurrently", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
 		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		userId := "6476f457e64589e868aac97d"
-		userObjId, _ := primitive.ObjectIDFromHex(userId)
-		req := &dto.AddInterviewCommentRequest{
-			ID:      id,
-			Comment: "comment",
-			UserID:  userId,
-		}
-		params := &domains.AddInterviewCommentParams{
-			ID:      objId,
-			Comment: req.Comment,
-			UserID:  userObjId,
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:     id,
+			Status: "IN_PROGRESS",
+			UserID: adminId.Hex(),
+			Role:   constants.ADMIN_ROLE,
 		}
-		expected := helpers.InternalError
-		tsvc.interviewAppointmentRepo.On("AddComment", ctx, params).Return(errors.New("some error"))
-		err := tsvc.service.AddInterviewComment(ctx, req)
+		expected := helpers.NewCustomError(http.StatusConflict, "Interview appointment status was changed by someone else, please reload")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.workflowRepo.On("Get", ctx).Return(&mockWorkflow, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, mock.Anything).Return(nil, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
-}
-
-func TestUpdateInterviewComment(t *testing.T) {
-	t.Run("update interview comment success", func(t *testing.T) {
+	t.Run("update interview appointment candidate", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "6476f457e64589e868aac993"
-		commentId := "6476f457e64589e868aac996"
-		userId := "6476f457e64589e868aac997"
+		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		commentObjId, _ := primitive.ObjectIDFromHex(commentId)
-		userObjId, _ := primitive.ObjectIDFromHex(userId)
-		interviewAppointment := mockInterviewAppointment1
-		interviewAppointment.Comments = append(interviewAppointment.Comments, []domains.InterviewComment{
-			{
-				ID:      commentObjId,
-				Comment: "comment",
-				User: domains.User{
-					ID:       userObjId,
-					Name:     "User name 1",
-					Email:    "User email 1",
-					Username: "Username 1",
-					Password: "Password 1",
-					ImageUrl: "https://image-url.com",
-					Role:     "ADMIN",
-				},
-				CreatedAt: now,
-				UpdatedAt: now,
-			},
-			{
-				ID:      primitive.NewObjectID(),
-				Comment: "comment 2",
-				User: domains.User{
-					ID:       primitive.NewObjectID(),
-					Name:     "User name 2",
-					Email:    "User email 2",
-					Username: "Username 2",
-					Password: "Password 2",
-					ImageUrl: "https://image-url.com",
-					Role:     "STAFF",
-				},
-				CreatedAt: now,
-				UpdatedAt: now,
-			},
-		}...)
-		req := &dto.UpdateInterviewCommentRequest{
-			ID:        id,
-			CommentID: commentId,
-			Comment:   "Update comment",
-			UserID:    userId,
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:          id,
+			CandidateID: mockCandidate.ID.Hex(),
+			UserID:      adminId.Hex(),
+			Role:        constants.ADMIN_ROLE,
 		}
-		params := &domains.UpdateInterviewCommentParams{
-			ID:        objId,
-			CommentID: commentObjId,
-			Comment:   req.Comment,
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID:          objId,
+			CandidateID: mockCandidate.ID,
 		}
-		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&interviewAppointment, nil)
-		tsvc.interviewAppointmentRepo.On("UpdateComment", ctx, params).Return(nil)
-		err := tsvc.service.UpdateInterviewComment(ctx, req)
+		updated := mockInterviewAppointment1
+		updated.CandidateID = mockCandidate.ID
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(&mockCandidate, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(&updated, nil)
+		tsvc.auditEventRepo.On("Create", ctx, mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+			return p.Action == constants.AUDIT_INTERVIEW_UPDATE && p.ActorID == adminId &&
+				assert.ObjectsAreEqual([]domains.AuditChange{{Field: "candidateId", After: mockCandidate.ID}}, p.Changes)
+		})).Return(nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.NoError(t, err)
 	})
-	t.Run("update interview comment error when invalid interview appointment id format", func(t *testing.T) {
+	t.Run("update interview appointment error when candidate is closed", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "xxxxxxx"
-		commentId := "6476f457e64589e868aac996"
-		userId := "6476f457e64589e868aac997"
-		req := &dto.UpdateInterviewCommentRequest{
-			ID:        id,
-			CommentID: commentId,
-			Comment:   "Update comment",
-			UserID:    userId,
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:          "64aaf0156999249a602ff55f",
+			CandidateID: mockCandidate.ID.Hex(),
+			UserID:      adminId.Hex(),
+			Role:        constants.ADMIN_ROLE,
 		}
-		expected := helpers.InternalError
-		err := tsvc.service.UpdateInterviewComment(ctx, req)
+		rejected := mockCandidate
+		rejected.Stage = constants.CANDIDATE_STAGE_REJECTED
+		expected := helpers.NewCustomError(http.StatusConflict, "Candidate is REJECTED and cannot be interviewed")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mock.Anything).Return(&mockInterviewAppointment1, nil)
+		tsvc.candidateRepo.On("Get", ctx, mockCandidate.ID).Return(&rejected, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
-	t.Run("update interview comment error when invalid interview comment id format", func(t *testing.T) {
+	t.Run("update interview appointment schedule", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "6476f457e64589e868aac993"
-		commentId := "xxxxxxx"
-		userId := "6476f457e64589e868aac997"
-		req := &dto.UpdateInterviewCommentRequest{
-			ID:        id,
-			CommentID: commentId,
-			Comment:   "Update comment",
-			UserID:    userId,
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		bangkok, _ := time.LoadLocation("Asia/Bangkok")
+		startAt := time.Date(2023, 7, 10, 9, 0, 0, 0, bangkok)
+		endAt := startAt.Add(45 * time.Minute)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:              id,
+			StartAt:         &startAt,
+			EndAt:           &endAt,
+			DurationMinutes: 45,
+			Timezone:        "Asia/Bangkok",
+			Location:        "Meeting room 2",
+			UserID:          adminId.Hex(),
+			Role:            constants.ADMIN_ROLE,
 		}
-		expected := helpers.InternalError
-		err := tsvc.service.UpdateInterviewComment(ctx, req)
-		assert.Equal(t, expected, err)
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID: objId,
+			Schedule: domains.InterviewSchedule{
+				StartAt:         time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC),
+				EndAt:           time.Date(2023, 7, 10, 2, 45, 0, 0, time.UTC),
+				DurationMinutes: 45,
+				Timezone:        "Asia/Bangkok",
+				Location:        "Meeting room 2",
+			},
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(&mockInterviewAppointment1, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
 	})
-	t.Run("update interview comment error when get interview appointment fail", func(t *testing.T) {
+	t.Run("update interview appointment schedule keeps the stored timezone", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "6476f457e64589e868aac993"
-		commentId := "6476f457e64589e868aac996"
-		userId := "6476f457e64589e868aac997"
+		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		req := &dto.UpdateInterviewCommentRequest{
-			ID:        id,
-			CommentID: commentId,
-			Comment:   "Update comment",
-			UserID:    userId,
+		startAt := time.Date(2023, 7, 11, 2, 0, 0, 0, time.UTC)
+		endAt := startAt.Add(30 * time.Minute)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:              id,
+			StartAt:         &startAt,
+			EndAt:           &endAt,
+			DurationMinutes: 30,
+			UserID:          adminId.Hex(),
+			Role:            constants.ADMIN_ROLE,
+		}
+		current := mockInterviewAppointment1
+		current.Timezone = "Asia/Bangkok"
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID: objId,
+			Schedule: domains.InterviewSchedule{
+				StartAt:         startAt,
+				EndAt:           endAt,
+				DurationMinutes: 30,
+				Timezone:        "Asia/Bangkok",
+			},
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&current, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(&current, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("update interview appointment error when rescheduling without any timezone", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		startAt := time.Date(2023, 7, 11, 2, 0, 0, 0, time.UTC)
+		endAt := startAt.Add(30 * time.Minute)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:              id,
+			StartAt:         &startAt,
+			EndAt:           &endAt,
+			DurationMinutes: 30,
+			UserID:          adminId.Hex(),
+			Role:            constants.ADMIN_ROLE,
+		}
+		current := mockInterviewAppointment1
+		current.Timezone = ""
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&current, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "timezone: Missing required field"), err)
+	})
+	t.Run("update interview appointment error when rescheduling double-books an interviewer", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		interviewerId := primitive.NewObjectID()
+		startAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		endAt := startAt.Add(time.Hour)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:              id,
+			StartAt:         &startAt,
+			EndAt:           &endAt,
+			DurationMinutes: 60,
+			Timezone:        "UTC",
+			UserID:          adminId.Hex(),
+			Role:            constants.ADMIN_ROLE,
+		}
+		current := mockInterviewAppointment1
+		current.InterviewerIDs = []primitive.ObjectID{interviewerId}
+		other := mockInterviewAppointment2
+		other.StartAt = startAt.Add(30 * time.Minute)
+		other.EndAt = startAt.Add(90 * time.Minute)
+		other.InterviewerIDs = []primitive.ObjectID{primitive.NewObjectID(), interviewerId}
+		conflictParams := &domains.FindInterviewConflictsParams{
+			InterviewerIDs: []primitive.ObjectID{interviewerId},
+			StartAt:        startAt,
+			EndAt:          endAt,
+			ExcludeID:      objId,
+		}
+		expected := helpers.NewCustomErrorWithDetails(http.StatusConflict, "Interviewer is already booked at this time", []dto.InterviewConflict{
+			{
+				ID:             other.ID.Hex(),
+				Title:          other.Title,
+				StartAt:        other.StartAt,
+				EndAt:          other.EndAt,
+				InterviewerIDs: []string{interviewerId.Hex()},
+			},
+		})
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&current, nil)
+		tsvc.interviewAppointmentRepo.On("FindConflicts", ctx, conflictParams).Return([]domains.InterviewAppointment{other}, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update interview appointment error when invalid id format", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "xxxxxx"
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:          id,
+			Title:       "Title",
+			Description: "Description",
+			UserID:      adminId.Hex(),
+			Role:        constants.ADMIN_ROLE,
 		}
 		expected := helpers.InternalError
-		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(nil, errors.New("some error"))
-		err := tsvc.service.UpdateInterviewComment(ctx, req)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
-	t.Run("update interview comment error when interview appointment not found", func(t *testing.T) {
+	t.Run("update interview appointment error when data not found", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "6476f457e64589e868aac993"
-		commentId := "6476f457e64589e868aac996"
-		userId := "6476f457e64589e868aac997"
+		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		req := &dto.UpdateInterviewCommentRequest{
-			ID:        id,
-			CommentID: commentId,
-			Comment:   "Update comment",
-			UserID:    userId,
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:          id,
+			Title:       "Title",
+			Description: "Description",
+			UserID:      adminId.Hex(),
+			Role:        constants.ADMIN_ROLE,
 		}
 		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
 		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(nil, nil)
-		err := tsvc.service.UpdateInterviewComment(ctx, req)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
-	t.Run("update interview comment error when interview comment not found", func(t *testing.T) {
+	t.Run("update interview appointment by assigned interviewer", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "6476f457e64589e868aac993"
-		commentId := "6476f457e64589e868aac996"
-		userId := "6476f457e64589e868aac997"
+		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		interviewAppointment := mockInterviewAppointment1
-		interviewAppointment.Comments = []domains.InterviewComment{}
-		req := &dto.UpdateInterviewCommentRequest{
-			ID:        id,
-			CommentID: commentId,
-			Comment:   "Update comment",
-			UserID:    userId,
+		interviewerId := primitive.NewObjectID()
+		current := mockInterviewAppointment1
+		current.InterviewerIDs = []primitive.ObjectID{interviewerId}
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:     id,
+			Title:  "Title",
+			UserID: interviewerId.Hex(),
+			Role:   constants.INTERVIEWER_ROLE,
 		}
-		expected := helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
-		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&interviewAppointment, nil)
-		err := tsvc.service.UpdateInterviewComment(ctx, req)
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID:    objId,
+			Title: req.Title,
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&current, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(&current, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("update interview appointment by creator", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:     id,
+			Title:  "Title",
+			UserID: mockInterviewAppointment1.CreateUser.ID.Hex(),
+			Role:   constants.STAFF_ROLE,
+		}
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID:    objId,
+			Title: req.Title,
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(&mockInterviewAppointment1, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("update interview appointment error when not creator or interviewer", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		current := mockInterviewAppointment1
+		current.InterviewerIDs = []primitive.ObjectID{primitive.NewObjectID()}
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:     id,
+			Status: "IN_PROGRESS",
+			UserID: primitive.NewObjectID().Hex(),
+			Role:   constants.INTERVIEWER_ROLE,
+		}
+		expected := helpers.NewCustomError(http.StatusForbidden, "You don't have permission to update this interview appointment")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&current, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
-	t.Run("update interview comment error when user comment not match", func(t *testing.T) {
+	t.Run("update interview appointment error when staff is not creator", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "6476f457e64589e868aac993"
-		commentId := "6476f457e64589e868aac996"
-		userId := "6476f457e64589e868aac997"
+		id := "64aaf0156999249a602ff55f"
 		objId, _ := primitive.ObjectIDFromHex(id)
-		commentObjId, _ := primitive.ObjectIDFromHex(commentId)
-		interviewAppointment := mockInterviewAppointment1
-		interviewAppointment.Comments = append(interviewAppointment.Comments, []domains.InterviewComment{
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:     id,
+			Title:  "Title",
+			UserID: primitive.NewObjectID().Hex(),
+			Role:   constants.STAFF_ROLE,
+		}
+		expected := helpers.NewCustomError(http.StatusForbidden, "You don't have permission to update this interview appointment")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update interview appointment error when update query fail", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.UpdateInterviewAppointmentRequest{
+			ID:          id,
+			Title:       "Title",
+			Description: "Description",
+			UserID:      adminId.Hex(),
+			Role:        constants.ADMIN_ROLE,
+		}
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID:          objId,
+			Title:       req.Title,
+			Description: req.Description,
+		}
+		expected := helpers.InternalError
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("Update", ctx, params).Return(nil, errors.New("some error"))
+		_, err := tsvc.service.UpdateInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestAssignInterviewers(t *testing.T) {
+	id := "64aaf0156999249a602ff55f"
+	objId, _ := primitive.ObjectIDFromHex(id)
+	interviewer := domains.User{
+		ID:       primitive.NewObjectID(),
+		Name:     "Interviewer",
+		Username: "interviewer",
+		Role:     constants.INTERVIEWER_ROLE,
+	}
+	scheduled := mockInterviewAppointment1
+	scheduled.StartAt = time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+	scheduled.EndAt = scheduled.StartAt.Add(time.Hour)
+	t.Run("assign interviewers success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.AssignInterviewersRequest{ID: id, UserID: adminId.Hex(), InterviewerIDs: []string{interviewer.ID.Hex()}}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&scheduled, nil)
+		tsvc.userRepo.On("GetByIDs", ctx, []primitive.ObjectID{interviewer.ID}).Return([]domains.User{interviewer}, nil)
+		tsvc.interviewAppointmentRepo.On("FindConflicts", ctx, &domains.FindInterviewConflictsParams{
+			InterviewerIDs: []primitive.ObjectID{interviewer.ID},
+			StartAt:        scheduled.StartAt,
+			EndAt:          scheduled.EndAt,
+			ExcludeID:      objId,
+		}).Return([]domains.InterviewAppointment{}, nil)
+		tsvc.interviewAppointmentRepo.On("UpdateInterviewers", ctx, &domains.UpdateInterviewersParams{
+			ID:             objId,
+			InterviewerIDs: []primitive.ObjectID{interviewer.ID},
+		}).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+			return p.Action == constants.AUDIT_INTERVIEW_ASSIGN && p.ActorID == adminId &&
+				assert.ObjectsAreEqual([]domains.AuditChange{{Field: "interviewerIds", After: []primitive.ObjectID{interviewer.ID}}}, p.Changes)
+		})).Return(nil)
+		err := tsvc.service.AssignInterviewers(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("assign interviewers to unscheduled appointment skips conflict check", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.AssignInterviewersRequest{ID: id, UserID: adminId.Hex(), InterviewerIDs: []string{interviewer.ID.Hex()}}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.userRepo.On("GetByIDs", ctx, []primitive.ObjectID{interviewer.ID}).Return([]domains.User{interviewer}, nil)
+		tsvc.interviewAppointmentRepo.On("UpdateInterviewers", ctx, &domains.UpdateInterviewersParams{
+			ID:             objId,
+			InterviewerIDs: []primitive.ObjectID{interviewer.ID},
+		}).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_INTERVIEW_ASSIGN, objId)).Return(nil)
+		err := tsvc.service.AssignInterviewers(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("clear interviewers", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.AssignInterviewersRequest{ID: id, UserID: adminId.Hex(), InterviewerIDs: []string{}}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&scheduled, nil)
+		tsvc.interviewAppointmentRepo.On("UpdateInterviewers", ctx, &domains.UpdateInterviewersParams{
+			ID:             objId,
+			InterviewerIDs: []primitive.ObjectID{},
+		}).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_INTERVIEW_ASSIGN, objId)).Return(nil)
+		err := tsvc.service.AssignInterviewers(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("assign interviewers error when appointment not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.AssignInterviewersRequest{ID: id, UserID: adminId.Hex(), InterviewerIDs: []string{interviewer.ID.Hex()}}
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(nil, nil)
+		err := tsvc.service.AssignInterviewers(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("assign interviewers error when user cannot interview", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		viewer := domains.User{ID: primitive.NewObjectID(), Role: constants.VIEWER_ROLE}
+		deactivated := interviewer
+		deactivated.ID = primitive.NewObjectID()
+		deactivated.IsDeactivated = true
+		missing := primitive.NewObjectID()
+		for _, user := range []domains.User{viewer, deactivated, {ID: missing}} {
+			req := &dto.AssignInterviewersRequest{ID: id, UserID: adminId.Hex(), InterviewerIDs: []string{interviewer.ID.Hex(), user.ID.Hex()}}
+			expected := helpers.NewCustomError(http.StatusBadRequest, "interviewerIds: "+user.ID.Hex()+" is not an active interviewer")
+			users := []domains.User{interviewer}
+			if user.ID != missing {
+				users = append(users, user)
+			}
+			tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&scheduled, nil).Once()
+			tsvc.userRepo.On("GetByIDs", ctx, []primitive.ObjectID{interviewer.ID, user.ID}).Return(users, nil).Once()
+			err := tsvc.service.AssignInterviewers(ctx, req)
+			assert.Equal(t, expected, err)
+		}
+	})
+	t.Run("assign interviewers error when interviewer is double-booked", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.AssignInterviewersRequest{ID: id, UserID: adminId.Hex(), InterviewerIDs: []string{interviewer.ID.Hex()}}
+		other := mockInterviewAppointment2
+		other.StartAt = scheduled.StartAt.Add(-30 * time.Minute)
+		other.EndAt = scheduled.StartAt.Add(30 * time.Minute)
+		other.InterviewerIDs = []primitive.ObjectID{interviewer.ID}
+		expected := helpers.NewCustomErrorWithDetails(http.StatusConflict, "Interviewer is already booked at this time", []dto.InterviewConflict{
 			{
-				ID:      commentObjId,
-				Comment: "comment",
-				User: domains.User{
-					ID:       primitive.NewObjectID(),
-					Name:     "User name 1",
-					Email:    "User email 1",
-					Username: "Username 1",
-					Password: "Password 1",
-					ImageUrl: "https://image-url.com",
-					Role:     "ADMIN",
-				},
-				CreatedAt: now,
-				UpdatedAt: now,
+				ID:             other.ID.Hex(),
+				Title:          other.Title,
+				StartAt:        other.StartAt,
+				EndAt:          other.EndAt,
+				InterviewerIDs: []string{interviewer.ID.Hex()},
 			},
-			{
-				ID:      primitive.NewObjectID(),
-				Comment: "comment 2",
-				User: domains.User{
-					ID:       primitive.NewObjectID(),
-					Name:     "User name 2",
-					Email:    "User email 2",
-					Username: "Username 2",
-					Password: "Password 2",
-					ImageUrl: "https://image-url.com",
-					Role:     "STAFF",
-				},
-				CreatedAt: now,
-				UpdatedAt: now,
+		})
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&scheduled, nil)
+		tsvc.userRepo.On("GetByIDs", ctx, []primitive.ObjectID{interviewer.ID}).Return([]domains.User{interviewer}, nil)
+		tsvc.interviewAppointmentRepo.On("FindConflicts", ctx, &domains.FindInterviewConflictsParams{
+			InterviewerIDs: []primitive.ObjectID{interviewer.ID},
+			StartAt:        scheduled.StartAt,
+			EndAt:          scheduled.EndAt,
+			ExcludeID:      objId,
+		}).Return([]domains.InterviewAppointment{other}, nil)
+		err := tsvc.service.AssignInterviewers(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestGetInterviewerAppointments(t *testing.T) {
+	interviewerId := primitive.NewObjectID()
+	t.Run("get interviewer appointments success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewerAppointmentsRequest{InterviewerID: interviewerId.Hex(), Page: 2, Limit: 5}
+		expected := []domains.InterviewAppointment{mockInterviewAppointment1}
+		tsvc.interviewAppointmentRepo.On("GetByInterviewer", ctx, mock.MatchedBy(func(params *domains.GetInterviewerAppointmentsParams) bool {
+			return params.InterviewerID == interviewerId && params.Offset == 5 && params.Limit == 6 &&
+				time.Since(params.EndAfter) < time.Minute
+		})).Return(expected, nil)
+		got, err := tsvc.service.GetInterviewerAppointments(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get interviewer appointments error", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewerAppointmentsRequest{InterviewerID: interviewerId.Hex(), Page: 1, Limit: 20}
+		expected := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview appointment.")
+		tsvc.interviewAppointmentRepo.On("GetByInterviewer", ctx, mock.Anything).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetInterviewerAppointments(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestArchiveInterviewAppointment(t *testing.T) {
+	creatorId := mockInterviewAppointment1.CreateUser.ID.Hex()
+	t.Run("archive interview appointment success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.ArchiveInterviewAppointmentRequest{ID: id, UserID: creatorId, Role: constants.STAFF_ROLE}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("ArchiveInterviewAppointment", ctx, &domains.ArchiveInterviewAppointmentParams{ID: objId, UserID: userObjId(req.UserID)}).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_INTERVIEW_ARCHIVE, objId)).Return(nil)
+		err := tsvc.service.ArchiveInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("archive interview appointment success when admin archives others", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.ArchiveInterviewAppointmentRequest{ID: id, UserID: primitive.NewObjectID().Hex(), Role: constants.ADMIN_ROLE}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("ArchiveInterviewAppointment", ctx, &domains.ArchiveInterviewAppointmentParams{ID: objId, UserID: userObjId(req.UserID)}).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_INTERVIEW_ARCHIVE, objId)).Return(nil)
+		err := tsvc.service.ArchiveInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("archive interview appointment error when not the creator", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.ArchiveInterviewAppointmentRequest{ID: id, UserID: primitive.NewObjectID().Hex(), Role: constants.STAFF_ROLE}
+		expected := helpers.NewCustomError(http.StatusForbidden, "You don't have permission to archive this interview appointment")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		err := tsvc.service.ArchiveInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("archive interview appointment error when invalid id format", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.ArchiveInterviewAppointmentRequest{ID: "xxxxxxxxx", UserID: creatorId, Role: constants.STAFF_ROLE}
+		expected := helpers.InternalError
+		err := tsvc.service.ArchiveInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("archive interview appointment error when data not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.ArchiveInterviewAppointmentRequest{ID: id, UserID: creatorId, Role: constants.STAFF_ROLE}
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found or archived")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(nil, nil)
+		err := tsvc.service.ArchiveInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("archive interview appointment error when archived concurrently", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.ArchiveInterviewAppointmentRequest{ID: id, UserID: creatorId, Role: constants.STAFF_ROLE}
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found or archived")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("ArchiveInterviewAppointment", ctx, &domains.ArchiveInterviewAppointmentParams{ID: objId, UserID: userObjId(req.UserID)}).Return(mongo.ErrNoDocuments)
+		err := tsvc.service.ArchiveInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("archive interview appointment error when query fail", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := "64aaf0156999249a602ff55f"
+		objId, _ := primitive.ObjectIDFromHex(id)
+		req := &dto.ArchiveInterviewAppointmentRequest{ID: id, UserID: creatorId, Role: constants.STAFF_ROLE}
+		expected := helpers.InternalError
+		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(nil, errors.New("some error"))
+		err := tsvc.service.ArchiveInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestUnarchiveInterviewAppointment(t *testing.T) {
+	creatorId := mockInterviewAppointment1.CreateUser.ID.Hex()
+	id := "64aaf0156999249a602ff55f"
+	objId, _ := primitive.ObjectIDFromHex(id)
+	t.Run("unarchive interview appointment success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.UnarchiveInterviewAppointmentRequest{ID: id, UserID: creatorId, Role: constants.STAFF_ROLE}
+		tsvc.interviewAppointmentRepo.On("GetArchived", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("UnarchiveInterviewAppointment", ctx, objId).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_INTERVIEW_UNARCHIVE, objId)).Return(nil)
+		err := tsvc.service.UnarchiveInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("unarchive interview appointment success when admin restores others", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.UnarchiveInterviewAppointmentRequest{ID: id, UserID: primitive.NewObjectID().Hex(), Role: constants.ADMIN_ROLE}
+		tsvc.interviewAppointmentRepo.On("GetArchived", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("UnarchiveInterviewAppointment", ctx, objId).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_INTERVIEW_UNARCHIVE, objId)).Return(nil)
+		err := tsvc.service.UnarchiveInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("unarchive interview appointment error when not the creator", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.UnarchiveInterviewAppointmentRequest{ID: id, UserID: primitive.NewObjectID().Hex(), Role: constants.STAFF_ROLE}
+		expected := helpers.NewCustomError(http.StatusForbidden, "You don't have permission to restore this interview appointment")
+		tsvc.interviewAppointmentRepo.On("GetArchived", ctx, objId).Return(&mockInterviewAppointment1, nil)
+		err := tsvc.service.UnarchiveInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("unarchive interview appointment error when not archived", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.UnarchiveInterviewAppointmentRequest{ID: id, UserID: creatorId, Role: constants.STAFF_ROLE}
+		expected := helpers.NewCustomError(http.StatusNotFound, "Archived interview appointment not found")
+		tsvc.interviewAppointmentRepo.On("GetArchived", ctx, objId).Return(nil, nil)
+		err := tsvc.service.UnarchiveInterviewAppointment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestPurgeArchivedInterviewAppointments(t *testing.T) {
+	t.Run("purge archived interview appointments success", func(t *testing.T) {
+		t.Setenv("ARCHIVE_RETENTION", "720h")
+		config.New()
+		tsvc := newTestInterviewService(t)
+		ids := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID()}
+		var before time.Time
+		tsvc.interviewAppointmentRepo.On("GetArchivedIDsBefore", ctx, mock.MatchedBy(func(t time.Time) bool {
+			before = t
+			return true
+		})).Return(ids, nil)
+		tsvc.interviewAppointmentRepo.On("DeleteArchived", ctx, ids, mock.MatchedBy(func(t time.Time) bool {
+			return t.Equal(before)
+		})).Return(ids, nil)
+		tsvc.scorecardRepo.On("DeleteByAppointments", ctx, ids).Return(nil)
+		tsvc.interviewCommentRepo.On("DeleteByAppointments", ctx, ids).Return(nil)
+		tsvc.auditEventRepo.On("RedactAppointments", ctx, ids).Return(nil)
+		got, err := tsvc.service.PurgeArchivedInterviewAppointments(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), got)
+		assert.WithinDuration(t, time.Now().Add(-720*time.Hour), before, time.Minute)
+	})
+	t.Run("purge archived interview appointments skips appointment restored mid-purge", func(t *testing.T) {
+		t.Setenv("ARCHIVE_RETENTION", "720h")
+		config.New()
+		tsvc := newTestInterviewService(t)
+		restored := primitive.NewObjectID()
+		purged := primitive.NewObjectID()
+		ids := []primitive.ObjectID{restored, purged}
+		tsvc.interviewAppointmentRepo.On("GetArchivedIDsBefore", ctx, mock.Anything).Return(ids, nil)
+		tsvc.interviewAppointmentRepo.On("DeleteArchived", ctx, ids, mock.Anything).Return([]primitive.ObjectID{purged}, nil)
+		tsvc.scorecardRepo.On("DeleteByAppointments", ctx, []primitive.ObjectID{purged}).Return(nil)
+		tsvc.interviewCommentRepo.On("DeleteByAppointments", ctx, []primitive.ObjectID{purged}).Return(nil)
+		tsvc.auditEventRepo.On("RedactAppointments", ctx, []primitive.ObjectID{purged}).Return(nil)
+		got, err := tsvc.service.PurgeArchivedInterviewAppointments(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), got)
+	})
+	t.Run("purge archived interview appointments all restored mid-purge", func(t *testing.T) {
+		t.Setenv("ARCHIVE_RETENTION", "720h")
+		config.New()
+		tsvc := newTestInterviewService(t)
+		ids := []primitive.ObjectID{primitive.NewObjectID()}
+		tsvc.interviewAppointmentRepo.On("GetArchivedIDsBefore", ctx, mock.Anything).Return(ids, nil)
+		tsvc.interviewAppointmentRepo.On("DeleteArchived", ctx, ids, mock.Anything).Return([]primitive.ObjectID{}, nil)
+		got, err := tsvc.service.PurgeArchivedInterviewAppointments(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), got)
+	})
+	t.Run("purge archived interview appointments nothing expired", func(t *testing.T) {
+		t.Setenv("ARCHIVE_RETENTION", "720h")
+		config.New()
+		tsvc := newTestInterviewService(t)
+		tsvc.interviewAppointmentRepo.On("GetArchivedIDsBefore", ctx, mock.Anything).Return([]primitive.ObjectID{}, nil)
+		got, err := tsvc.service.PurgeArchivedInterviewAppointments(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), got)
+	})
+	t.Run("purge archived interview appointments disabled", func(t *testing.T) {
+		t.Setenv("ARCHIVE_RETENTION", "0")
+		config.New()
+		tsvc := newTestInterviewService(t)
+		got, err := tsvc.service.PurgeArchivedInterviewAppointments(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), got)
+	})
+}
+
+func userObjId(id string) primitive.ObjectID {
+	objId, _ := primitive.ObjectIDFromHex(id)
+	return objId
+}
+
+func TestUpdateWorkflow(t *testing.T) {
+	req := &dto.UpdateWorkflowRequest{
+		Statuses: []dto.WorkflowStatus{
+			{Name: "TODO", Transitions: []string{"SCHEDULED"}},
+			{Name: "SCHEDULED", Transitions: []string{"DONE"}},
+			{Name: "DONE", Transitions: []string{}},
+		},
+		InitialStatus: "TODO",
+		UserID:        adminId.Hex(),
+	}
+	t.Run("update workflow success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		params := &domains.UpdateWorkflowParams{
+			Statuses: []domains.WorkflowStatus{
+				{Name: "TODO", Transitions: []string{"SCHEDULED"}},
+				{Name: "SCHEDULED", Transitions: []string{"DONE"}},
+				{Name: "DONE", Transitions: []string{}},
 			},
-		}...)
-		req := &dto.UpdateInterviewCommentRequest{
-			ID:        id,
-			CommentID: commentId,
+			InitialStatus: "TODO",
+			UpdatedBy:     adminId,
+		}
+		expected := &domains.Workflow{Statuses: params.Statuses, InitialStatus: "TODO", UpdatedBy: adminId}
+		tsvc.interviewAppointmentRepo.On("GetStatusesInUse", ctx).Return([]string{"TODO", "DONE"}, nil)
+		tsvc.workflowRepo.On("Get", ctx).Return(&mockWorkflow, nil)
+		tsvc.workflowRepo.On("Update", ctx, params).Return(expected, nil)
+		tsvc.auditEventRepo.On("Create", ctx, mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+			return p.Action == constants.AUDIT_WORKFLOW_UPDATE && p.ActorID == adminId && len(p.Changes) == 1 &&
+				assert.ObjectsAreEqual(domains.AuditChange{Field: "statuses", Before: mockWorkflow.Statuses, After: params.Statuses}, p.Changes[0])
+		})).Return(nil)
+		got, err := tsvc.service.UpdateWorkflow(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("update workflow error when removing a status in use", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		expected := helpers.NewCustomError(http.StatusConflict, "statuses: IN_PROGRESS is still used by interview appointments")
+		tsvc.interviewAppointmentRepo.On("GetStatusesInUse", ctx).Return([]string{"TODO", "IN_PROGRESS"}, nil)
+		got, err := tsvc.service.UpdateWorkflow(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestGetWorkflow(t *testing.T) {
+	t.Run("get workflow success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		tsvc.workflowRepo.On("Get", ctx).Return(&mockWorkflow, nil)
+		got, err := tsvc.service.GetWorkflow(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &mockWorkflow, got)
+	})
+	t.Run("get workflow error", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		tsvc.workflowRepo.On("Get", ctx).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetWorkflow(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestGetInterviewComments(t *testing.T) {
+	authorId := primitive.NewObjectID()
+	comments := []domains.InterviewComment{
+		{ID: primitive.NewObjectID(), AppointmentID: mockInterviewAppointment1.ID, Comment: "comment 1", UserID: authorId, CreatedAt: now},
+		{ID: primitive.NewObjectID(), AppointmentID: mockInterviewAppointment1.ID, Comment: "comment 2", UserID: authorId, CreatedAt: now},
+	}
+	t.Run("get interview comments first page", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewCommentsRequest{ID: mockInterviewAppointment1.ID.Hex(), Limit: 20, UserID: authorId.Hex(), Role: constants.VIEWER_ROLE}
+		params := &domains.GetInterviewCommentsParams{AppointmentID: mockInterviewAppointment1.ID, Limit: 21}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, params).Return(comments, nil)
+		got, err := tsvc.service.GetInterviewComments(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, comments, got)
+	})
+	t.Run("get interview comments after cursor", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		createdAt := now.Truncate(time.Millisecond).UTC()
+		req := &dto.GetInterviewCommentsRequest{
+			ID:     mockInterviewAppointment1.ID.Hex(),
+			Cursor: helpers.NewCreatedAtCursor(createdAt, comments[0].ID.Hex(), false),
+			Limit:  1,
+			UserID: authorId.Hex(),
+			Role:   constants.VIEWER_ROLE,
+		}
+		params := &domains.GetInterviewCommentsParams{
+			AppointmentID:  mockInterviewAppointment1.ID,
+			AfterCreatedAt: createdAt,
+			AfterID:        comments[0].ID,
+			Limit:          2,
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, params).Return(comments[1:], nil)
+		got, err := tsvc.service.GetInterviewComments(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, comments[1:], got)
+	})
+	t.Run("get interview comments only own comments when feedback is hidden", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		data := mockInterviewAppointment1
+		data.BlindFeedback = true
+		data.HiringManagerID = primitive.NewObjectID()
+		req := &dto.GetInterviewCommentsRequest{ID: data.ID.Hex(), Limit: 20, UserID: authorId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		params := &domains.GetInterviewCommentsParams{AppointmentID: data.ID, UserID: authorId, Limit: 21}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, data.ID).Return(&data, nil)
+		tsvc.interviewCommentRepo.On("ExistsByAuthor", ctx, data.ID, authorId).Return(false, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, params).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewComments(ctx, req)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("get interview comments error when cursor is invalid", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewCommentsRequest{
+			ID:     mockInterviewAppointment1.ID.Hex(),
+			Cursor: helpers.NewCreatedAtCursor(now, comments[0].ID.Hex(), true),
+			Limit:  20,
+			UserID: authorId.Hex(),
+			Role:   constants.VIEWER_ROLE,
+		}
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(&mockInterviewAppointment1, nil)
+		got, err := tsvc.service.GetInterviewComments(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("get interview comments error when interview appointment not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewCommentsRequest{ID: mockInterviewAppointment1.ID.Hex(), Limit: 20, UserID: authorId.Hex(), Role: constants.VIEWER_ROLE}
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(nil, nil)
+		got, err := tsvc.service.GetInterviewComments(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("get interview comments error when query fail", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewCommentsRequest{ID: mockInterviewAppointment1.ID.Hex(), Limit: 20, UserID: authorId.Hex(), Role: constants.VIEWER_ROLE}
+		expected := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview comments.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, mock.Anything).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetInterviewComments(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestAddInterviewComment(t *testing.T) {
+	id := "64aaf0156999249a602ff55f"
+	userId := "6476f457e64589e868aac97d"
+	req := &dto.AddInterviewCommentRequest{
+		ID:      id,
+		Comment: "comment",
+		UserID:  userId,
+	}
+	params := &domains.AddInterviewCommentParams{
+		AppointmentID: userObjId(id),
+		Comment:       req.Comment,
+		UserID:        userObjId(userId),
+	}
+	t.Run("add interview comment success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, params.AppointmentID).Return(&mockInterviewAppointment1, nil)
+		created := &domains.AddInterviewComment{
+			ID:            primitive.NewObjectID(),
+			AppointmentID: params.AppointmentID,
+			Comment:       params.Comment,
+			UserID:        params.UserID,
+			Version:       1,
+		}
+		tsvc.interviewCommentRepo.On("Create", ctx, params).Return(created, nil)
+		tsvc.auditEventRepo.On("Create", ctx, mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+			return p.Action == constants.AUDIT_COMMENT_CREATE && p.TargetID == created.ID && p.AppointmentID == params.AppointmentID &&
+				assert.ObjectsAreEqual([]domains.AuditChange{{Field: "comment", After: "comment"}}, p.Changes)
+		})).Return(nil)
+		err := tsvc.service.AddInterviewComment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("add interview comment error when invalid interview appointment id format", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.AddInterviewCommentRequest{
+			ID:      "xxxxxxxx",
+			Comment: "comment",
+			UserID:  userId,
+		}
+		expected := helpers.InternalError
+		err := tsvc.service.AddInterviewComment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("add interview comment error when invalid user id format", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.AddInterviewCommentRequest{
+			ID:      id,
+			Comment: "comment",
+			UserID:  "xxxxxxx",
+		}
+		expected := helpers.InternalError
+		err := tsvc.service.AddInterviewComment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("add interview comment error when interview appointment not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, params.AppointmentID).Return(nil, nil)
+		err := tsvc.service.AddInterviewComment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("add interview comment error when query fail", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		expected := helpers.InternalError
+		tsvc.interviewAppointmentRepo.On("Get", ctx, params.AppointmentID).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Create", ctx, params).Return(nil, errors.New("some error"))
+		err := tsvc.service.AddInterviewComment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestUpdateInterviewComment(t *testing.T) {
+	id := mockInterviewAppointment1.ID
+	commentId := primitive.NewObjectID()
+	authorId := primitive.NewObjectID()
+	newComment := func() *domains.InterviewComment {
+		return &domains.InterviewComment{
+			ID:            commentId,
+			AppointmentID: id,
+			Comment:       "comment",
+			UserID:        authorId,
+			User:          domains.User{ID: authorId, Name: "User name 1"},
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+	}
+	newRequest := func(userId primitive.ObjectID, role string) *dto.UpdateInterviewCommentRequest {
+		return &dto.UpdateInterviewCommentRequest{
+			ID:        id.Hex(),
+			CommentID: commentId.Hex(),
 			Comment:   "Update comment",
-			UserID:    userId,
+			UserID:    userId.Hex(),
+			Role:      role,
 		}
+	}
+	t.Run("update interview comment success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		params := &domains.UpdateInterviewCommentParams{
+			CommentID:         commentId,
+			Comment:           "Update comment",
+			UserID:            authorId,
+			Revision:          domains.InterviewCommentRevision{Comment: "comment", UserID: authorId, CreatedAt: now},
+			PreviousUpdatedAt: now,
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(newComment(), nil)
+		tsvc.interviewCommentRepo.On("Update", ctx, params).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, mock.MatchedBy(func(p *domains.CreateAuditEventParams) bool {
+			return p.Action == constants.AUDIT_COMMENT_UPDATE && p.TargetID == commentId && p.AppointmentID == id &&
+				assert.ObjectsAreEqual([]domains.AuditChange{{Field: "comment", Before: "comment", After: "Update comment"}}, p.Changes)
+		})).Return(nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(authorId, constants.INTERVIEWER_ROLE))
+		assert.NoError(t, err)
+	})
+	t.Run("update interview comment success when admin edits others comment", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		adminId := primitive.NewObjectID()
+		params := &domains.UpdateInterviewCommentParams{
+			CommentID:         commentId,
+			Comment:           "Update comment",
+			UserID:            adminId,
+			Revision:          domains.InterviewCommentRevision{Comment: "comment", UserID: authorId, CreatedAt: now},
+			PreviousUpdatedAt: now,
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(newComment(), nil)
+		tsvc.interviewCommentRepo.On("Update", ctx, params).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_COMMENT_UPDATE, commentId)).Return(nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(adminId, constants.ADMIN_ROLE))
+		assert.NoError(t, err)
+	})
+	t.Run("update interview comment keeps last editor as revision author", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		editorId := primitive.NewObjectID()
+		editedAt := now.Add(time.Hour)
+		comment := newComment()
+		comment.Comment = "comment edited"
+		comment.UpdatedAt = editedAt
+		comment.UpdatedBy = editorId
+		comment.Revisions = []domains.InterviewCommentRevision{{Comment: "comment", UserID: authorId, CreatedAt: now}}
+		params := &domains.UpdateInterviewCommentParams{
+			CommentID:         commentId,
+			Comment:           "Update comment",
+			UserID:            authorId,
+			Revision:          domains.InterviewCommentRevision{Comment: "comment edited", UserID: editorId, CreatedAt: editedAt},
+			PreviousUpdatedAt: editedAt,
+		}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(comment, nil)
+		tsvc.interviewCommentRepo.On("Update", ctx, params).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_COMMENT_UPDATE, commentId)).Return(nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(authorId, constants.INTERVIEWER_ROLE))
+		assert.NoError(t, err)
+	})
+	t.Run("update interview comment does nothing when text is unchanged", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := newRequest(authorId, constants.INTERVIEWER_ROLE)
+		req.Comment = "comment"
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(newComment(), nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, req)
+		assert.NoError(t, err)
+		tsvc.interviewCommentRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+	t.Run("update interview comment error when invalid interview appointment id format", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := newRequest(authorId, constants.INTERVIEWER_ROLE)
+		req.ID = "xxxxxxx"
+		_, err := tsvc.service.UpdateInterviewComment(ctx, req)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+	t.Run("update interview comment error when invalid interview comment id format", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := newRequest(authorId, constants.INTERVIEWER_ROLE)
+		req.CommentID = "xxxxxxx"
+		_, err := tsvc.service.UpdateInterviewComment(ctx, req)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+	t.Run("update interview comment error when get interview appointment fail", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(nil, errors.New("some error"))
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(authorId, constants.INTERVIEWER_ROLE))
+		assert.Equal(t, helpers.InternalError, err)
+	})
+	t.Run("update interview comment error when interview appointment not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(nil, nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(authorId, constants.INTERVIEWER_ROLE))
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update interview comment error when interview comment not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(nil, nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(authorId, constants.INTERVIEWER_ROLE))
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update interview comment error when comment belongs to another appointment", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		comment := newComment()
+		comment.AppointmentID = primitive.NewObjectID()
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(comment, nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(authorId, constants.INTERVIEWER_ROLE))
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update interview comment error when user comment not match", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
 		expected := helpers.NewCustomError(http.StatusForbidden, "You don't have permission to update this comment")
-		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&interviewAppointment, nil)
-		err := tsvc.service.UpdateInterviewComment(ctx, req)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(newComment(), nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(primitive.NewObjectID(), constants.STAFF_ROLE))
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update interview comment error when comment was deleted", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		comment := newComment()
+		comment.DeletedAt = now
+		expected := helpers.NewCustomError(http.StatusConflict, "Interview comment was deleted")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(comment, nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(authorId, constants.INTERVIEWER_ROLE))
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update interview comment error when comment changed concurrently", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		expected := helpers.NewCustomError(http.StatusConflict, "Interview comment was changed by someone else, please reload")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(newComment(), nil)
+		tsvc.interviewCommentRepo.On("Update", ctx, mock.Anything).Return(mongo.ErrNoDocuments)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(authorId, constants.INTERVIEWER_ROLE))
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update interview comment with If-Match", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		comment := newComment()
+		comment.Version = 2
+		req := newRequest(authorId, constants.INTERVIEWER_ROLE)
+		req.IfMatch = &comment.Version
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(comment, nil)
+		tsvc.interviewCommentRepo.On("Update", ctx, mock.Anything).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_COMMENT_UPDATE, commentId)).Return(nil)
+		got, err := tsvc.service.UpdateInterviewComment(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), got)
+	})
+	t.Run("update interview comment error when If-Match is stale", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		comment := newComment()
+		comment.Version = 2
+		version := int64(1)
+		req := newRequest(authorId, constants.INTERVIEWER_ROLE)
+		req.IfMatch = &version
+		expected := helpers.NewCustomError(http.StatusPreconditionFailed, "Interview comment was changed by someone else, please reload")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(comment, nil)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, req)
+		assert.Equal(t, expected, err)
+		tsvc.interviewCommentRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+	t.Run("update interview comment error when changed after If-Match was checked", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		version := int64(0)
+		req := newRequest(authorId, constants.INTERVIEWER_ROLE)
+		req.IfMatch = &version
+		expected := helpers.NewCustomError(http.StatusPreconditionFailed, "Interview comment was changed by someone else, please reload")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(newComment(), nil)
+		tsvc.interviewCommentRepo.On("Update", ctx, mock.Anything).Return(mongo.ErrNoDocuments)
+		_, err := tsvc.service.UpdateInterviewComment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
 	t.Run("update interview comment error when update query fail", func(t *testing.T) {
 		tsvc := newTestInterviewService(t)
-		id := "6476f457e64589e868aac993"
-		commentId := "6476f457e64589e868aac996"
-		userId := "6476f457e64589e868aac997"
-		objId, _ := primitive.ObjectIDFromHex(id)
-		commentObjId, _ := primitive.ObjectIDFromHex(commentId)
-		userObjId, _ := primitive.ObjectIDFromHex(userId)
-		interviewAppointment := mockInterviewAppointment1
-		interviewAppointment.Comments = append(interviewAppointment.Comments, []domains.InterviewComment{
-			{
-				ID:      commentObjId,
-				Comment: "comment",
-				User: domains.User{
-					ID:       userObjId,
-					Name:     "User name 1",
-					Email:    "User email 1",
-					Username: "Username 1",
-					Password: "Password 1",
-					ImageUrl: "https://image-url.com",
-					Role:     "ADMIN",
-				},
-				CreatedAt: now,
-				UpdatedAt: now,
-			},
-			{
-				ID:      primitive.NewObjectID(),
-				Comment: "comment 2",
-				User: domains.User{
-					ID:       primitive.NewObjectID(),
-					Name:     "User name 2",
-					Email:    "User email 2",
-					Username: "Username 2",
-					Password: "Password 2",
-					ImageUrl: "https://image-url.com",
-					Role:     "STAFF",
-				},
-				CreatedAt: now,
-				UpdatedAt: now,
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(newComment(), nil)
+		tsvc.interviewCommentRepo.On("Update", ctx, mock.Anything).Return(errors.New("some error"))
+		_, err := tsvc.service.UpdateInterviewComment(ctx, newRequest(authorId, constants.INTERVIEWER_ROLE))
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestGetInterviewCommentRevisions(t *testing.T) {
+	authorObjId := primitive.NewObjectID()
+	editorObjId := primitive.NewObjectID()
+	commentObjId := primitive.NewObjectID()
+	newComment := func() *domains.InterviewComment {
+		return &domains.InterviewComment{
+			ID:            commentObjId,
+			AppointmentID: mockInterviewAppointment1.ID,
+			Comment:       "comment 3",
+			UserID:        authorObjId,
+			CreatedAt:     now,
+			UpdatedAt:     now.Add(2 * time.Hour),
+			UpdatedBy:     authorObjId,
+			Revisions: []domains.InterviewCommentRevision{
+				{Comment: "comment 1", UserID: authorObjId, CreatedAt: now},
+				{Comment: "comment 2", UserID: editorObjId, CreatedAt: now.Add(time.Hour)},
 			},
-		}...)
-		req := &dto.UpdateInterviewCommentRequest{
-			ID:        id,
-			CommentID: commentId,
-			Comment:   "Update comment",
-			UserID:    userId,
 		}
-		params := &domains.UpdateInterviewCommentParams{
-			ID:        objId,
-			CommentID: commentObjId,
-			Comment:   req.Comment,
+	}
+	req := &dto.GetInterviewCommentRevisionsRequest{
+		ID:        mockInterviewAppointment1.ID.Hex(),
+		CommentID: commentObjId.Hex(),
+		UserID:    authorObjId.Hex(),
+		Role:      constants.INTERVIEWER_ROLE,
+	}
+	t.Run("get interview comment revisions success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		users := []domains.User{{ID: authorObjId, Name: "Author"}, {ID: editorObjId, Name: "Editor"}}
+		expected := []domains.InterviewCommentRevision{
+			{Comment: "comment 1", UserID: authorObjId, CreatedAt: now, User: &users[0]},
+			{Comment: "comment 2", UserID: editorObjId, CreatedAt: now.Add(time.Hour), User: &users[1]},
 		}
-		expected := helpers.InternalError
-		tsvc.interviewAppointmentRepo.On("Get", ctx, objId).Return(&interviewAppointment, nil)
-		tsvc.interviewAppointmentRepo.On("UpdateComment", ctx, params).Return(errors.New("some error"))
-		err := tsvc.service.UpdateInterviewComment(ctx, req)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentObjId).Return(newComment(), nil)
+		tsvc.userRepo.On("GetByIDs", ctx, []primitive.ObjectID{authorObjId, editorObjId}).Return(users, nil)
+		got, err := tsvc.service.GetInterviewCommentRevisions(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get interview comment revisions returns empty list when never edited", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		comment := newComment()
+		comment.Revisions = nil
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentObjId).Return(comment, nil)
+		got, err := tsvc.service.GetInterviewCommentRevisions(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, []domains.InterviewCommentRevision{}, got)
+	})
+	t.Run("get interview comment revisions error when comment deleted", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		comment := newComment()
+		comment.DeletedAt = now
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentObjId).Return(comment, nil)
+		got, err := tsvc.service.GetInterviewCommentRevisions(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("get interview comment revisions error when hidden by blind feedback", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		data := mockInterviewAppointment1
+		data.BlindFeedback = true
+		data.HiringManagerID = primitive.NewObjectID()
+		otherId := primitive.NewObjectID()
+		req := &dto.GetInterviewCommentRevisionsRequest{ID: data.ID.Hex(), CommentID: commentObjId.Hex(), UserID: otherId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, data.ID).Return(&data, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentObjId).Return(newComment(), nil)
+		tsvc.interviewCommentRepo.On("ExistsByAuthor", ctx, data.ID, otherId).Return(false, nil)
+		got, err := tsvc.service.GetInterviewCommentRevisions(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("get interview comment revisions error when interview appointment not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(nil, nil)
+		got, err := tsvc.service.GetInterviewCommentRevisions(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestDeleteInterviewComment(t *testing.T) {
+	id := mockInterviewAppointment1.ID
+	commentId := primitive.NewObjectID()
+	authorId := primitive.NewObjectID()
+	comment := func(deleted bool) *domains.InterviewComment {
+		data := &domains.InterviewComment{ID: commentId, AppointmentID: id, Comment: "Wrong candidate", UserID: authorId}
+		if deleted {
+			data.DeletedAt = now
+			data.DeletedBy = authorId
+		}
+		return data
+	}
+	t.Run("delete interview comment success by author", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.DeleteInterviewCommentRequest{ID: id.Hex(), CommentID: commentId.Hex(), UserID: authorId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		params := &domains.DeleteInterviewCommentParams{CommentID: commentId, UserID: authorId}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(comment(false), nil)
+		tsvc.interviewCommentRepo.On("Delete", ctx, params).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_COMMENT_DELETE, commentId)).Return(nil)
+		err := tsvc.service.DeleteInterviewComment(ctx, req)
+		assert.NoError(t, err)
+		tsvc.auditEventRepo.AssertNotCalled(t, "RedactTarget", mock.Anything, mock.Anything)
+	})
+	t.Run("delete interview comment success by admin", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		adminId := primitive.NewObjectID()
+		req := &dto.DeleteInterviewCommentRequest{ID: id.Hex(), CommentID: commentId.Hex(), UserID: adminId.Hex(), Role: constants.ADMIN_ROLE}
+		params := &domains.DeleteInterviewCommentParams{CommentID: commentId, UserID: adminId}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(comment(false), nil)
+		tsvc.interviewCommentRepo.On("Delete", ctx, params).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_COMMENT_DELETE, commentId)).Return(nil)
+		err := tsvc.service.DeleteInterviewComment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("delete interview comment error when not the author", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.DeleteInterviewCommentRequest{ID: id.Hex(), CommentID: commentId.Hex(), UserID: primitive.NewObjectID().Hex(), Role: constants.STAFF_ROLE}
+		expected := helpers.NewCustomError(http.StatusForbidden, "You don't have permission to delete this comment")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(comment(false), nil)
+		err := tsvc.service.DeleteInterviewComment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("delete interview comment error when already deleted", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.DeleteInterviewCommentRequest{ID: id.Hex(), CommentID: commentId.Hex(), UserID: authorId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		expected := helpers.NewCustomError(http.StatusConflict, "Interview comment was already deleted")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, commentId).Return(comment(true), nil)
+		err := tsvc.service.DeleteInterviewComment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("delete interview comment error when comment not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		otherCommentId := primitive.NewObjectID()
+		req := &dto.DeleteInterviewCommentRequest{ID: id.Hex(), CommentID: otherCommentId.Hex(), UserID: authorId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewCommentRepo.On("Get", ctx, otherCommentId).Return(nil, nil)
+		err := tsvc.service.DeleteInterviewComment(ctx, req)
 		assert.Equal(t, expected, err)
 	})
+	t.Run("purge interview comment success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.DeleteInterviewCommentRequest{ID: id.Hex(), CommentID: commentId.Hex(), Purge: true, UserID: primitive.NewObjectID().Hex(), Role: constants.ADMIN_ROLE}
+		tsvc.interviewCommentRepo.On("Purge", ctx, id, commentId).Return(nil)
+		tsvc.auditEventRepo.On("RedactTarget", ctx, commentId).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_COMMENT_PURGE, commentId)).Return(nil)
+		err := tsvc.service.DeleteInterviewComment(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("purge interview comment error when not admin", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.DeleteInterviewCommentRequest{ID: id.Hex(), CommentID: commentId.Hex(), Purge: true, UserID: authorId.Hex(), Role: constants.STAFF_ROLE}
+		expected := helpers.NewCustomError(http.StatusForbidden, "You don't have permission to purge comments")
+		err := tsvc.service.DeleteInterviewComment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("purge interview comment error when comment not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.DeleteInterviewCommentRequest{ID: id.Hex(), CommentID: commentId.Hex(), Purge: true, UserID: primitive.NewObjectID().Hex(), Role: constants.ADMIN_ROLE}
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview comment not found.")
+		tsvc.interviewCommentRepo.On("Purge", ctx, id, commentId).Return(mongo.ErrNoDocuments)
+		err := tsvc.service.DeleteInterviewComment(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestGetInterviewActivity(t *testing.T) {
+	id := mockInterviewAppointment1.ID
+	interviewerId := primitive.NewObjectID()
+	otherId := primitive.NewObjectID()
+	newEvents := func() []domains.AuditEvent {
+		return []domains.AuditEvent{
+			{ID: primitive.NewObjectID(), ActorID: otherId, Action: constants.AUDIT_COMMENT_CREATE, TargetType: constants.AUDIT_TARGET_COMMENT, AppointmentID: id, Changes: []domains.AuditChange{{Field: "comment", After: "Strong hire"}}},
+			{ID: primitive.NewObjectID(), ActorID: interviewerId, Action: constants.AUDIT_COMMENT_CREATE, TargetType: constants.AUDIT_TARGET_COMMENT, AppointmentID: id, Changes: []domains.AuditChange{{Field: "comment", After: "Solid"}}},
+			{ID: primitive.NewObjectID(), ActorID: otherId, Action: constants.AUDIT_INTERVIEW_UPDATE, TargetType: constants.AUDIT_TARGET_INTERVIEW, AppointmentID: id, Changes: []domains.AuditChange{{Field: "title", Before: "Title 1", After: "Title"}}},
+			{ID: primitive.NewObjectID(), ActorID: otherId, Action: constants.AUDIT_SCORECARD_SUBMIT, TargetType: constants.AUDIT_TARGET_SCORECARD, AppointmentID: id, Changes: []domains.AuditChange{{Field: "recommendation", After: "STRONG_YES"}}},
+		}
+	}
+	t.Run("get interview activity success", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		createdAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		beforeId := primitive.NewObjectID()
+		req := &dto.GetInterviewActivityRequest{ID: id.Hex(), Cursor: helpers.NewCreatedAtCursor(createdAt, beforeId.Hex(), true), Limit: 20, UserID: interviewerId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		params := &domains.GetAuditEventsParams{AppointmentID: id, BeforeCreatedAt: createdAt, BeforeID: beforeId, Limit: 21}
+		expected := newEvents()
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.auditEventRepo.On("GetAll", ctx, params).Return(newEvents(), nil)
+		got, err := tsvc.service.GetInterviewActivity(ctx, req)
+		assert.NoError(t, err)
+		for i := range expected {
+			assert.Equal(t, expected[i].Changes, got[i].Changes)
+		}
+	})
+	t.Run("get interview activity of archived appointment", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewActivityRequest{ID: id.Hex(), Limit: 20, UserID: interviewerId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		archived := mockInterviewAppointment1
+		archived.IsArchived = true
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(nil, nil)
+		tsvc.interviewAppointmentRepo.On("GetArchived", ctx, id).Return(&archived, nil)
+		tsvc.auditEventRepo.On("GetAll", ctx, &domains.GetAuditEventsParams{AppointmentID: id, Limit: 21}).Return([]domains.AuditEvent{}, nil)
+		got, err := tsvc.service.GetInterviewActivity(ctx, req)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("get interview activity hides feedback of others before submitting feedback", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewActivityRequest{ID: id.Hex(), Limit: 20, UserID: interviewerId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		blind := mockInterviewAppointment1
+		blind.BlindFeedback = true
+		blind.HiringManagerID = primitive.NewObjectID()
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&blind, nil)
+		tsvc.interviewCommentRepo.On("ExistsByAuthor", ctx, id, interviewerId).Return(false, nil)
+		tsvc.auditEventRepo.On("GetAll", ctx, mock.Anything).Return(newEvents(), nil)
+		got, err := tsvc.service.GetInterviewActivity(ctx, req)
+		assert.NoError(t, err)
+		assert.Nil(t, got[0].Changes)
+		assert.Len(t, got[1].Changes, 1)
+		assert.Len(t, got[2].Changes, 1)
+		assert.Nil(t, got[3].Changes)
+	})
+	t.Run("get interview activity error when data not found", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewActivityRequest{ID: id.Hex(), Limit: 20, UserID: interviewerId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		expected := helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(nil, nil)
+		tsvc.interviewAppointmentRepo.On("GetArchived", ctx, id).Return(nil, nil)
+		got, err := tsvc.service.GetInterviewActivity(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("get interview activity error when query fail", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		req := &dto.GetInterviewActivityRequest{ID: id.Hex(), Limit: 20, UserID: interviewerId.Hex(), Role: constants.INTERVIEWER_ROLE}
+		expected := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get interview activity.")
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.auditEventRepo.On("GetAll", ctx, mock.Anything).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetInterviewActivity(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestInterviewAuditFailure(t *testing.T) {
+	t.Run("archive interview appointment success when audit log fails", func(t *testing.T) {
+		tsvc := newTestInterviewService(t)
+		id := mockInterviewAppointment1.ID
+		req := &dto.ArchiveInterviewAppointmentRequest{ID: id.Hex(), UserID: mockInterviewAppointment1.CreateUser.ID.Hex(), Role: constants.STAFF_ROLE}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, id).Return(&mockInterviewAppointment1, nil)
+		tsvc.interviewAppointmentRepo.On("ArchiveInterviewAppointment", ctx, mock.Anything).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_INTERVIEW_ARCHIVE, id)).Return(errors.New("some error"))
+		err := tsvc.service.ArchiveInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+	})
 }
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+)
+
+const recoveryCodeCount = 10
+
+// Second factors, as recorded in the audit log.
+const (
+	mfaMethodTOTP         = "totp"
+	mfaMethodRecoveryCode = "recovery_code"
+)
+
+var errInvalidMFACode = helpers.NewCustomError(http.StatusUnauthorized, "Invalid verification code")
+
+func mfaRequired(user *domains.User) bool {
+	return user.MFAEnabled || (config.Get().Auth.MFARequiredForAdmin && user.Role == constants.ADMIN_ROLE)
+}
+
+func startTOTPEnrollment(ctx context.Context, userRepo ports.UserRepository, user *domains.User) (*domains.TOTPEnrollment, error) {
+	if user.MFAEnabled {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Two-factor authentication is already enabled")
+	}
+	secret, err := helpers.GenerateTOTPSecret()
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if err := userRepo.SetPendingTOTPSecret(ctx, user.ID, secret); err != nil {
+		return nil, helpers.InternalError
+	}
+	return &domains.TOTPEnrollment{
+		Secret: secret,
+		URI:    helpers.TOTPProvisioningURI(config.Get().Auth.MFAIssuer, user.Username, secret),
+	}, nil
+}
+
+// confirmTOTPEnrollment turns the pending secret into the active one once the
+// user proves their authenticator produces matching codes, and returns the
+// recovery codes in plain text. Only their hashes are stored.
+func confirmTOTPEnrollment(ctx context.Context, userRepo ports.UserRepository, user *domains.User, code string) ([]string, error) {
+	if user.MFAEnabled {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Two-factor authentication is already enabled")
+	}
+	if user.PendingTOTP == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Two-factor enrollment has not been started")
+	}
+	step, ok := helpers.VerifyTOTP(user.PendingTOTP, code, time.Now())
+	if !ok {
+		return nil, errInvalidMFACode
+	}
+	codes, err := helpers.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	hashes := make([]string, len(codes))
+	for i, c := range codes {
+		hashes[i] = helpers.HashToken(helpers.NormalizeRecoveryCode(c))
+	}
+	if err := userRepo.EnableTOTP(ctx, user.ID, user.PendingTOTP, hashes); err != nil {
+		return nil, helpers.InternalError
+	}
+	if _, err := userRepo.UseTOTPStep(ctx, user.ID, step); err != nil {
+		return nil, helpers.InternalError
+	}
+	return codes, nil
+}
+
+// verifySecondFactor accepts either a TOTP code or an unused recovery code,
+// and returns which of the two it was.
+func verifySecondFactor(ctx context.Context, userRepo ports.UserRepository, user *domains.User, code string) (string, error) {
+	if step, ok := helpers.VerifyTOTP(user.TOTPSecret, code, time.Now()); ok {
+		used, err := userRepo.UseTOTPStep(ctx, user.ID, step)
+		if err != nil {
+			return "", helpers.InternalError
+		}
+		if !used {
+			return "", errInvalidMFACode
+		}
+		return mfaMethodTOTP, nil
+	}
+	used, err := userRepo.UseRecoveryCode(ctx, user.ID, helpers.HashToken(helpers.NormalizeRecoveryCode(code)))
+	if err != nil {
+		return "", helpers.InternalError
+	}
+	if !used {
+		return "", errInvalidMFACode
+	}
+	return mfaMethodRecoveryCode, nil
+}
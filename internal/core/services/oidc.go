@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/dto"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var errOIDCNotConfigured = helpers.NewCustomError(http.StatusNotFound, "OIDC login is not configured")
+
+// oidcRolePriority orders the roles from most to least privileged so a user
+// in several mapped groups gets the strongest one.
+var oidcRolePriority = []string{
+	constants.ADMIN_ROLE,
+	constants.STAFF_ROLE,
+	constants.INTERVIEWER_ROLE,
+	constants.VIEWER_ROLE,
+}
+
+// StartOIDCLogin returns the provider URL to send the browser to. The nonce
+// and PKCE verifier stay on the server under a hash of the state, so the
+// callback only has to echo the state back.
+func (a *authService) StartOIDCLogin(ctx context.Context) (string, error) {
+	if a.oidcProvider == nil {
+		return "", errOIDCNotConfigured
+	}
+	state, err := helpers.GenerateRandomToken()
+	if err != nil {
+		return "", helpers.InternalError
+	}
+	nonce, err := helpers.GenerateRandomToken()
+	if err != nil {
+		return "", helpers.InternalError
+	}
+	codeVerifier, err := helpers.GenerateRandomToken()
+	if err != nil {
+		return "", helpers.InternalError
+	}
+	authURL, err := a.oidcProvider.AuthCodeURL(ctx, state, nonce, helpers.PKCEChallenge(codeVerifier))
+	if err != nil {
+		return "", helpers.NewCustomError(http.StatusBadGateway, "Identity provider is unavailable")
+	}
+	params := &domains.CreateOIDCStateParams{
+		StateHash:    helpers.HashToken(state),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    time.Now().Add(config.Get().OIDC.StateTTL),
+	}
+	if _, err := a.oidcStateRepo.Create(ctx, params); err != nil {
+		return "", helpers.InternalError
+	}
+	return authURL, nil
+}
+
+func (a *authService) CompleteOIDCLogin(ctx context.Context, req *dto.OIDCCallbackRequest) (*domains.AuthToken, error) {
+	if a.oidcProvider == nil {
+		return nil, errOIDCNotConfigured
+	}
+	attempt := loginAttempt{method: loginMethodOIDC, requestID: req.RequestID, clientIP: req.ClientIP}
+	state, err := a.oidcStateRepo.Consume(ctx, helpers.HashToken(req.State))
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if state == nil || state.ExpiresAt.Before(time.Now()) {
+		a.recordLogin(ctx, attempt, nil, constants.AUDIT_LOGIN_FAILED, "invalid_state")
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid or expired login state")
+	}
+	identity, err := a.oidcProvider.Exchange(ctx, req.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		a.recordLogin(ctx, attempt, nil, constants.AUDIT_LOGIN_FAILED, "provider_rejected")
+		return nil, helpers.NewCustomError(http.StatusUnauthorized, "OIDC login failed")
+	}
+	attempt.username = identity.Email
+	role := oidcRole(identity.Groups)
+	if role == "" {
+		a.recordLogin(ctx, attempt, nil, constants.AUDIT_LOGIN_FAILED, "role_not_allowed")
+		return nil, helpers.NewCustomError(http.StatusForbidden, "Your account is not allowed to sign in")
+	}
+	user, err := a.oidcUser(ctx, identity, role, attempt)
+	if err != nil {
+		if err != helpers.InternalError {
+			a.recordLogin(ctx, attempt, nil, constants.AUDIT_LOGIN_FAILED, "account_conflict")
+		}
+		return nil, err
+	}
+	if user.IsDeactivated {
+		a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_FAILED, "deactivated")
+		return nil, helpers.NewCustomError(http.StatusForbidden, "Account is deactivated")
+	}
+	if mfaRequired(user) {
+		a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_MFA_CHALLENGED, "")
+		return a.issueMFAChallenge(user)
+	}
+	a.recordLogin(ctx, attempt, user, constants.AUDIT_LOGIN_SUCCEEDED, "")
+	return a.issueToken(ctx, user, primitive.NewObjectID())
+}
+
+func (a *authService) GetAuthSetting(ctx context.Context) (*domains.AuthSetting, error) {
+	setting, err := a.authSettingRepo.Get(ctx)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	setting.OIDCEnabled = a.oidcProvider != nil
+	return setting, nil
+}
+
+// UpdateAuthSetting refuses to turn password login off while single sign-on
+// is not configured, since nobody could log in afterwards.
+func (a *authService) UpdateAuthSetting(ctx context.Context, req *dto.UpdateAuthSettingRequest) (*domains.AuthSetting, error) {
+	if !*req.PasswordLoginEnabled && a.oidcProvider == nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Cannot disable password login while OIDC login is not configured")
+	}
+	userID, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	params := &domains.UpdateAuthSettingParams{
+		PasswordLoginDisabled: !*req.PasswordLoginEnabled,
+		UpdatedBy:             userID,
+	}
+	setting, err := a.authSettingRepo.Update(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	setting.OIDCEnabled = a.oidcProvider != nil
+	return setting, nil
+}
+
+// oidcUser finds the local account for the identity. Accounts are matched by
+// subject, then linked by verified email, and otherwise created. The role is
+// synced from the provider on every login, and a changed role is recorded
+// with the provider as its source.
+func (a *authService) oidcUser(ctx context.Context, identity *domains.OIDCIdentity, role string, attempt loginAttempt) (*domains.User, error) {
+	user, err := a.userRepo.GetByOIDCSubject(ctx, identity.Subject)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if user == nil && identity.Email != "" && identity.EmailVerified {
+		user, err = a.userRepo.GetByEmail(ctx, identity.Email)
+		if err != nil {
+			return nil, helpers.InternalError
+		}
+		if user != nil && (user.IsServiceAccount || user.OIDCSubject != "") {
+			return nil, helpers.NewCustomError(http.StatusConflict, "Account is already linked to another identity")
+		}
+	}
+	if user == nil {
+		return a.createOIDCUser(ctx, identity, role)
+	}
+	if user.OIDCSubject == identity.Subject && user.Role == role {
+		return user, nil
+	}
+	params := &domains.UpdateUserParams{
+		ID:          user.ID,
+		Role:        role,
+		OIDCSubject: identity.Subject,
+	}
+	updated, err := a.userRepo.Update(ctx, params)
+	if err != nil || updated == nil {
+		return nil, helpers.InternalError
+	}
+	if user.Role != updated.Role {
+		diff := auditDiff{}
+		diff.add("role", user.Role, updated.Role)
+		recordAudit(ctx, a.auditEventRepo, &domains.CreateAuditEventParams{
+			Action:     constants.AUDIT_USER_ROLE_UPDATE,
+			TargetType: constants.AUDIT_TARGET_USER,
+			TargetID:   user.ID,
+			Changes:    diff,
+			Metadata:   map[string]string{"source": loginMethodOIDC},
+			RequestID:  attempt.requestID,
+			IPAddress:  attempt.clientIP,
+		})
+	}
+	return updated, nil
+}
+
+// createOIDCUser provisions the account on first login. It has no password,
+// so it can only sign in through the provider until a password is reset.
+func (a *authService) createOIDCUser(ctx context.Context, identity *domains.OIDCIdentity, role string) (*domains.User, error) {
+	username := identity.Username
+	if username == "" {
+		username = identity.Email
+	}
+	if username == "" {
+		username = identity.Subject
+	}
+	existing, err := a.userRepo.GetByUsername(ctx, username)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if existing != nil {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Duplicate username")
+	}
+	name := identity.Name
+	if name == "" {
+		name = username
+	}
+	params := &domains.CreateUserParams{
+		Name:        name,
+		Email:       identity.Email,
+		Username:    username,
+		ImageUrl:    identity.Picture,
+		Role:        role,
+		OIDCSubject: identity.Subject,
+	}
+	user, err := a.userRepo.Create(ctx, params)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Create user fail")
+	}
+	return user, nil
+}
+
+// oidcRole maps the provider groups through OIDC_ROLE_MAPPING and falls back
+// to OIDC_DEFAULT_ROLE. An empty result means the user may not sign in.
+func oidcRole(groups []string) string {
+	cfg := config.Get().OIDC
+	granted := map[string]bool{}
+	for _, g := range groups {
+		if role, ok := cfg.RoleMapping[g]; ok {
+			granted[role] = true
+		}
+	}
+	for _, role := range oidcRolePriority {
+		if granted[role] {
+			return role
+		}
+	}
+	if constants.IsRole(cfg.DefaultRole) {
+		return cfg.DefaultRole
+	}
+	return ""
+}
@@ -0,0 +1,316 @@
+package services_test
+
+import (
+	"errors"
+	"net/http"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/helpers/oidctest"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/services"
+	"robinhood-assignment/internal/dto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newTestAuthServiceWithoutOIDC(t *testing.T) testAuthService {
+	tsvc := newTestAuthService(t)
+	tsvc.service = services.NewAuthService(tsvc.userRepo, tsvc.refreshTokenRepo, tsvc.passwordResetTokenRepo, tsvc.loginAttemptRepo, tsvc.oidcStateRepo, tsvc.authSettingRepo, tsvc.auditEventRepo, tsvc.myBcrypt, tsvc.myJWT, tsvc.mailer, nil)
+	return tsvc
+}
+
+func setOIDCEnv(t *testing.T) {
+	t.Setenv("JWT_SECRET", "mock-jwt-secret")
+	t.Setenv("OIDC_ROLE_MAPPING", "interview-admins:ADMIN,interviewers:INTERVIEWER")
+	t.Setenv("OIDC_DEFAULT_ROLE", "")
+	t.Setenv("OIDC_STATE_TTL", "10m")
+	t.Setenv("MFA_REQUIRED_FOR_ADMIN", "false")
+	config.New()
+}
+
+func TestStartOIDCLogin(t *testing.T) {
+	setOIDCEnv(t)
+	t.Run("start oidc login success", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		var challenge string
+		tsvc.oidcProvider.On("AuthCodeURL", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Run(func(args mock.Arguments) {
+			challenge = args.String(3)
+		}).Return("https://idp.example.com/authorize?client_id=app", nil)
+		tsvc.oidcStateRepo.On("Create", ctx, mock.MatchedBy(func(params *domains.CreateOIDCStateParams) bool {
+			return len(params.StateHash) == 64 &&
+				params.Nonce != "" &&
+				helpers.PKCEChallenge(params.CodeVerifier) == challenge &&
+				params.ExpiresAt.After(time.Now().Add(9*time.Minute))
+		})).Return(&domains.OIDCState{}, nil)
+		got, err := tsvc.service.StartOIDCLogin(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://idp.example.com/authorize?client_id=app", got)
+	})
+	t.Run("start oidc login error when not configured", func(t *testing.T) {
+		tsvc := newTestAuthServiceWithoutOIDC(t)
+		expected := helpers.NewCustomError(http.StatusNotFound, "OIDC login is not configured")
+		got, err := tsvc.service.StartOIDCLogin(ctx)
+		assert.Equal(t, "", got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("start oidc login error when provider is unavailable", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		expected := helpers.NewCustomError(http.StatusBadGateway, "Identity provider is unavailable")
+		tsvc.oidcProvider.On("AuthCodeURL", ctx, mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("connection refused"))
+		got, err := tsvc.service.StartOIDCLogin(ctx)
+		assert.Equal(t, "", got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestCompleteOIDCLogin(t *testing.T) {
+	setOIDCEnv(t)
+	req := &dto.OIDCCallbackRequest{Code: "auth-code", State: "state"}
+	state := &domains.OIDCState{
+		ID:           primitive.NewObjectID(),
+		StateHash:    helpers.HashToken("state"),
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		ExpiresAt:    time.Now().Add(5 * time.Minute),
+	}
+	identity := &domains.OIDCIdentity{
+		Subject:       "idp-user-1",
+		Email:         email,
+		EmailVerified: true,
+		Name:          name,
+		Username:      username,
+		Groups:        []string{"engineering", "interviewers"},
+	}
+	t.Run("complete oidc login success and create user", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		created := domains.User{ID: primitive.NewObjectID(), Username: username, Role: constants.INTERVIEWER_ROLE, OIDCSubject: identity.Subject}
+		tsvc.oidcStateRepo.On("Consume", ctx, state.StateHash).Return(state, nil)
+		tsvc.oidcProvider.On("Exchange", ctx, "auth-code", "verifier", "nonce").Return(identity, nil)
+		tsvc.userRepo.On("GetByOIDCSubject", ctx, identity.Subject).Return(nil, nil)
+		tsvc.userRepo.On("GetByEmail", ctx, email).Return(nil, nil)
+		tsvc.userRepo.On("GetByUsername", ctx, username).Return(nil, nil)
+		tsvc.userRepo.On("Create", ctx, &domains.CreateUserParams{
+			Name:        name,
+			Email:       email,
+			Username:    username,
+			Role:        constants.INTERVIEWER_ROLE,
+			OIDCSubject: identity.Subject,
+		}).Return(&created, nil)
+		tsvc.myJWT.On("SignClaims", mock.MatchedBy(func(claims domains.Claims) bool {
+			return claims.UserID == created.ID.Hex() && claims.Role == constants.INTERVIEWER_ROLE
+		})).Return("jwt-token", nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(&domains.RefreshToken{}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_SUCCEEDED, "oidc", "")).Return(nil)
+		got, err := tsvc.service.CompleteOIDCLogin(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "jwt-token", got.AccessToken)
+		assert.NotEmpty(t, got.RefreshToken)
+	})
+	t.Run("complete oidc login success and sync role of linked user", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		linked := user
+		linked.OIDCSubject = identity.Subject
+		adminIdentity := *identity
+		adminIdentity.Groups = []string{"interviewers", "interview-admins"}
+		updated := linked
+		updated.Role = constants.ADMIN_ROLE
+		tsvc.oidcStateRepo.On("Consume", ctx, state.StateHash).Return(state, nil)
+		tsvc.oidcProvider.On("Exchange", ctx, "auth-code", "verifier", "nonce").Return(&adminIdentity, nil)
+		tsvc.userRepo.On("GetByOIDCSubject", ctx, identity.Subject).Return(&linked, nil)
+		tsvc.userRepo.On("Update", ctx, &domains.UpdateUserParams{ID: linked.ID, Role: constants.ADMIN_ROLE, OIDCSubject: identity.Subject}).Return(&updated, nil)
+		tsvc.auditEventRepo.On("Create", ctx, &domains.CreateAuditEventParams{
+			Action:     constants.AUDIT_USER_ROLE_UPDATE,
+			TargetType: constants.AUDIT_TARGET_USER,
+			TargetID:   linked.ID,
+			Changes:    []domains.AuditChange{{Field: "role", Before: constants.STAFF_ROLE, After: constants.ADMIN_ROLE}},
+			Metadata:   map[string]string{"source": "oidc"},
+		}).Return(nil)
+		tsvc.myJWT.On("SignClaims", mock.MatchedBy(func(claims domains.Claims) bool {
+			return claims.UserID == linked.ID.Hex() && claims.Role == constants.ADMIN_ROLE
+		})).Return("jwt-token", nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(&domains.RefreshToken{}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_SUCCEEDED, "oidc", "")).Return(nil)
+		got, err := tsvc.service.CompleteOIDCLogin(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "jwt-token", got.AccessToken)
+	})
+	t.Run("complete oidc login success and link user by verified email", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		updated := user
+		updated.Role = constants.INTERVIEWER_ROLE
+		updated.OIDCSubject = identity.Subject
+		tsvc.oidcStateRepo.On("Consume", ctx, state.StateHash).Return(state, nil)
+		tsvc.oidcProvider.On("Exchange", ctx, "auth-code", "verifier", "nonce").Return(identity, nil)
+		tsvc.userRepo.On("GetByOIDCSubject", ctx, identity.Subject).Return(nil, nil)
+		tsvc.userRepo.On("GetByEmail", ctx, email).Return(&user, nil)
+		tsvc.userRepo.On("Update", ctx, &domains.UpdateUserParams{ID: user.ID, Role: constants.INTERVIEWER_ROLE, OIDCSubject: identity.Subject}).Return(&updated, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAudit(constants.AUDIT_USER_ROLE_UPDATE, user.ID)).Return(nil)
+		tsvc.myJWT.On("SignClaims", mock.AnythingOfType("domains.Claims")).Return("jwt-token", nil)
+		tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(&domains.RefreshToken{}, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_SUCCEEDED, "oidc", "")).Return(nil)
+		got, err := tsvc.service.CompleteOIDCLogin(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "jwt-token", got.AccessToken)
+	})
+	t.Run("complete oidc login error when email belongs to another identity", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		other := user
+		other.OIDCSubject = "idp-user-2"
+		expected := helpers.NewCustomError(http.StatusConflict, "Account is already linked to another identity")
+		tsvc.oidcStateRepo.On("Consume", ctx, state.StateHash).Return(state, nil)
+		tsvc.oidcProvider.On("Exchange", ctx, "auth-code", "verifier", "nonce").Return(identity, nil)
+		tsvc.userRepo.On("GetByOIDCSubject", ctx, identity.Subject).Return(nil, nil)
+		tsvc.userRepo.On("GetByEmail", ctx, email).Return(&other, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_FAILED, "oidc", "account_conflict")).Return(nil)
+		got, err := tsvc.service.CompleteOIDCLogin(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("complete oidc login error when state is unknown", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid or expired login state")
+		tsvc.oidcStateRepo.On("Consume", ctx, state.StateHash).Return(nil, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_FAILED, "oidc", "invalid_state")).Return(nil)
+		got, err := tsvc.service.CompleteOIDCLogin(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("complete oidc login error when state is expired", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		expired := *state
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid or expired login state")
+		tsvc.oidcStateRepo.On("Consume", ctx, state.StateHash).Return(&expired, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_FAILED, "oidc", "invalid_state")).Return(nil)
+		got, err := tsvc.service.CompleteOIDCLogin(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("complete oidc login error when code exchange fail", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		expected := helpers.NewCustomError(http.StatusUnauthorized, "OIDC login failed")
+		tsvc.oidcStateRepo.On("Consume", ctx, state.StateHash).Return(state, nil)
+		tsvc.oidcProvider.On("Exchange", ctx, "auth-code", "verifier", "nonce").Return(nil, errors.New("invalid_grant"))
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_FAILED, "oidc", "provider_rejected")).Return(nil)
+		got, err := tsvc.service.CompleteOIDCLogin(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("complete oidc login error when no group is mapped to a role", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		unmapped := *identity
+		unmapped.Groups = []string{"engineering"}
+		expected := helpers.NewCustomError(http.StatusForbidden, "Your account is not allowed to sign in")
+		tsvc.oidcStateRepo.On("Consume", ctx, state.StateHash).Return(state, nil)
+		tsvc.oidcProvider.On("Exchange", ctx, "auth-code", "verifier", "nonce").Return(&unmapped, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_FAILED, "oidc", "role_not_allowed")).Return(nil)
+		got, err := tsvc.service.CompleteOIDCLogin(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("complete oidc login error when account is deactivated", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		deactivated := user
+		deactivated.Role = constants.INTERVIEWER_ROLE
+		deactivated.OIDCSubject = identity.Subject
+		deactivated.IsDeactivated = true
+		expected := helpers.NewCustomError(http.StatusForbidden, "Account is deactivated")
+		tsvc.oidcStateRepo.On("Consume", ctx, state.StateHash).Return(state, nil)
+		tsvc.oidcProvider.On("Exchange", ctx, "auth-code", "verifier", "nonce").Return(identity, nil)
+		tsvc.userRepo.On("GetByOIDCSubject", ctx, identity.Subject).Return(&deactivated, nil)
+		tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_FAILED, "oidc", "deactivated")).Return(nil)
+		got, err := tsvc.service.CompleteOIDCLogin(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestOIDCLoginWithMockIdP(t *testing.T) {
+	setOIDCEnv(t)
+	idp := oidctest.NewServer("client-id", "client-secret")
+	defer idp.Close()
+	idp.Claims = map[string]interface{}{
+		"sub":                "idp-user-1",
+		"email":              email,
+		"email_verified":     true,
+		"preferred_username": username,
+		"groups":             []string{"interview-admins"},
+	}
+	provider := helpers.NewOIDCClient(idp.Issuer(), idp.ClientID, idp.ClientSecret, "http://localhost:8080/api/auth/oidc/callback", []string{"openid"}, "groups")
+	tsvc := newTestAuthService(t)
+	tsvc.service = services.NewAuthService(tsvc.userRepo, tsvc.refreshTokenRepo, tsvc.passwordResetTokenRepo, tsvc.loginAttemptRepo, tsvc.oidcStateRepo, tsvc.authSettingRepo, tsvc.auditEventRepo, tsvc.myBcrypt, tsvc.myJWT, tsvc.mailer, provider)
+
+	var saved *domains.OIDCState
+	tsvc.oidcStateRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateOIDCStateParams")).Run(func(args mock.Arguments) {
+		params := args.Get(1).(*domains.CreateOIDCStateParams)
+		saved = &domains.OIDCState{StateHash: params.StateHash, Nonce: params.Nonce, CodeVerifier: params.CodeVerifier, ExpiresAt: params.ExpiresAt}
+	}).Return(&domains.OIDCState{}, nil)
+	authURL, err := tsvc.service.StartOIDCLogin(ctx)
+	require.NoError(t, err)
+
+	code, state, err := idp.Authorize(authURL)
+	require.NoError(t, err)
+	admin := domains.User{ID: primitive.NewObjectID(), Username: username, Role: constants.ADMIN_ROLE, OIDCSubject: "idp-user-1"}
+	tsvc.oidcStateRepo.On("Consume", ctx, helpers.HashToken(state)).Return(saved, nil)
+	tsvc.userRepo.On("GetByOIDCSubject", ctx, "idp-user-1").Return(&admin, nil)
+	tsvc.myJWT.On("SignClaims", mock.MatchedBy(func(claims domains.Claims) bool {
+		return claims.UserID == admin.ID.Hex() && claims.Role == constants.ADMIN_ROLE
+	})).Return("jwt-token", nil)
+	tsvc.refreshTokenRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateRefreshTokenParams")).Return(&domains.RefreshToken{}, nil)
+	tsvc.auditEventRepo.On("Create", ctx, matchAuthAudit(constants.AUDIT_LOGIN_SUCCEEDED, "oidc", "")).Return(nil)
+	got, err := tsvc.service.CompleteOIDCLogin(ctx, &dto.OIDCCallbackRequest{Code: code, State: state})
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-token", got.AccessToken)
+}
+
+func TestUpdateAuthSetting(t *testing.T) {
+	enabled, disabled := true, false
+	t.Run("update auth setting success", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.UpdateAuthSettingRequest{PasswordLoginEnabled: &disabled, UserID: adminId.Hex()}
+		tsvc.authSettingRepo.On("Update", ctx, &domains.UpdateAuthSettingParams{PasswordLoginDisabled: true, UpdatedBy: adminId}).Return(&domains.AuthSetting{PasswordLoginDisabled: true}, nil)
+		got, err := tsvc.service.UpdateAuthSetting(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &domains.AuthSetting{PasswordLoginDisabled: true, OIDCEnabled: true}, got)
+	})
+	t.Run("update auth setting success when enabling password login without oidc", func(t *testing.T) {
+		tsvc := newTestAuthServiceWithoutOIDC(t)
+		req := &dto.UpdateAuthSettingRequest{PasswordLoginEnabled: &enabled, UserID: adminId.Hex()}
+		tsvc.authSettingRepo.On("Update", ctx, &domains.UpdateAuthSettingParams{PasswordLoginDisabled: false, UpdatedBy: adminId}).Return(&domains.AuthSetting{}, nil)
+		got, err := tsvc.service.UpdateAuthSetting(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &domains.AuthSetting{}, got)
+	})
+	t.Run("update auth setting error when disabling password login without oidc", func(t *testing.T) {
+		tsvc := newTestAuthServiceWithoutOIDC(t)
+		req := &dto.UpdateAuthSettingRequest{PasswordLoginEnabled: &disabled, UserID: adminId.Hex()}
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Cannot disable password login while OIDC login is not configured")
+		got, err := tsvc.service.UpdateAuthSetting(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update auth setting error when query fail", func(t *testing.T) {
+		tsvc := newTestAuthService(t)
+		req := &dto.UpdateAuthSettingRequest{PasswordLoginEnabled: &disabled, UserID: adminId.Hex()}
+		tsvc.authSettingRepo.On("Update", ctx, mock.Anything).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.UpdateAuthSetting(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestGetAuthSetting(t *testing.T) {
+	t.Run("get auth setting success", func(t *testing.T) {
+		tsvc := newTestAuthServiceWithoutOIDC(t)
+		tsvc.authSettingRepo.On("Get", ctx).Return(&domains.AuthSetting{}, nil)
+		got, err := tsvc.service.GetAuthSetting(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domains.AuthSetting{OIDCEnabled: false}, got)
+	})
+}
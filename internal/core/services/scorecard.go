@@ -0,0 +1,439 @@
+package services
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type scorecardService struct {
+	scorecardTemplateRepo    ports.ScorecardTemplateRepository
+	scorecardRepo            ports.ScorecardRepository
+	interviewAppointmentRepo ports.InterviewAppointmentRepository
+	interviewCommentRepo     ports.InterviewCommentRepository
+	auditEventRepo           ports.AuditEventRepository
+}
+
+func NewScorecardService(scorecardTemplateRepo ports.ScorecardTemplateRepository, scorecardRepo ports.ScorecardRepository, interviewAppointmentRepo ports.InterviewAppointmentRepository, interviewCommentRepo ports.InterviewCommentRepository, auditEventRepo ports.AuditEventRepository) ports.ScorecardService {
+	return &scorecardService{
+		scorecardTemplateRepo:    scorecardTemplateRepo,
+		scorecardRepo:            scorecardRepo,
+		interviewAppointmentRepo: interviewAppointmentRepo,
+		interviewCommentRepo:     interviewCommentRepo,
+		auditEventRepo:           auditEventRepo,
+	}
+}
+
+func (s *scorecardService) GetScorecardTemplates(ctx context.Context, req *dto.GetScorecardTemplatesRequest) ([]domains.ScorecardTemplate, error) {
+	params := &domains.GetScorecardTemplatesParams{
+		IncludeArchived: req.IncludeArchived,
+		Offset:          (req.Page - 1) * req.Limit,
+		Limit:           req.Limit + 1,
+	}
+	data, err := s.scorecardTemplateRepo.GetAll(ctx, params)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get scorecard templates.")
+	}
+	return data, nil
+}
+
+func (s *scorecardService) GetScorecardTemplate(ctx context.Context, id string) (*domains.ScorecardTemplate, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	data, err := s.scorecardTemplateRepo.Get(ctx, objID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Scorecard template not found.")
+	}
+	return data, nil
+}
+
+func (s *scorecardService) CreateScorecardTemplate(ctx context.Context, req *dto.CreateScorecardTemplateRequest) (*domains.ScorecardTemplate, error) {
+	userId, err := primitive.ObjectIDFromHex(req.CreatedBy)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	competencies := make([]domains.ScorecardCompetency, len(req.Competencies))
+	for i, competency := range req.Competencies {
+		competencies[i] = domains.ScorecardCompetency{
+			Name:        competency.Name,
+			Description: competency.Description,
+		}
+	}
+	questions := make([]domains.ScorecardQuestion, len(req.Questions))
+	for i, question := range req.Questions {
+		questions[i] = domains.ScorecardQuestion{
+			Text:     question.Text,
+			Required: question.Required,
+		}
+	}
+	params := &domains.CreateScorecardTemplateParams{
+		Name:         req.Name,
+		Competencies: competencies,
+		RatingScale: domains.ScorecardRatingScale{
+			Min: req.RatingScale.Min,
+			Max: req.RatingScale.Max,
+		},
+		Questions: questions,
+		CreatedBy: userId,
+	}
+	data, err := s.scorecardTemplateRepo.Create(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    userId,
+		Action:     constants.AUDIT_TEMPLATE_CREATE,
+		TargetType: constants.AUDIT_TARGET_TEMPLATE,
+		TargetID:   data.ID,
+		Changes:    diffScorecardTemplate(&domains.ScorecardTemplate{}, data),
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return data, nil
+}
+
+// ArchiveScorecardTemplate stops a template from being linked to more
+// appointments. Appointments already using it keep it.
+func (s *scorecardService) ArchiveScorecardTemplate(ctx context.Context, req *dto.ArchiveScorecardTemplateRequest) (*domains.ScorecardTemplate, error) {
+	objID, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	current, err := s.scorecardTemplateRepo.Get(ctx, objID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if current == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Scorecard template not found.")
+	}
+	data, err := s.scorecardTemplateRepo.Archive(ctx, objID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Scorecard template not found.")
+	}
+	if changes := diffScorecardTemplate(current, data); len(changes) > 0 {
+		recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+			ActorID:    userId,
+			Action:     constants.AUDIT_TEMPLATE_ARCHIVE,
+			TargetType: constants.AUDIT_TARGET_TEMPLATE,
+			TargetID:   objID,
+			Changes:    changes,
+			RequestID:  req.RequestID,
+			IPAddress:  req.ClientIP,
+		})
+	}
+	return data, nil
+}
+
+// diffScorecardTemplate lists the fields of a template.
+func diffScorecardTemplate(before *domains.ScorecardTemplate, after *domains.ScorecardTemplate) []domains.AuditChange {
+	diff := auditDiff{}
+	diff.add("name", before.Name, after.Name)
+	diff.add("competencies", before.Competencies, after.Competencies)
+	diff.add("ratingScale", before.RatingScale, after.RatingScale)
+	diff.add("questions", before.Questions, after.Questions)
+	diff.add("isArchived", before.IsArchived, after.IsArchived)
+	return diff
+}
+
+func (s *scorecardService) GetScorecards(ctx context.Context, req *dto.GetScorecardsRequest) ([]domains.Scorecard, error) {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	appointment, err := s.interviewAppointmentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if appointment == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+	}
+	if err := hideBlindFeedback(ctx, s.interviewCommentRepo, appointment, userId, req.Role); err != nil {
+		return nil, helpers.InternalError
+	}
+	if appointment.FeedbackHidden {
+		// The caller has not submitted a scorecard, so none of these are theirs.
+		return []domains.Scorecard{}, nil
+	}
+	data, err := s.scorecardRepo.GetByAppointment(ctx, id)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get scorecards.")
+	}
+	return data, nil
+}
+
+// SubmitScorecard records the feedback of an assigned interviewer. Each
+// interviewer submits one scorecard per appointment, which they can change
+// until the lock window has passed.
+func (s *scorecardService) SubmitScorecard(ctx context.Context, req *dto.SubmitScorecardRequest) (*domains.Scorecard, error) {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	appointment, err := s.interviewAppointmentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if appointment == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found.")
+	}
+	if !isAssignedInterviewer(appointment, userId) {
+		return nil, helpers.NewCustomError(http.StatusForbidden, "Only assigned interviewers can submit a scorecard")
+	}
+	if appointment.ScorecardTemplate == nil {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Interview appointment has no scorecard template")
+	}
+	ratings, answers, err := checkScorecard(appointment.ScorecardTemplate, req.Ratings, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+	params := &domains.CreateScorecardParams{
+		AppointmentID:  id,
+		TemplateID:     appointment.ScorecardTemplate.ID,
+		InterviewerID:  userId,
+		Ratings:        ratings,
+		Answers:        answers,
+		Recommendation: req.Recommendation,
+		LockedAt:       time.Now().Add(config.Get().Interview.ScorecardLockWindow),
+	}
+	data, err := s.scorecardRepo.Create(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusConflict, "You have already submitted a scorecard for this interview appointment")
+	}
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:       userId,
+		Action:        constants.AUDIT_SCORECARD_SUBMIT,
+		TargetType:    constants.AUDIT_TARGET_SCORECARD,
+		TargetID:      data.ID,
+		AppointmentID: id,
+		Changes:       diffScorecard(&domains.Scorecard{}, data),
+		RequestID:     req.RequestID,
+		IPAddress:     req.ClientIP,
+	})
+	return data, nil
+}
+
+// UpdateScorecard lets an interviewer change their own scorecard while it is
+// not locked.
+func (s *scorecardService) UpdateScorecard(ctx context.Context, req *dto.UpdateScorecardRequest) (*domains.Scorecard, error) {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	scorecardId, err := primitive.ObjectIDFromHex(req.ScorecardID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	current, err := s.scorecardRepo.Get(ctx, scorecardId)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if current == nil || current.AppointmentID != id {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Scorecard not found.")
+	}
+	if current.InterviewerID.Hex() != req.UserID {
+		return nil, helpers.NewCustomError(http.StatusForbidden, "You can only update your own scorecard")
+	}
+	if !time.Now().Before(current.LockedAt) {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Scorecard is locked")
+	}
+	template, err := s.scorecardTemplateRepo.Get(ctx, current.TemplateID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if template == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "Scorecard template not found.")
+	}
+	ratings, answers, err := checkScorecard(template, req.Ratings, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+	params := &domains.UpdateScorecardParams{
+		ID:             scorecardId,
+		Ratings:        ratings,
+		Answers:        answers,
+		Recommendation: req.Recommendation,
+	}
+	data, err := s.scorecardRepo.Update(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Scorecard is locked")
+	}
+	if changes := diffScorecard(current, data); len(changes) > 0 {
+		recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+			ActorID:       current.InterviewerID,
+			Action:        constants.AUDIT_SCORECARD_UPDATE,
+			TargetType:    constants.AUDIT_TARGET_SCORECARD,
+			TargetID:      scorecardId,
+			AppointmentID: id,
+			Changes:       changes,
+			RequestID:     req.RequestID,
+			IPAddress:     req.ClientIP,
+		})
+	}
+	return data, nil
+}
+
+// diffScorecard lists the feedback fields of a scorecard.
+func diffScorecard(before *domains.Scorecard, after *domains.Scorecard) []domains.AuditChange {
+	diff := auditDiff{}
+	diff.add("ratings", before.Ratings, after.Ratings)
+	diff.add("answers", before.Answers, after.Answers)
+	diff.add("recommendation", before.Recommendation, after.Recommendation)
+	return diff
+}
+
+// checkScorecard matches the feedback against the template: every
+// competency is rated within the scale and every required question is
+// answered. Blank answers are dropped.
+func checkScorecard(template *domains.ScorecardTemplate, ratings []dto.ScorecardRating, answers []dto.ScorecardAnswer) ([]domains.ScorecardRating, []domains.ScorecardAnswer, error) {
+	scale := template.RatingScale
+	byCompetency := map[string]dto.ScorecardRating{}
+	for _, rating := range ratings {
+		byCompetency[rating.Competency] = rating
+	}
+	resRatings := make([]domains.ScorecardRating, 0, len(template.Competencies))
+	for _, competency := range template.Competencies {
+		rating, ok := byCompetency[competency.Name]
+		if !ok {
+			return nil, nil, helpers.NewCustomError(http.StatusBadRequest, "ratings: "+competency.Name+" is not rated")
+		}
+		if rating.Score < scale.Min || rating.Score > scale.Max {
+			return nil, nil, helpers.NewCustomError(http.StatusBadRequest, "ratings: "+competency.Name+" must be between "+strconv.Itoa(scale.Min)+" and "+strconv.Itoa(scale.Max))
+		}
+		delete(byCompetency, competency.Name)
+		resRatings = append(resRatings, domains.ScorecardRating{
+			Competency: competency.Name,
+			Score:      rating.Score,
+			Note:       rating.Note,
+		})
+	}
+	for _, rating := range ratings {
+		if _, ok := byCompetency[rating.Competency]; ok {
+			return nil, nil, helpers.NewCustomError(http.StatusBadRequest, "ratings: Unknown competency "+strconv.Quote(rating.Competency))
+		}
+	}
+	byQuestion := map[string]string{}
+	for _, answer := range answers {
+		if findScorecardQuestion(template, answer.QuestionID) == nil {
+			return nil, nil, helpers.NewCustomError(http.StatusBadRequest, "answers: Unknown question "+answer.QuestionID)
+		}
+		byQuestion[answer.QuestionID] = answer.Answer
+	}
+	resAnswers := []domains.ScorecardAnswer{}
+	for _, question := range template.Questions {
+		answer := byQuestion[question.ID.Hex()]
+		if answer == "" {
+			if question.Required {
+				return nil, nil, helpers.NewCustomError(http.StatusBadRequest, "answers: "+strconv.Quote(question.Text)+" is required")
+			}
+			continue
+		}
+		resAnswers = append(resAnswers, domains.ScorecardAnswer{QuestionID: question.ID, Answer: answer})
+	}
+	return resRatings, resAnswers, nil
+}
+
+func findScorecardQuestion(template *domains.ScorecardTemplate, id string) *domains.ScorecardQuestion {
+	for i := range template.Questions {
+		if template.Questions[i].ID.Hex() == id {
+			return &template.Questions[i]
+		}
+	}
+	return nil
+}
+
+func isAssignedInterviewer(appointment *domains.InterviewAppointment, userId primitive.ObjectID) bool {
+	for _, interviewerId := range appointment.InterviewerIDs {
+		if interviewerId == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// summarizeScorecards aggregates the scorecards of an appointment per
+// competency of its template. It is nil when the appointment has no template.
+func summarizeScorecards(data *domains.InterviewAppointment) *domains.ScorecardSummary {
+	template := data.ScorecardTemplate
+	if template == nil || template.ID.IsZero() {
+		return nil
+	}
+	recommendations := map[string]int{}
+	for _, recommendation := range constants.RECOMMENDATIONS {
+		recommendations[recommendation] = 0
+	}
+	totals := map[string]int{}
+	counts := map[string]int{}
+	total, count := 0, 0
+	for _, scorecard := range data.Scorecards {
+		recommendations[scorecard.Recommendation]++
+		for _, rating := range scorecard.Ratings {
+			totals[rating.Competency] += rating.Score
+			counts[rating.Competency]++
+			total += rating.Score
+			count++
+		}
+	}
+	competencies := make([]domains.CompetencyScore, len(template.Competencies))
+	for i, competency := range template.Competencies {
+		competencies[i] = domains.CompetencyScore{
+			Competency:   competency.Name,
+			AverageScore: averageScore(totals[competency.Name], counts[competency.Name]),
+			Ratings:      counts[competency.Name],
+		}
+	}
+	return &domains.ScorecardSummary{
+		TemplateID:      template.ID,
+		TemplateName:    template.Name,
+		RatingScale:     template.RatingScale,
+		Submitted:       len(data.Scorecards),
+		Expected:        len(data.InterviewerIDs),
+		AverageScore:    averageScore(total, count),
+		Competencies:    competencies,
+		Recommendations: recommendations,
+	}
+}
+
+// averageScore rounds to two decimals, or returns nil without any score.
+func averageScore(total int, count int) *float64 {
+	if count == 0 {
+		return nil
+	}
+	average := math.Round(float64(total)/float64(count)*100) / 100
+	return &average
+}
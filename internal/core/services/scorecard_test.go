@@ -0,0 +1,488 @@
+package services_test
+
+import (
+	"errors"
+	"net/http"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/core/services"
+	"robinhood-assignment/internal/dto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testScorecardService struct {
+	scorecardTemplateRepo    *mocks.ScorecardTemplateRepository
+	scorecardRepo            *mocks.ScorecardRepository
+	interviewAppointmentRepo *mocks.InterviewAppointmentRepository
+	interviewCommentRepo     *mocks.InterviewCommentRepository
+	auditEventRepo           *mocks.AuditEventRepository
+	service                  ports.ScorecardService
+}
+
+func newTestScorecardService(t *testing.T) testScorecardService {
+	scorecardTemplateRepo := mocks.NewScorecardTemplateRepository(t)
+	scorecardRepo := mocks.NewScorecardRepository(t)
+	interviewAppointmentRepo := mocks.NewInterviewAppointmentRepository(t)
+	interviewCommentRepo := mocks.NewInterviewCommentRepository(t)
+	auditEventRepo := mocks.NewAuditEventRepository(t)
+
+	service := services.NewScorecardService(scorecardTemplateRepo, scorecardRepo, interviewAppointmentRepo, interviewCommentRepo, auditEventRepo)
+	return testScorecardService{scorecardTemplateRepo, scorecardRepo, interviewAppointmentRepo, interviewCommentRepo, auditEventRepo, service}
+}
+
+var (
+	mockQuestionId        = primitive.NewObjectID()
+	mockScorecardTemplate = domains.ScorecardTemplate{
+		ID:   primitive.NewObjectID(),
+		Name: "Backend onsite",
+		Competencies: []domains.ScorecardCompetency{
+			{Name: "Coding"},
+			{Name: "System design"},
+		},
+		RatingScale: domains.ScorecardRatingScale{Min: 1, Max: 4},
+		Questions: []domains.ScorecardQuestion{
+			{ID: mockQuestionId, Text: "What stood out?", Required: true},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	interviewerId = primitive.NewObjectID()
+)
+
+func scorecardAppointment() *domains.InterviewAppointment {
+	appointment := mockInterviewAppointment1
+	appointment.InterviewerIDs = []primitive.ObjectID{interviewerId}
+	appointment.ScorecardTemplateID = mockScorecardTemplate.ID
+	appointment.ScorecardTemplate = &mockScorecardTemplate
+	return &appointment
+}
+
+func validSubmitScorecardRequest() *dto.SubmitScorecardRequest {
+	return &dto.SubmitScorecardRequest{
+		ID: mockInterviewAppointment1.ID.Hex(),
+		Ratings: []dto.ScorecardRating{
+			{Competency: "System design", Score: 3},
+			{Competency: "Coding", Score: 4, Note: "Clean code"},
+		},
+		Answers: []dto.ScorecardAnswer{
+			{QuestionID: mockQuestionId.Hex(), Answer: "Good questions"},
+		},
+		Recommendation: constants.RECOMMENDATION_YES,
+		UserID:         interviewerId.Hex(),
+	}
+}
+
+func TestCreateScorecardTemplate(t *testing.T) {
+	req := &dto.CreateScorecardTemplateRequest{
+		Name:         "Backend onsite",
+		Competencies: []dto.ScorecardCompetency{{Name: "Coding"}, {Name: "System design"}},
+		RatingScale:  &dto.ScorecardRatingScale{Min: 1, Max: 4},
+		Questions:    []dto.ScorecardQuestion{{Text: "What stood out?", Required: true}},
+		CreatedBy:    adminId.Hex(),
+	}
+	params := &domains.CreateScorecardTemplateParams{
+		Name:         "Backend onsite",
+		Competencies: []domains.ScorecardCompetency{{Name: "Coding"}, {Name: "System design"}},
+		RatingScale:  domains.ScorecardRatingScale{Min: 1, Max: 4},
+		Questions:    []domains.ScorecardQuestion{{Text: "What stood out?", Required: true}},
+		CreatedBy:    adminId,
+	}
+	t.Run("create scorecard template success", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		audit := &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_TEMPLATE_CREATE,
+			TargetType: constants.AUDIT_TARGET_TEMPLATE,
+			TargetID:   mockScorecardTemplate.ID,
+			Changes: []domains.AuditChange{
+				{Field: "name", After: mockScorecardTemplate.Name},
+				{Field: "competencies", After: mockScorecardTemplate.Competencies},
+				{Field: "ratingScale", After: mockScorecardTemplate.RatingScale},
+				{Field: "questions", After: mockScorecardTemplate.Questions},
+			},
+		}
+		tsvc.scorecardTemplateRepo.On("Create", ctx, params).Return(&mockScorecardTemplate, nil)
+		tsvc.auditEventRepo.On("Create", ctx, audit).Return(nil)
+		got, err := tsvc.service.CreateScorecardTemplate(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &mockScorecardTemplate, got)
+	})
+	t.Run("create scorecard template error", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		tsvc.scorecardTemplateRepo.On("Create", ctx, params).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.CreateScorecardTemplate(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestArchiveScorecardTemplate(t *testing.T) {
+	req := &dto.ArchiveScorecardTemplateRequest{ID: mockScorecardTemplate.ID.Hex(), UserID: adminId.Hex()}
+	t.Run("archive scorecard template success", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		expected := mockScorecardTemplate
+		expected.IsArchived = true
+		audit := &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_TEMPLATE_ARCHIVE,
+			TargetType: constants.AUDIT_TARGET_TEMPLATE,
+			TargetID:   mockScorecardTemplate.ID,
+			Changes:    []domains.AuditChange{{Field: "isArchived", Before: false, After: true}},
+		}
+		tsvc.scorecardTemplateRepo.On("Get", ctx, mockScorecardTemplate.ID).Return(&mockScorecardTemplate, nil)
+		tsvc.scorecardTemplateRepo.On("Archive", ctx, mockScorecardTemplate.ID).Return(&expected, nil)
+		tsvc.auditEventRepo.On("Create", ctx, audit).Return(nil)
+		got, err := tsvc.service.ArchiveScorecardTemplate(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, got)
+	})
+	t.Run("archive scorecard template already archived is not audited", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		expected := mockScorecardTemplate
+		expected.IsArchived = true
+		tsvc.scorecardTemplateRepo.On("Get", ctx, mockScorecardTemplate.ID).Return(&expected, nil)
+		tsvc.scorecardTemplateRepo.On("Archive", ctx, mockScorecardTemplate.ID).Return(&expected, nil)
+		got, err := tsvc.service.ArchiveScorecardTemplate(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, got)
+	})
+	t.Run("archive scorecard template error when not found", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		tsvc.scorecardTemplateRepo.On("Get", ctx, mockScorecardTemplate.ID).Return(nil, nil)
+		got, err := tsvc.service.ArchiveScorecardTemplate(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusNotFound, "Scorecard template not found."), err)
+	})
+	t.Run("archive scorecard template error when archive fail", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		tsvc.scorecardTemplateRepo.On("Get", ctx, mockScorecardTemplate.ID).Return(&mockScorecardTemplate, nil)
+		tsvc.scorecardTemplateRepo.On("Archive", ctx, mockScorecardTemplate.ID).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.ArchiveScorecardTemplate(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestGetScorecards(t *testing.T) {
+	t.Run("get scorecards success", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		expected := []domains.Scorecard{{ID: primitive.NewObjectID(), AppointmentID: mockInterviewAppointment1.ID}}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(scorecardAppointment(), nil)
+		tsvc.scorecardRepo.On("GetByAppointment", ctx, mockInterviewAppointment1.ID).Return(expected, nil)
+		got, err := tsvc.service.GetScorecards(ctx, getScorecardsRequest(constants.INTERVIEWER_ROLE))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get scorecards empty when blind feedback is hidden", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		appointment := scorecardAppointment()
+		appointment.BlindFeedback = true
+		appointment.Scorecards = []domains.Scorecard{{ID: primitive.NewObjectID(), InterviewerID: primitive.NewObjectID()}}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(appointment, nil)
+		got, err := tsvc.service.GetScorecards(ctx, getScorecardsRequest(constants.INTERVIEWER_ROLE))
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("get scorecards error when appointment not found", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(nil, nil)
+		got, err := tsvc.service.GetScorecards(ctx, getScorecardsRequest(constants.INTERVIEWER_ROLE))
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found."), err)
+	})
+}
+
+func getScorecardsRequest(role string) *dto.GetScorecardsRequest {
+	return &dto.GetScorecardsRequest{ID: mockInterviewAppointment1.ID.Hex(), UserID: interviewerId.Hex(), Role: role}
+}
+
+func TestSubmitScorecard(t *testing.T) {
+	t.Setenv("SCORECARD_LOCK_WINDOW", "2h")
+	config.New()
+	t.Run("submit scorecard success", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		var got *domains.CreateScorecardParams
+		expected := &domains.Scorecard{ID: primitive.NewObjectID(), Recommendation: constants.RECOMMENDATION_YES}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(scorecardAppointment(), nil)
+		tsvc.scorecardRepo.On("Create", ctx, mock.MatchedBy(func(params *domains.CreateScorecardParams) bool {
+			got = params
+			return true
+		})).Return(expected, nil)
+		tsvc.auditEventRepo.On("Create", ctx, &domains.CreateAuditEventParams{
+			ActorID:       interviewerId,
+			Action:        constants.AUDIT_SCORECARD_SUBMIT,
+			TargetType:    constants.AUDIT_TARGET_SCORECARD,
+			TargetID:      expected.ID,
+			AppointmentID: mockInterviewAppointment1.ID,
+			Changes:       []domains.AuditChange{{Field: "recommendation", After: constants.RECOMMENDATION_YES}},
+		}).Return(nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, validSubmitScorecardRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, expected, data)
+		assert.Equal(t, mockScorecardTemplate.ID, got.TemplateID)
+		assert.Equal(t, interviewerId, got.InterviewerID)
+		assert.Equal(t, []domains.ScorecardRating{
+			{Competency: "Coding", Score: 4, Note: "Clean code"},
+			{Competency: "System design", Score: 3},
+		}, got.Ratings)
+		assert.Equal(t, []domains.ScorecardAnswer{{QuestionID: mockQuestionId, Answer: "Good questions"}}, got.Answers)
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), got.LockedAt, time.Minute)
+	})
+	t.Run("submit scorecard error when not assigned", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		req := validSubmitScorecardRequest()
+		req.UserID = adminId.Hex()
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(scorecardAppointment(), nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, req)
+		assert.Nil(t, data)
+		assert.Equal(t, helpers.NewCustomError(http.StatusForbidden, "Only assigned interviewers can submit a scorecard"), err)
+	})
+	t.Run("submit scorecard error when appointment has no template", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		appointment := scorecardAppointment()
+		appointment.ScorecardTemplate = nil
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(appointment, nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, validSubmitScorecardRequest())
+		assert.Nil(t, data)
+		assert.Equal(t, helpers.NewCustomError(http.StatusConflict, "Interview appointment has no scorecard template"), err)
+	})
+	t.Run("submit scorecard error when competency not rated", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		req := validSubmitScorecardRequest()
+		req.Ratings = req.Ratings[1:]
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(scorecardAppointment(), nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, req)
+		assert.Nil(t, data)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "ratings: System design is not rated"), err)
+	})
+	t.Run("submit scorecard error when score out of scale", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		req := validSubmitScorecardRequest()
+		req.Ratings[1].Score = 5
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(scorecardAppointment(), nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, req)
+		assert.Nil(t, data)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "ratings: Coding must be between 1 and 4"), err)
+	})
+	t.Run("submit scorecard error when competency unknown", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		req := validSubmitScorecardRequest()
+		req.Ratings = append(req.Ratings, dto.ScorecardRating{Competency: "Culture", Score: 2})
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(scorecardAppointment(), nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, req)
+		assert.Nil(t, data)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, `ratings: Unknown competency "Culture"`), err)
+	})
+	t.Run("submit scorecard error when required question not answered", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		req := validSubmitScorecardRequest()
+		req.Answers = []dto.ScorecardAnswer{}
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(scorecardAppointment(), nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, req)
+		assert.Nil(t, data)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, `answers: "What stood out?" is required`), err)
+	})
+	t.Run("submit scorecard error when question unknown", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		req := validSubmitScorecardRequest()
+		unknownId := primitive.NewObjectID().Hex()
+		req.Answers = append(req.Answers, dto.ScorecardAnswer{QuestionID: unknownId, Answer: "x"})
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(scorecardAppointment(), nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, req)
+		assert.Nil(t, data)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "answers: Unknown question "+unknownId), err)
+	})
+	t.Run("submit scorecard error when already submitted", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(scorecardAppointment(), nil)
+		tsvc.scorecardRepo.On("Create", ctx, mock.Anything).Return(nil, nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, validSubmitScorecardRequest())
+		assert.Nil(t, data)
+		assert.Equal(t, helpers.NewCustomError(http.StatusConflict, "You have already submitted a scorecard for this interview appointment"), err)
+	})
+	t.Run("submit scorecard error when appointment not found", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, mockInterviewAppointment1.ID).Return(nil, nil)
+		data, err := tsvc.service.SubmitScorecard(ctx, validSubmitScorecardRequest())
+		assert.Nil(t, data)
+		assert.Equal(t, helpers.NewCustomError(http.StatusNotFound, "Interview appointment not found."), err)
+	})
+}
+
+func TestUpdateScorecard(t *testing.T) {
+	scorecardId := primitive.NewObjectID()
+	submitted := validSubmitScorecardRequest()
+	req := &dto.UpdateScorecardRequest{
+		ID:             mockInterviewAppointment1.ID.Hex(),
+		ScorecardID:    scorecardId.Hex(),
+		Ratings:        submitted.Ratings,
+		Answers:        submitted.Answers,
+		Recommendation: constants.RECOMMENDATION_STRONG_YES,
+		UserID:         interviewerId.Hex(),
+	}
+	current := domains.Scorecard{
+		ID:            scorecardId,
+		AppointmentID: mockInterviewAppointment1.ID,
+		TemplateID:    mockScorecardTemplate.ID,
+		InterviewerID: interviewerId,
+		LockedAt:      time.Now().Add(time.Hour),
+	}
+	t.Run("update scorecard success", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		params := &domains.UpdateScorecardParams{
+			ID: scorecardId,
+			Ratings: []domains.ScorecardRating{
+				{Competency: "Coding", Score: 4, Note: "Clean code"},
+				{Competency: "System design", Score: 3},
+			},
+			Answers:        []domains.ScorecardAnswer{{QuestionID: mockQuestionId, Answer: "Good questions"}},
+			Recommendation: constants.RECOMMENDATION_STRONG_YES,
+		}
+		expected := current
+		expected.Recommendation = constants.RECOMMENDATION_STRONG_YES
+		tsvc.scorecardRepo.On("Get", ctx, scorecardId).Return(&current, nil)
+		tsvc.scorecardTemplateRepo.On("Get", ctx, mockScorecardTemplate.ID).Return(&mockScorecardTemplate, nil)
+		tsvc.scorecardRepo.On("Update", ctx, params).Return(&expected, nil)
+		tsvc.auditEventRepo.On("Create", ctx, &domains.CreateAuditEventParams{
+			ActorID:       interviewerId,
+			Action:        constants.AUDIT_SCORECARD_UPDATE,
+			TargetType:    constants.AUDIT_TARGET_SCORECARD,
+			TargetID:      scorecardId,
+			AppointmentID: mockInterviewAppointment1.ID,
+			Changes:       []domains.AuditChange{{Field: "recommendation", After: constants.RECOMMENDATION_STRONG_YES}},
+		}).Return(nil)
+		got, err := tsvc.service.UpdateScorecard(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, got)
+	})
+	t.Run("update scorecard error when locked", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		locked := current
+		locked.LockedAt = time.Now().Add(-time.Minute)
+		tsvc.scorecardRepo.On("Get", ctx, scorecardId).Return(&locked, nil)
+		got, err := tsvc.service.UpdateScorecard(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusConflict, "Scorecard is locked"), err)
+	})
+	t.Run("update scorecard error when locked during update", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		tsvc.scorecardRepo.On("Get", ctx, scorecardId).Return(&current, nil)
+		tsvc.scorecardTemplateRepo.On("Get", ctx, mockScorecardTemplate.ID).Return(&mockScorecardTemplate, nil)
+		tsvc.scorecardRepo.On("Update", ctx, mock.Anything).Return(nil, nil)
+		got, err := tsvc.service.UpdateScorecard(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusConflict, "Scorecard is locked"), err)
+	})
+	t.Run("update scorecard error when not owner", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		other := *req
+		other.UserID = adminId.Hex()
+		tsvc.scorecardRepo.On("Get", ctx, scorecardId).Return(&current, nil)
+		got, err := tsvc.service.UpdateScorecard(ctx, &other)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusForbidden, "You can only update your own scorecard"), err)
+	})
+	t.Run("update scorecard error when on another appointment", func(t *testing.T) {
+		tsvc := newTestScorecardService(t)
+		moved := current
+		moved.AppointmentID = primitive.NewObjectID()
+		tsvc.scorecardRepo.On("Get", ctx, scorecardId).Return(&moved, nil)
+		got, err := tsvc.service.UpdateScorecard(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusNotFound, "Scorecard not found."), err)
+	})
+}
+
+func TestScorecardSummary(t *testing.T) {
+	template := domains.ScorecardTemplate{
+		ID:           primitive.NewObjectID(),
+		Name:         "Backend onsite",
+		Competencies: []domains.ScorecardCompetency{{Name: "Coding"}, {Name: "System design"}, {Name: "Culture"}},
+		RatingScale:  domains.ScorecardRatingScale{Min: 1, Max: 4},
+	}
+	interviewerIds := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()}
+	newAppointment := func() domains.InterviewAppointment {
+		data := mockInterviewAppointment1
+		data.InterviewerIDs = interviewerIds
+		data.ScorecardTemplateID = template.ID
+		data.ScorecardTemplate = &template
+		data.Scorecards = []domains.Scorecard{
+			{
+				InterviewerID:  interviewerIds[0],
+				Ratings:        []domains.ScorecardRating{{Competency: "Coding", Score: 4}, {Competency: "System design", Score: 2}},
+				Recommendation: "STRONG_YES",
+			},
+			{
+				InterviewerID:  interviewerIds[1],
+				Ratings:        []domains.ScorecardRating{{Competency: "Coding", Score: 3}, {Competency: "System design", Score: 2}},
+				Recommendation: "YES",
+			},
+		}
+		return data
+	}
+	average := func(v float64) *float64 { return &v }
+	getInterviewAppointment := func(t *testing.T, data *domains.InterviewAppointment, req *dto.GetInterviewAppointmentRequest) *domains.InterviewAppointment {
+		tsvc := newTestInterviewService(t)
+		tsvc.interviewAppointmentRepo.On("Get", ctx, data.ID).Return(data, nil)
+		tsvc.interviewCommentRepo.On("GetByAppointment", ctx, mock.Anything).Return([]domains.InterviewComment{}, nil)
+		got, err := tsvc.service.GetInterviewAppointment(ctx, req)
+		assert.NoError(t, err)
+		return got
+	}
+	t.Run("scorecard summary averages scores per competency", func(t *testing.T) {
+		data := newAppointment()
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: primitive.NewObjectID().Hex(), Role: constants.ADMIN_ROLE}
+		expected := &domains.ScorecardSummary{
+			TemplateID:   template.ID,
+			TemplateName: "Backend onsite",
+			RatingScale:  domains.ScorecardRatingScale{Min: 1, Max: 4},
+			Submitted:    2,
+			Expected:     3,
+			AverageScore: average(2.75),
+			Competencies: []domains.CompetencyScore{
+				{Competency: "Coding", AverageScore: average(3.5), Ratings: 2},
+				{Competency: "System design", AverageScore: average(2), Ratings: 2},
+				{Competency: "Culture", Ratings: 0},
+			},
+			Recommendations: map[string]int{"STRONG_NO": 0, "NO": 0, "YES": 1, "STRONG_YES": 1},
+		}
+		got := getInterviewAppointment(t, &data, req)
+		assert.Equal(t, expected, got.ScorecardSummary)
+	})
+	t.Run("scorecard summary without scorecards has no averages", func(t *testing.T) {
+		data := newAppointment()
+		data.Scorecards = nil
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: primitive.NewObjectID().Hex(), Role: constants.ADMIN_ROLE}
+		got := getInterviewAppointment(t, &data, req)
+		assert.Equal(t, 0, got.ScorecardSummary.Submitted)
+		assert.Nil(t, got.ScorecardSummary.AverageScore)
+		for _, competency := range got.ScorecardSummary.Competencies {
+			assert.Nil(t, competency.AverageScore)
+		}
+	})
+	t.Run("scorecard summary leaves out hidden scorecards", func(t *testing.T) {
+		data := newAppointment()
+		data.BlindFeedback = true
+		data.HiringManagerID = primitive.NewObjectID()
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: interviewerIds[2].Hex(), Role: constants.INTERVIEWER_ROLE}
+		got := getInterviewAppointment(t, &data, req)
+		assert.True(t, got.FeedbackHidden)
+		assert.Equal(t, 0, got.ScorecardSummary.Submitted)
+		assert.Equal(t, 3, got.ScorecardSummary.Expected)
+	})
+	t.Run("scorecard summary is nil without template", func(t *testing.T) {
+		data := mockInterviewAppointment1
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: primitive.NewObjectID().Hex(), Role: constants.ADMIN_ROLE}
+		got := getInterviewAppointment(t, &data, req)
+		assert.Nil(t, got.ScorecardSummary)
+	})
+}
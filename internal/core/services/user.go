@@ -0,0 +1,380 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type userService struct {
+	userRepo         ports.UserRepository
+	refreshTokenRepo ports.RefreshTokenRepository
+	loginAttemptRepo ports.LoginAttemptRepository
+	auditEventRepo   ports.AuditEventRepository
+	myBcrypt         ports.MyBcrypt
+}
+
+func NewUserService(userRepo ports.UserRepository, refreshTokenRepo ports.RefreshTokenRepository, loginAttemptRepo ports.LoginAttemptRepository, auditEventRepo ports.AuditEventRepository, myBcrypt ports.MyBcrypt) ports.UserService {
+	return &userService{
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		loginAttemptRepo: loginAttemptRepo,
+		auditEventRepo:   auditEventRepo,
+		myBcrypt:         myBcrypt,
+	}
+}
+
+func (s *userService) GetUsers(ctx context.Context, req *dto.GetUsersRequest) ([]domains.User, error) {
+	params := &domains.GetUsersParams{
+		Search: req.Search,
+		Role:   req.Role,
+		Offset: (req.Page - 1) * req.Limit,
+		Limit:  req.Limit + 1,
+	}
+	if req.Status != "" {
+		isDeactivated := req.Status == "DEACTIVATED"
+		params.IsDeactivated = &isDeactivated
+	}
+	data, err := s.userRepo.GetAll(ctx, params)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusInternalServerError, "Cannot get users.")
+	}
+	return data, nil
+}
+
+func (s *userService) GetUser(ctx context.Context, id string) (*domains.User, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	data, err := s.userRepo.Get(ctx, objID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "User not found.")
+	}
+	return data, nil
+}
+
+func (s *userService) UpdateUser(ctx context.Context, req *dto.UpdateUserRequest) (*domains.User, error) {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	current, err := s.GetUser(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	params := &domains.UpdateUserParams{
+		ID:       id,
+		Name:     req.Name,
+		Email:    req.Email,
+		ImageUrl: req.ImageUrl,
+	}
+	data, err := s.userRepo.Update(ctx, params)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if data == nil {
+		return nil, helpers.NewCustomError(http.StatusNotFound, "User not found.")
+	}
+	diff := auditDiff{}
+	diff.add("name", current.Name, data.Name)
+	diff.add("email", current.Email, data.Email)
+	diff.add("imageUrl", current.ImageUrl, data.ImageUrl)
+	if len(diff) > 0 {
+		recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+			ActorID:    userId,
+			Action:     constants.AUDIT_USER_UPDATE,
+			TargetType: constants.AUDIT_TARGET_USER,
+			TargetID:   id,
+			Changes:    diff,
+			RequestID:  req.RequestID,
+			IPAddress:  req.ClientIP,
+		})
+	}
+	return data, nil
+}
+
+func (s *userService) UpdateUserRole(ctx context.Context, req *dto.UpdateUserRoleRequest) error {
+	if req.ID == req.UserID {
+		return helpers.NewCustomError(http.StatusBadRequest, "You cannot change your own role")
+	}
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	current, err := s.GetUser(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	params := &domains.UpdateUserParams{
+		ID:   id,
+		Role: req.Role,
+	}
+	data, err := s.userRepo.Update(ctx, params)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if data == nil {
+		return helpers.NewCustomError(http.StatusNotFound, "User not found.")
+	}
+	diff := auditDiff{}
+	diff.add("role", current.Role, data.Role)
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    userId,
+		Action:     constants.AUDIT_USER_ROLE_UPDATE,
+		TargetType: constants.AUDIT_TARGET_USER,
+		TargetID:   id,
+		Changes:    diff,
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return nil
+}
+
+func (s *userService) DeactivateUser(ctx context.Context, req *dto.UpdateUserStatusRequest) error {
+	if req.ID == req.UserID {
+		return helpers.NewCustomError(http.StatusBadRequest, "You cannot deactivate your own account")
+	}
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if err := s.setDeactivated(ctx, req, true); err != nil {
+		return err
+	}
+	if err := s.refreshTokenRepo.RevokeUserSessions(ctx, id, primitive.NilObjectID); err != nil {
+		return helpers.InternalError
+	}
+	return nil
+}
+
+func (s *userService) ReactivateUser(ctx context.Context, req *dto.UpdateUserStatusRequest) error {
+	return s.setDeactivated(ctx, req, false)
+}
+
+func (s *userService) UnlockUser(ctx context.Context, req *dto.UpdateUserStatusRequest) error {
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	user, err := s.GetUser(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	// The IP guards the user's failures were counted against are cleared as
+	// well, or the user could stay locked out from their network.
+	key := usernameAttemptKey(user.Username)
+	attempt, err := s.loginAttemptRepo.Get(ctx, key)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if attempt != nil {
+		for _, ip := range attempt.ClientIPs {
+			if err := s.loginAttemptRepo.Reset(ctx, ipAttemptKey(ip)); err != nil {
+				return helpers.InternalError
+			}
+		}
+	}
+	if err := s.loginAttemptRepo.Reset(ctx, key); err != nil {
+		return helpers.InternalError
+	}
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    userId,
+		Action:     constants.AUDIT_USER_UNLOCK,
+		TargetType: constants.AUDIT_TARGET_USER,
+		TargetID:   user.ID,
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return nil
+}
+
+func (s *userService) ChangePassword(ctx context.Context, req *dto.ChangePasswordRequest) error {
+	id, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	sessionID, err := primitive.ObjectIDFromHex(req.SessionID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	user, err := s.userRepo.Get(ctx, id)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if user == nil {
+		return helpers.NewCustomError(http.StatusNotFound, "User not found.")
+	}
+	if err := s.myBcrypt.CompareHashAndPassword(user.Password, req.OldPassword); err != nil {
+		return helpers.NewCustomError(http.StatusBadRequest, "Old password is incorrect")
+	}
+	passHash, err := s.myBcrypt.GenerateFromPassword(req.NewPassword, config.Get().Auth.BcryptCost)
+	if err != nil {
+		return helpers.InternalError
+	}
+	params := &domains.UpdateUserParams{
+		ID:       id,
+		Password: *passHash,
+	}
+	data, err := s.userRepo.Update(ctx, params)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if data == nil {
+		return helpers.NewCustomError(http.StatusNotFound, "User not found.")
+	}
+	if err := s.refreshTokenRepo.RevokeUserSessions(ctx, id, sessionID); err != nil {
+		return helpers.InternalError
+	}
+	return nil
+}
+
+func (s *userService) EnrollMFA(ctx context.Context, userID string) (*domains.TOTPEnrollment, error) {
+	user, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return startTOTPEnrollment(ctx, s.userRepo, user)
+}
+
+func (s *userService) ConfirmMFA(ctx context.Context, req *dto.MFACodeRequest) ([]string, error) {
+	user, err := s.GetUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return confirmTOTPEnrollment(ctx, s.userRepo, user, req.Code)
+}
+
+func (s *userService) DisableMFA(ctx context.Context, req *dto.MFACodeRequest) error {
+	user, err := s.GetUser(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+	if !user.MFAEnabled {
+		return helpers.NewCustomError(http.StatusBadRequest, "Two-factor authentication is not enabled")
+	}
+	if config.Get().Auth.MFARequiredForAdmin && user.Role == constants.ADMIN_ROLE {
+		return helpers.NewCustomError(http.StatusBadRequest, "Two-factor authentication is required for admins")
+	}
+	method, err := verifySecondFactor(ctx, s.userRepo, user, req.Code)
+	if err != nil {
+		return err
+	}
+	if err := s.userRepo.DisableTOTP(ctx, user.ID); err != nil {
+		return helpers.InternalError
+	}
+	diff := auditDiff{}
+	diff.add("mfaEnabled", true, false)
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    user.ID,
+		Action:     constants.AUDIT_USER_MFA_DISABLE,
+		TargetType: constants.AUDIT_TARGET_USER,
+		TargetID:   user.ID,
+		Changes:    diff,
+		Metadata:   map[string]string{"method": method},
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return nil
+}
+
+// CreateServiceAccount creates a user without a password. Service accounts
+// cannot log in and only authenticate with API keys.
+func (s *userService) CreateServiceAccount(ctx context.Context, req *dto.CreateServiceAccountRequest) (*domains.User, error) {
+	adminId, err := primitive.ObjectIDFromHex(req.CreatedBy)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	user, err := s.userRepo.GetByUsername(ctx, req.Username)
+	if err != nil {
+		return nil, helpers.InternalError
+	}
+	if user != nil {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Duplicate username")
+	}
+	params := &domains.CreateUserParams{
+		Name:             req.Name,
+		Username:         req.Username,
+		Role:             req.Role,
+		IsServiceAccount: true,
+	}
+	data, err := s.userRepo.Create(ctx, params)
+	if err != nil {
+		return nil, helpers.NewCustomError(http.StatusConflict, "Create service account fail")
+	}
+	diff := auditDiff{}
+	diff.add("name", nil, req.Name)
+	diff.add("username", nil, req.Username)
+	diff.add("role", nil, req.Role)
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    adminId,
+		Action:     constants.AUDIT_SERVICE_ACCOUNT_CREATE,
+		TargetType: constants.AUDIT_TARGET_USER,
+		TargetID:   data.ID,
+		Changes:    diff,
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return data, nil
+}
+
+// setDeactivated deactivates or reactivates the user in req and records it.
+func (s *userService) setDeactivated(ctx context.Context, req *dto.UpdateUserStatusRequest, isDeactivated bool) error {
+	id, err := primitive.ObjectIDFromHex(req.ID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	userId, err := primitive.ObjectIDFromHex(req.UserID)
+	if err != nil {
+		return helpers.InternalError
+	}
+	current, err := s.GetUser(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	params := &domains.UpdateUserParams{
+		ID:            id,
+		IsDeactivated: &isDeactivated,
+	}
+	data, err := s.userRepo.Update(ctx, params)
+	if err != nil {
+		return helpers.InternalError
+	}
+	if data == nil {
+		return helpers.NewCustomError(http.StatusNotFound, "User not found.")
+	}
+	action := constants.AUDIT_USER_REACTIVATE
+	if isDeactivated {
+		action = constants.AUDIT_USER_DEACTIVATE
+	}
+	diff := auditDiff{}
+	diff.add("isDeactivated", current.IsDeactivated, data.IsDeactivated)
+	recordAudit(ctx, s.auditEventRepo, &domains.CreateAuditEventParams{
+		ActorID:    userId,
+		Action:     action,
+		TargetType: constants.AUDIT_TARGET_USER,
+		TargetID:   id,
+		Changes:    diff,
+		RequestID:  req.RequestID,
+		IPAddress:  req.ClientIP,
+	})
+	return nil
+}
@@ -0,0 +1,584 @@
+package services_test
+
+import (
+	"errors"
+	"net/http"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/core/services"
+	"robinhood-assignment/internal/dto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testUserService struct {
+	userRepo         *mocks.UserRepository
+	refreshTokenRepo *mocks.RefreshTokenRepository
+	loginAttemptRepo *mocks.LoginAttemptRepository
+	auditEventRepo   *mocks.AuditEventRepository
+	myBcrypt         *mocks.MyBcrypt
+	service          ports.UserService
+}
+
+func newTestUserService(t *testing.T) testUserService {
+	userRepo := mocks.NewUserRepository(t)
+	refreshTokenRepo := mocks.NewRefreshTokenRepository(t)
+	loginAttemptRepo := mocks.NewLoginAttemptRepository(t)
+	auditEventRepo := mocks.NewAuditEventRepository(t)
+	myBcrypt := mocks.NewMyBcrypt(t)
+
+	service := services.NewUserService(userRepo, refreshTokenRepo, loginAttemptRepo, auditEventRepo, myBcrypt)
+	return testUserService{userRepo, refreshTokenRepo, loginAttemptRepo, auditEventRepo, myBcrypt, service}
+}
+
+var adminId = primitive.NewObjectID()
+
+func TestGetUsers(t *testing.T) {
+	t.Run("get users success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.GetUsersRequest{
+			Page:   2,
+			Limit:  10,
+			Search: "sam",
+			Role:   constants.STAFF_ROLE,
+			Status: "DEACTIVATED",
+		}
+		isDeactivated := true
+		params := &domains.GetUsersParams{
+			Search:        "sam",
+			Role:          constants.STAFF_ROLE,
+			IsDeactivated: &isDeactivated,
+			Offset:        10,
+			Limit:         11,
+		}
+		expected := []domains.User{user}
+		tsvc.userRepo.On("GetAll", ctx, params).Return(expected, nil)
+		got, err := tsvc.service.GetUsers(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get users error", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.GetUsersRequest{Page: 1, Limit: 20}
+		params := &domains.GetUsersParams{Offset: 0, Limit: 21}
+		expected := helpers.NewCustomError(http.StatusInternalServerError, "Cannot get users.")
+		tsvc.userRepo.On("GetAll", ctx, params).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetUsers(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestGetUser(t *testing.T) {
+	t.Run("get user success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&user, nil)
+		got, err := tsvc.service.GetUser(ctx, user.ID.Hex())
+		assert.NoError(t, err)
+		assert.Equal(t, &user, got)
+	})
+	t.Run("get user error when not found", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		expected := helpers.NewCustomError(http.StatusNotFound, "User not found.")
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(nil, nil)
+		got, err := tsvc.service.GetUser(ctx, user.ID.Hex())
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("get user error when query fail", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.GetUser(ctx, user.ID.Hex())
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestUpdateUser(t *testing.T) {
+	req := &dto.UpdateUserRequest{
+		ID:     user.ID.Hex(),
+		Name:   "New name",
+		UserID: adminId.Hex(),
+	}
+	params := &domains.UpdateUserParams{
+		ID:   user.ID,
+		Name: "New name",
+	}
+	t.Run("update user success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		updated := user
+		updated.Name = "New name"
+		audit := &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_USER_UPDATE,
+			TargetType: constants.AUDIT_TARGET_USER,
+			TargetID:   user.ID,
+			Changes:    []domains.AuditChange{{Field: "name", Before: user.Name, After: "New name"}},
+		}
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&user, nil)
+		tsvc.userRepo.On("Update", ctx, params).Return(&updated, nil)
+		tsvc.auditEventRepo.On("Create", ctx, audit).Return(nil)
+		got, err := tsvc.service.UpdateUser(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &updated, got)
+	})
+	t.Run("update user without changes is not audited", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		updated := user
+		updated.Name = "New name"
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&updated, nil)
+		tsvc.userRepo.On("Update", ctx, params).Return(&updated, nil)
+		got, err := tsvc.service.UpdateUser(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, &updated, got)
+	})
+	t.Run("update user error when not found", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		expected := helpers.NewCustomError(http.StatusNotFound, "User not found.")
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(nil, nil)
+		got, err := tsvc.service.UpdateUser(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update user error when update fail", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&user, nil)
+		tsvc.userRepo.On("Update", ctx, params).Return(nil, errors.New("some error"))
+		got, err := tsvc.service.UpdateUser(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	t.Run("update user role success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.UpdateUserRoleRequest{
+			ID:        user.ID.Hex(),
+			Role:      constants.ADMIN_ROLE,
+			UserID:    adminId.Hex(),
+			RequestID: "req-1",
+			ClientIP:  "10.0.0.1",
+		}
+		params := &domains.UpdateUserParams{
+			ID:   user.ID,
+			Role: constants.ADMIN_ROLE,
+		}
+		promoted := user
+		promoted.Role = constants.ADMIN_ROLE
+		audit := &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_USER_ROLE_UPDATE,
+			TargetType: constants.AUDIT_TARGET_USER,
+			TargetID:   user.ID,
+			Changes:    []domains.AuditChange{{Field: "role", Before: constants.STAFF_ROLE, After: constants.ADMIN_ROLE}},
+			RequestID:  "req-1",
+			IPAddress:  "10.0.0.1",
+		}
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&user, nil)
+		tsvc.userRepo.On("Update", ctx, params).Return(&promoted, nil)
+		tsvc.auditEventRepo.On("Create", ctx, audit).Return(nil)
+		err := tsvc.service.UpdateUserRole(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("update user role error when change own role", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.UpdateUserRoleRequest{
+			ID:     adminId.Hex(),
+			Role:   constants.STAFF_ROLE,
+			UserID: adminId.Hex(),
+		}
+		expected := helpers.NewCustomError(http.StatusBadRequest, "You cannot change your own role")
+		err := tsvc.service.UpdateUserRole(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("update user role error when not found", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.UpdateUserRoleRequest{
+			ID:     user.ID.Hex(),
+			Role:   constants.ADMIN_ROLE,
+			UserID: adminId.Hex(),
+		}
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(nil, nil)
+		err := tsvc.service.UpdateUserRole(ctx, req)
+		assert.Equal(t, helpers.NewCustomError(http.StatusNotFound, "User not found."), err)
+		tsvc.userRepo.AssertNotCalled(t, "Update", ctx, mock.Anything)
+	})
+	t.Run("update user role error when query fail", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.UpdateUserRoleRequest{
+			ID:     user.ID.Hex(),
+			Role:   constants.ADMIN_ROLE,
+			UserID: adminId.Hex(),
+		}
+		params := &domains.UpdateUserParams{
+			ID:   user.ID,
+			Role: constants.ADMIN_ROLE,
+		}
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&user, nil)
+		tsvc.userRepo.On("Update", ctx, params).Return(nil, errors.New("some error"))
+		err := tsvc.service.UpdateUserRole(ctx, req)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestDeactivateUser(t *testing.T) {
+	isDeactivated := true
+	deactivated := user
+	deactivated.IsDeactivated = true
+	audit := &domains.CreateAuditEventParams{
+		ActorID:    adminId,
+		Action:     constants.AUDIT_USER_DEACTIVATE,
+		TargetType: constants.AUDIT_TARGET_USER,
+		TargetID:   user.ID,
+		Changes:    []domains.AuditChange{{Field: "isDeactivated", Before: false, After: true}},
+	}
+	t.Run("deactivate user success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.UpdateUserStatusRequest{
+			ID:     user.ID.Hex(),
+			UserID: adminId.Hex(),
+		}
+		params := &domains.UpdateUserParams{
+			ID:            user.ID,
+			IsDeactivated: &isDeactivated,
+		}
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&user, nil)
+		tsvc.userRepo.On("Update", ctx, params).Return(&deactivated, nil)
+		tsvc.auditEventRepo.On("Create", ctx, audit).Return(nil)
+		tsvc.refreshTokenRepo.On("RevokeUserSessions", ctx, user.ID, primitive.NilObjectID).Return(nil)
+		err := tsvc.service.DeactivateUser(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("deactivate user error when deactivate own account", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.UpdateUserStatusRequest{
+			ID:     adminId.Hex(),
+			UserID: adminId.Hex(),
+		}
+		expected := helpers.NewCustomError(http.StatusBadRequest, "You cannot deactivate your own account")
+		err := tsvc.service.DeactivateUser(ctx, req)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("deactivate user error when not found", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.UpdateUserStatusRequest{
+			ID:     user.ID.Hex(),
+			UserID: adminId.Hex(),
+		}
+		expected := helpers.NewCustomError(http.StatusNotFound, "User not found.")
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(nil, nil)
+		err := tsvc.service.DeactivateUser(ctx, req)
+		assert.Equal(t, expected, err)
+		tsvc.userRepo.AssertNotCalled(t, "Update", ctx, mock.Anything)
+	})
+	t.Run("deactivate user error when revoke sessions fail", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.UpdateUserStatusRequest{
+			ID:     user.ID.Hex(),
+			UserID: adminId.Hex(),
+		}
+		params := &domains.UpdateUserParams{
+			ID:            user.ID,
+			IsDeactivated: &isDeactivated,
+		}
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&user, nil)
+		tsvc.userRepo.On("Update", ctx, params).Return(&deactivated, nil)
+		tsvc.auditEventRepo.On("Create", ctx, audit).Return(nil)
+		tsvc.refreshTokenRepo.On("RevokeUserSessions", ctx, user.ID, primitive.NilObjectID).Return(errors.New("some error"))
+		err := tsvc.service.DeactivateUser(ctx, req)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestReactivateUser(t *testing.T) {
+	isDeactivated := false
+	t.Run("reactivate user success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.UpdateUserStatusRequest{
+			ID:     user.ID.Hex(),
+			UserID: adminId.Hex(),
+		}
+		params := &domains.UpdateUserParams{
+			ID:            user.ID,
+			IsDeactivated: &isDeactivated,
+		}
+		deactivated := user
+		deactivated.IsDeactivated = true
+		audit := &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_USER_REACTIVATE,
+			TargetType: constants.AUDIT_TARGET_USER,
+			TargetID:   user.ID,
+			Changes:    []domains.AuditChange{{Field: "isDeactivated", Before: true, After: false}},
+		}
+		tsvc.userRepo.On("Get", ctx, user.ID).Return(&deactivated, nil)
+		tsvc.userRepo.On("Update", ctx, params).Return(&user, nil)
+		tsvc.auditEventRepo.On("Create", ctx, audit).Return(nil)
+		err := tsvc.service.ReactivateUser(ctx, req)
+		assert.NoError(t, err)
+	})
+}
+
+func TestUnlockUser(t *testing.T) {
+	req := &dto.UpdateUserStatusRequest{
+		ID:     userId.Hex(),
+		UserID: adminId.Hex(),
+	}
+	t.Run("unlock user success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		audit := &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_USER_UNLOCK,
+			TargetType: constants.AUDIT_TARGET_USER,
+			TargetID:   userId,
+		}
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, "username:"+user.Username).Return(nil, nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, "username:"+user.Username).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, audit).Return(nil)
+		err := tsvc.service.UnlockUser(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("unlock user also clears the ip guards of the failures", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		attempt := &domains.LoginAttempt{Key: "username:" + user.Username, Failures: 5, ClientIPs: []string{"10.0.0.1", "10.0.0.2"}}
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, "username:"+user.Username).Return(attempt, nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, "ip:10.0.0.1").Return(nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, "ip:10.0.0.2").Return(nil)
+		tsvc.loginAttemptRepo.On("Reset", ctx, "username:"+user.Username).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, mock.AnythingOfType("*domains.CreateAuditEventParams")).Return(nil)
+		err := tsvc.service.UnlockUser(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("unlock user error when login attempt query fail", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		tsvc.loginAttemptRepo.On("Get", ctx, "username:"+user.Username).Return(nil, errors.New("some error"))
+		err := tsvc.service.UnlockUser(ctx, req)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+	t.Run("unlock user error when user not found", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		tsvc.userRepo.On("Get", ctx, userId).Return(nil, nil)
+		err := tsvc.service.UnlockUser(ctx, req)
+		assert.Equal(t, helpers.NewCustomError(http.StatusNotFound, "User not found."), err)
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	t.Setenv("BCRYPT_COST", "8")
+	config.New()
+	sessionId := primitive.NewObjectID()
+	newPassword := "0987654321"
+	newPassHash := "new-hashed-password"
+	t.Run("change password success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.ChangePasswordRequest{
+			OldPassword: password,
+			NewPassword: newPassword,
+			UserID:      userId.Hex(),
+			SessionID:   sessionId.Hex(),
+		}
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(nil)
+		tsvc.myBcrypt.On("GenerateFromPassword", newPassword, bcryptCost).Return(&newPassHash, nil)
+		tsvc.userRepo.On("Update", ctx, &domains.UpdateUserParams{
+			ID:       userId,
+			Password: newPassHash,
+		}).Return(&user, nil)
+		tsvc.refreshTokenRepo.On("RevokeUserSessions", ctx, userId, sessionId).Return(nil)
+		err := tsvc.service.ChangePassword(ctx, req)
+		assert.NoError(t, err)
+	})
+	t.Run("change password error when old password is incorrect", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.ChangePasswordRequest{
+			OldPassword: "wrong-password",
+			NewPassword: newPassword,
+			UserID:      userId.Hex(),
+			SessionID:   sessionId.Hex(),
+		}
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, "wrong-password").Return(errors.New("some error"))
+		err := tsvc.service.ChangePassword(ctx, req)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "Old password is incorrect"), err)
+	})
+	t.Run("change password error when user not found", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.ChangePasswordRequest{
+			OldPassword: password,
+			NewPassword: newPassword,
+			UserID:      userId.Hex(),
+			SessionID:   sessionId.Hex(),
+		}
+		tsvc.userRepo.On("Get", ctx, userId).Return(nil, nil)
+		err := tsvc.service.ChangePassword(ctx, req)
+		assert.Equal(t, helpers.NewCustomError(http.StatusNotFound, "User not found."), err)
+	})
+	t.Run("change password error when revoke sessions fail", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		req := &dto.ChangePasswordRequest{
+			OldPassword: password,
+			NewPassword: newPassword,
+			UserID:      userId.Hex(),
+			SessionID:   sessionId.Hex(),
+		}
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		tsvc.myBcrypt.On("CompareHashAndPassword", user.Password, password).Return(nil)
+		tsvc.myBcrypt.On("GenerateFromPassword", newPassword, bcryptCost).Return(&newPassHash, nil)
+		tsvc.userRepo.On("Update", ctx, &domains.UpdateUserParams{
+			ID:       userId,
+			Password: newPassHash,
+		}).Return(&user, nil)
+		tsvc.refreshTokenRepo.On("RevokeUserSessions", ctx, userId, sessionId).Return(errors.New("some error"))
+		err := tsvc.service.ChangePassword(ctx, req)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestEnrollMFA(t *testing.T) {
+	t.Setenv("MFA_ISSUER", "robinhood")
+	config.New()
+	t.Run("enroll mfa success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		tsvc.userRepo.On("SetPendingTOTPSecret", ctx, userId, mock.AnythingOfType("string")).Return(nil)
+		got, err := tsvc.service.EnrollMFA(ctx, userId.Hex())
+		assert.NoError(t, err)
+		assert.NotEmpty(t, got.Secret)
+		assert.True(t, strings.HasPrefix(got.URI, "otpauth://totp/robinhood:"+user.Username+"?"))
+	})
+	t.Run("enroll mfa error when already enabled", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		mfaUser := user
+		mfaUser.MFAEnabled = true
+		tsvc.userRepo.On("Get", ctx, userId).Return(&mfaUser, nil)
+		got, err := tsvc.service.EnrollMFA(ctx, userId.Hex())
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "Two-factor authentication is already enabled"), err)
+	})
+}
+
+func TestConfirmMFA(t *testing.T) {
+	secret, _ := helpers.GenerateTOTPSecret()
+	t.Run("confirm mfa success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		code, _ := helpers.TOTPCode(secret, time.Now())
+		enrolling := user
+		enrolling.PendingTOTP = secret
+		tsvc.userRepo.On("Get", ctx, userId).Return(&enrolling, nil)
+		tsvc.userRepo.On("EnableTOTP", ctx, userId, secret, mock.AnythingOfType("[]string")).Return(nil)
+		tsvc.userRepo.On("UseTOTPStep", ctx, userId, mock.AnythingOfType("int64")).Return(true, nil)
+		got, err := tsvc.service.ConfirmMFA(ctx, &dto.MFACodeRequest{Code: code, UserID: userId.Hex()})
+		assert.NoError(t, err)
+		assert.Len(t, got, 10)
+	})
+	t.Run("confirm mfa error when enrollment not started", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		got, err := tsvc.service.ConfirmMFA(ctx, &dto.MFACodeRequest{Code: "123456", UserID: userId.Hex()})
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "Two-factor enrollment has not been started"), err)
+	})
+}
+
+func TestDisableMFA(t *testing.T) {
+	t.Setenv("MFA_REQUIRED_FOR_ADMIN", "true")
+	config.New()
+	secret, _ := helpers.GenerateTOTPSecret()
+	mfaUser := user
+	mfaUser.MFAEnabled = true
+	mfaUser.TOTPSecret = secret
+	t.Run("disable mfa success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		code, _ := helpers.TOTPCode(secret, time.Now())
+		tsvc.userRepo.On("Get", ctx, userId).Return(&mfaUser, nil)
+		tsvc.userRepo.On("UseTOTPStep", ctx, userId, mock.AnythingOfType("int64")).Return(true, nil)
+		tsvc.userRepo.On("DisableTOTP", ctx, userId).Return(nil)
+		tsvc.auditEventRepo.On("Create", ctx, &domains.CreateAuditEventParams{
+			ActorID:    userId,
+			Action:     constants.AUDIT_USER_MFA_DISABLE,
+			TargetType: constants.AUDIT_TARGET_USER,
+			TargetID:   userId,
+			Changes:    []domains.AuditChange{{Field: "mfaEnabled", Before: true, After: false}},
+			Metadata:   map[string]string{"method": "totp"},
+		}).Return(nil)
+		err := tsvc.service.DisableMFA(ctx, &dto.MFACodeRequest{Code: code, UserID: userId.Hex()})
+		assert.NoError(t, err)
+	})
+	t.Run("disable mfa error when code already used", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		code, _ := helpers.TOTPCode(secret, time.Now())
+		tsvc.userRepo.On("Get", ctx, userId).Return(&mfaUser, nil)
+		tsvc.userRepo.On("UseTOTPStep", ctx, userId, mock.AnythingOfType("int64")).Return(false, nil)
+		err := tsvc.service.DisableMFA(ctx, &dto.MFACodeRequest{Code: code, UserID: userId.Hex()})
+		assert.Equal(t, helpers.NewCustomError(http.StatusUnauthorized, "Invalid verification code"), err)
+	})
+	t.Run("disable mfa error when mfa is mandatory for admin", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		admin := mfaUser
+		admin.Role = constants.ADMIN_ROLE
+		tsvc.userRepo.On("Get", ctx, userId).Return(&admin, nil)
+		err := tsvc.service.DisableMFA(ctx, &dto.MFACodeRequest{Code: "123456", UserID: userId.Hex()})
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "Two-factor authentication is required for admins"), err)
+	})
+	t.Run("disable mfa error when not enabled", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		tsvc.userRepo.On("Get", ctx, userId).Return(&user, nil)
+		err := tsvc.service.DisableMFA(ctx, &dto.MFACodeRequest{Code: "123456", UserID: userId.Hex()})
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "Two-factor authentication is not enabled"), err)
+	})
+}
+
+func TestCreateServiceAccount(t *testing.T) {
+	req := &dto.CreateServiceAccountRequest{
+		Name:      "Interview sync",
+		Username:  "interview-sync",
+		Role:      constants.STAFF_ROLE,
+		CreatedBy: adminId.Hex(),
+	}
+	t.Run("create service account success", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		params := &domains.CreateUserParams{
+			Name:             req.Name,
+			Username:         req.Username,
+			Role:             req.Role,
+			IsServiceAccount: true,
+		}
+		expected := &domains.User{ID: primitive.NewObjectID(), Name: req.Name, Username: req.Username, Role: req.Role, IsServiceAccount: true}
+		tsvc.userRepo.On("GetByUsername", ctx, req.Username).Return(nil, nil)
+		tsvc.userRepo.On("Create", ctx, params).Return(expected, nil)
+		tsvc.auditEventRepo.On("Create", ctx, &domains.CreateAuditEventParams{
+			ActorID:    adminId,
+			Action:     constants.AUDIT_SERVICE_ACCOUNT_CREATE,
+			TargetType: constants.AUDIT_TARGET_USER,
+			TargetID:   expected.ID,
+			Changes: []domains.AuditChange{
+				{Field: "name", After: req.Name},
+				{Field: "username", After: req.Username},
+				{Field: "role", After: req.Role},
+			},
+		}).Return(nil)
+		got, err := tsvc.service.CreateServiceAccount(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("create service account error when username exists", func(t *testing.T) {
+		tsvc := newTestUserService(t)
+		tsvc.userRepo.On("GetByUsername", ctx, req.Username).Return(&user, nil)
+		got, err := tsvc.service.CreateServiceAccount(ctx, req)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusConflict, "Duplicate username"), err)
+	})
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+)
+
+type CreateAPIKeyRequest struct {
+	UserID    string     `json:"userId" from:"userId" valid:"type(string)"`
+	Name      string     `json:"name" from:"name" valid:"type(string)"`
+	Scopes    []string   `json:"scopes" from:"scopes" valid:"-"`
+	ExpiresAt *time.Time `json:"expiresAt" from:"expiresAt" valid:"-"`
+	CreatedBy string     `json:"createdBy" from:"createdBy" valid:"type(string)"`
+	RequestID string     `json:"-" valid:"-"`
+	ClientIP  string     `json:"-" valid:"-"`
+}
+
+type RevokeAPIKeyRequest struct {
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	RevokedBy string `json:"revokedBy" from:"revokedBy" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type APIKeyDetail struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type GetAPIKeysResponse struct {
+	StatusCode int            `json:"statusCode"`
+	Data       []APIKeyDetail `json:"data"`
+}
+
+type CreateAPIKeyResponse struct {
+	StatusCode int          `json:"statusCode"`
+	Key        string       `json:"key"`
+	Data       APIKeyDetail `json:"data"`
+}
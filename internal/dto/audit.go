@@ -0,0 +1,53 @@
+package dto
+
+import "time"
+
+type GetAuditEventsRequest struct {
+	ActorID       string     `query:"actorId" valid:"type(string),optional"`
+	Action        string     `query:"action" valid:"type(string),optional"`
+	TargetType    string     `query:"targetType" valid:"type(string),optional"`
+	TargetID      string     `query:"targetId" valid:"type(string),optional"`
+	AppointmentID string     `query:"appointmentId" valid:"type(string),optional"`
+	RequestID     string     `query:"requestId" valid:"type(string),optional"`
+	From          *time.Time `query:"from" valid:"-"`
+	To            *time.Time `query:"to" valid:"-"`
+	Cursor        string     `query:"cursor" valid:"type(string),optional"`
+	Limit         uint32     `query:"limit" valid:"type(uint32),optional"`
+}
+
+type GetInterviewActivityRequest struct {
+	ID     string `json:"id" from:"id" valid:"type(string)"`
+	Cursor string `query:"cursor" valid:"type(string),optional"`
+	Limit  uint32 `query:"limit" valid:"type(uint32),optional"`
+	UserID string `json:"userId" from:"userId" valid:"type(string)"`
+	Role   string `json:"role" from:"role" valid:"type(string)"`
+}
+
+type GetAuditEventsResponse struct {
+	StatusCode int              `json:"statusCode"`
+	Data       []AuditEvent     `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+// AuditEvent is a change recorded in the audit log. Actor is left out when
+// the user no longer exists or, for a failed login, was not found.
+type AuditEvent struct {
+	ID            string            `json:"id"`
+	Action        string            `json:"action"`
+	ActorID       string            `json:"actorId,omitempty"`
+	Actor         *User             `json:"actor,omitempty"`
+	TargetType    string            `json:"targetType"`
+	TargetID      string            `json:"targetId,omitempty"`
+	AppointmentID string            `json:"appointmentId,omitempty"`
+	Changes       []AuditChange     `json:"changes,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	RequestID     string            `json:"requestId,omitempty"`
+	IPAddress     string            `json:"ipAddress,omitempty"`
+	CreatedAt     time.Time         `json:"createdAt"`
+}
+
+type AuditChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
@@ -1,13 +1,62 @@
 package dto
 
+import "time"
+
 type LoginRequest struct {
-	Username string `json:"username" from:"username" valid:"type(string)"`
-	Password string `json:"password" from:"password" valid:"type(string)"`
+	Username  string `json:"username" from:"username" valid:"type(string)"`
+	Password  string `json:"password" from:"password" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
 }
 
 type LoginResponse struct {
+	StatusCode    uint32    `json:"statusCode" from:"statusCode"`
+	Token         string    `json:"token" from:"token"`
+	RefreshToken  string    `json:"refreshToken" from:"refreshToken"`
+	ExpiresAt     time.Time `json:"expiresAt" from:"expiresAt"`
+	RecoveryCodes []string  `json:"recoveryCodes,omitempty" from:"recoveryCodes"`
+}
+
+type MFAChallengeResponse struct {
+	StatusCode        uint32    `json:"statusCode" from:"statusCode"`
+	MFARequired       bool      `json:"mfaRequired" from:"mfaRequired"`
+	MFAEnrollRequired bool      `json:"mfaEnrollRequired" from:"mfaEnrollRequired"`
+	MFAToken          string    `json:"mfaToken" from:"mfaToken"`
+	ExpiresAt         time.Time `json:"expiresAt" from:"expiresAt"`
+}
+
+type MFAEnrollRequest struct {
+	MFAToken string `json:"mfaToken" from:"mfaToken" valid:"type(string)"`
+}
+
+type MFAVerifyRequest struct {
+	MFAToken  string `json:"mfaToken" from:"mfaToken" valid:"type(string)"`
+	Code      string `json:"code" from:"code" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type MFAEnrollResponse struct {
 	StatusCode uint32 `json:"statusCode" from:"statusCode"`
-	Token      string `json:"token" from:"token"`
+	Secret     string `json:"secret" from:"secret"`
+	URI        string `json:"uri" from:"uri"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" from:"refreshToken" valid:"type(string)"`
+}
+
+type LogoutRequest struct {
+	SessionID string `json:"sessionId" from:"sessionId" valid:"type(string)"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" from:"email" valid:"type(string),email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" from:"token" valid:"type(string)"`
+	Password string `json:"password" from:"password" valid:"type(string)"`
 }
 
 type CreateStaffRequest struct {
@@ -16,5 +65,46 @@ type CreateStaffRequest struct {
 	Username string `json:"username" from:"username" valid:"type(string)"`
 	Password string `json:"password" from:"password" valid:"type(string)"`
 	ImageUrl string `json:"imageUrl" from:"imageUrl" valid:"type(string),url"`
-	Role     string `json:"role" from:"role" valid:"type(string),in(STAFF|ADMIN)"`
+	Role     string `json:"role" from:"role" valid:"type(string),in(VIEWER|INTERVIEWER|STAFF|ADMIN)"`
+	// CreatedBy is the admin creating the account.
+	CreatedBy string `json:"-" valid:"-"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKSResponse struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+type OIDCCallbackRequest struct {
+	Code      string `json:"code" from:"code" valid:"type(string)"`
+	State     string `json:"state" from:"state" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type UpdateAuthSettingRequest struct {
+	PasswordLoginEnabled *bool  `json:"passwordLoginEnabled" from:"passwordLoginEnabled" valid:"-"`
+	UserID               string `json:"userId" from:"userId" valid:"type(string)"`
+}
+
+type AuthSettingDetail struct {
+	PasswordLoginEnabled bool `json:"passwordLoginEnabled" from:"passwordLoginEnabled"`
+	OIDCEnabled          bool `json:"oidcEnabled" from:"oidcEnabled"`
+}
+
+type AuthSettingResponse struct {
+	StatusCode uint32            `json:"statusCode" from:"statusCode"`
+	Data       AuthSettingDetail `json:"data" from:"data"`
 }
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"time"
+)
+
+type GetCandidatesRequest struct {
+	Page     uint32 `query:"page" valid:"type(uint32),optional"`
+	Limit    uint32 `query:"limit" valid:"type(uint32),optional"`
+	Search   string `query:"search" valid:"type(string),optional"`
+	Position string `query:"position" valid:"type(string),optional"`
+	Stage    string `query:"stage" valid:"type(string),in(APPLIED|SCREENING|ONSITE|OFFER|HIRED|REJECTED),optional"`
+}
+
+type GetCandidatesResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Data       []CandidateDetail `json:"data"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+type GetCandidateResponse struct {
+	StatusCode int             `json:"statusCode"`
+	Data       CandidateDetail `json:"data"`
+}
+
+type CreateCandidateRequest struct {
+	Name      string `json:"name" from:"name" valid:"type(string)"`
+	Email     string `json:"email" from:"email" valid:"type(string),email"`
+	Phone     string `json:"phone" from:"phone" valid:"type(string),optional"`
+	Position  string `json:"position" from:"position" valid:"type(string)"`
+	Stage     string `json:"stage" from:"stage" valid:"type(string),in(APPLIED|SCREENING|ONSITE|OFFER|HIRED|REJECTED),optional"`
+	CreatedBy string `json:"createdBy" from:"createdBy" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type CreateCandidateResponse struct {
+	StatusCode int             `json:"statusCode"`
+	Data       CandidateDetail `json:"data"`
+}
+
+type UpdateCandidateRequest struct {
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	Name      string `json:"name" from:"name" valid:"type(string),optional"`
+	Email     string `json:"email" from:"email" valid:"type(string),email,optional"`
+	Phone     string `json:"phone" from:"phone" valid:"type(string),optional"`
+	Position  string `json:"position" from:"position" valid:"type(string),optional"`
+	Stage     string `json:"stage" from:"stage" valid:"type(string),in(APPLIED|SCREENING|ONSITE|OFFER|HIRED|REJECTED),optional"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type CandidateDetail struct {
+	ID         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	Email      string                 `json:"email"`
+	Phone      string                 `json:"phone"`
+	Position   string                 `json:"position"`
+	Stage      string                 `json:"stage"`
+	CreatedAt  time.Time              `json:"createdAt"`
+	UpdatedAt  time.Time              `json:"updatedAt"`
+	Interviews []InterviewAppointment `json:"interviews,omitempty"`
+}
+
+type CandidateSummary struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Position string `json:"position"`
+	Stage    string `json:"stage"`
+}
@@ -16,23 +16,71 @@ type Pagination struct {
 }
 
 type GetInterviewAppointmentsRequest struct {
-	Page  uint32 `query:"page" valid:"type(uint32),optional"`
-	Limit uint32 `query:"limit" valid:"type(uint32),optional"`
+	Page      uint32     `query:"page" valid:"type(uint32),optional"`
+	Limit     uint32     `query:"limit" valid:"type(uint32),optional"`
+	Archived  bool       `query:"archived" valid:"-"`
+	Status    string     `query:"status" valid:"type(string),optional"`
+	CreatedBy string     `query:"createdBy" valid:"type(string),optional"`
+	Tag       string     `query:"tag" valid:"type(string),optional"`
+	From      *time.Time `query:"from" valid:"-"`
+	To        *time.Time `query:"to" valid:"-"`
+	Q         string     `query:"q" valid:"type(string),optional"`
+	Sort      string     `query:"sort" valid:"in(createdAt|updatedAt|title),optional"`
+	Order     string     `query:"order" valid:"in(asc|desc),optional"`
+	// Cursor is a nextCursor or prevCursor of an earlier response. Page is
+	// only used when there is no cursor.
+	Cursor     string `query:"cursor" valid:"type(string),optional"`
+	TotalCount bool   `query:"totalCount" valid:"-"`
+	// UserID and Role are the caller. They are only set with Q, to leave
+	// out blind feedback the caller cannot read from the search.
+	UserID string `json:"-" valid:"-"`
+	Role   string `json:"-" valid:"-"`
+}
+
+// ListPagination describes a page of a list that can be paged with cursors
+// or, for compatibility, with page numbers. Page is only set in the latter
+// case.
+type ListPagination struct {
+	Page       uint32 `json:"page,omitempty"`
+	Size       uint32 `json:"size"`
+	HasNext    bool   `json:"hasNext"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
+	TotalCount *int64 `json:"totalCount,omitempty"`
 }
 
 type GetInterviewAppointmentsResponse struct {
 	StatusCode int                    `json:"statusCode"`
 	Data       []InterviewAppointment `json:"data"`
-	Pagination Pagination             `json:"pagination"`
+	Pagination ListPagination         `json:"pagination"`
 }
 
 type InterviewAppointment struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Status      string    `json:"status"`
-	CreateUser  User      `json:"createUser"`
-	CreatedAt   time.Time `json:"createdAt"`
+	ID              string            `json:"id"`
+	Title           string            `json:"title"`
+	Description     string            `json:"description"`
+	Status          string            `json:"status"`
+	StartAt         *time.Time        `json:"startAt,omitempty"`
+	EndAt           *time.Time        `json:"endAt,omitempty"`
+	DurationMinutes int               `json:"durationMinutes,omitempty"`
+	Timezone        string            `json:"timezone,omitempty"`
+	Location        string            `json:"location,omitempty"`
+	MeetingURL      string            `json:"meetingUrl,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Interviewers    []Interviewer     `json:"interviewers,omitempty"`
+	Candidate       *CandidateSummary `json:"candidate,omitempty"`
+	CreateUser      User              `json:"createUser"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	ArchivedAt      *time.Time        `json:"archivedAt,omitempty"`
+	ArchivedBy      string            `json:"archivedBy,omitempty"`
+}
+
+type GetInterviewAppointmentRequest struct {
+	ID     string `json:"id" from:"id" valid:"type(string)"`
+	UserID string `json:"userId" from:"userId" valid:"type(string)"`
+	Role   string `json:"role" from:"role" valid:"type(string)"`
+	// IfNoneMatch is the If-None-Match header.
+	IfNoneMatch string `json:"-" valid:"-"`
 }
 
 type GetInterviewAppointmentResponse struct {
@@ -41,9 +89,22 @@ type GetInterviewAppointmentResponse struct {
 }
 
 type CreateInterviewAppointmentRequest struct {
-	Title       string `json:"title" from:"title" valid:"type(string)"`
-	Description string `json:"description" from:"description" valid:"type(string)"`
-	CreatedBy   string `json:"createdBy" from:"createdBy" valid:"type(string)"`
+	Title               string     `json:"title" from:"title" valid:"type(string)"`
+	Description         string     `json:"description" from:"description" valid:"type(string)"`
+	StartAt             *time.Time `json:"startAt" from:"startAt" valid:"-"`
+	EndAt               *time.Time `json:"endAt" from:"endAt" valid:"-"`
+	DurationMinutes     int        `json:"durationMinutes" from:"durationMinutes" valid:"-"`
+	Timezone            string     `json:"timezone" from:"timezone" valid:"type(string),optional"`
+	Location            string     `json:"location" from:"location" valid:"type(string),optional"`
+	MeetingURL          string     `json:"meetingUrl" from:"meetingUrl" valid:"type(string),optional"`
+	Tags                []string   `json:"tags" from:"tags" valid:"-"`
+	CandidateID         string     `json:"candidateId" from:"candidateId" valid:"type(string)"`
+	ScorecardTemplateID string     `json:"scorecardTemplateId" from:"scorecardTemplateId" valid:"type(string),optional"`
+	BlindFeedback       bool       `json:"blindFeedback" from:"blindFeedback" valid:"-"`
+	HiringManagerID     string     `json:"hiringManagerId" from:"hiringManagerId" valid:"type(string),optional"`
+	CreatedBy           string     `json:"createdBy" from:"createdBy" valid:"type(string)"`
+	RequestID           string     `json:"-" valid:"-"`
+	ClientIP            string     `json:"-" valid:"-"`
 }
 
 type CreateInterviewAppointmentResponse struct {
@@ -51,10 +112,34 @@ type CreateInterviewAppointmentResponse struct {
 	Data       InterviewAppointmentDetail `json:"data"`
 }
 
+type GetInterviewCommentsRequest struct {
+	ID     string `json:"id" from:"id" valid:"type(string)"`
+	Cursor string `query:"cursor" valid:"type(string),optional"`
+	Limit  uint32 `query:"limit" valid:"type(uint32),optional"`
+	UserID string `json:"userId" from:"userId" valid:"type(string)"`
+	Role   string `json:"role" from:"role" valid:"type(string)"`
+}
+
+// CursorPagination describes a page of a cursor-paginated list. Pass
+// NextCursor as the cursor query parameter to get the next page.
+type CursorPagination struct {
+	Size       uint32 `json:"size"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	HasNext    bool   `json:"hasNext"`
+}
+
+type GetInterviewCommentsResponse struct {
+	StatusCode int                `json:"statusCode"`
+	Data       []InterviewComment `json:"data"`
+	Pagination CursorPagination   `json:"pagination"`
+}
+
 type AddInterviewCommentRequest struct {
-	ID      string `json:"id" from:"id" valid:"type(string)"`
-	Comment string `json:"comment" from:"comment" valid:"type(string)"`
-	UserID  string `json:"userId" from:"userId" valid:"type(string)"`
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	Comment   string `json:"comment" from:"comment" valid:"type(string)"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
 }
 
 type UpdateInterviewCommentRequest struct {
@@ -62,30 +147,167 @@ type UpdateInterviewCommentRequest struct {
 	CommentID string `json:"commentId" from:"commentId" valid:"type(string)"`
 	Comment   string `json:"comment" from:"comment" valid:"type(string)"`
 	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	Role      string `json:"role" from:"role" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+	// IfMatch is the version from the If-Match header, or nil without one.
+	IfMatch *int64 `json:"-" valid:"-"`
+}
+
+type GetInterviewCommentRevisionsRequest struct {
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	CommentID string `json:"commentId" from:"commentId" valid:"type(string)"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	Role      string `json:"role" from:"role" valid:"type(string)"`
+}
+
+// InterviewCommentRevision is an earlier text of a comment. User is left out
+// when its author no longer exists.
+type InterviewCommentRevision struct {
+	Comment   string    `json:"comment"`
+	User      *User     `json:"user,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type GetInterviewCommentRevisionsResponse struct {
+	StatusCode int                        `json:"statusCode"`
+	Data       []InterviewCommentRevision `json:"data"`
+}
+
+type DeleteInterviewCommentRequest struct {
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	CommentID string `json:"commentId" from:"commentId" valid:"type(string)"`
+	Purge     bool   `json:"purge" from:"purge" valid:"-"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	Role      string `json:"role" from:"role" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type ArchiveInterviewAppointmentRequest struct {
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	Role      string `json:"role" from:"role" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type UnarchiveInterviewAppointmentRequest struct {
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	Role      string `json:"role" from:"role" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
 }
 
 type UpdateInterviewAppointmentRequest struct {
-	ID          string `json:"id" from:"id" valid:"type(string)"`
-	Title       string `json:"title" from:"title" valid:"type(string),optional"`
-	Description string `json:"description" from:"description" valid:"type(string),optional"`
-	Status      string `json:"status" from:"status" valid:"type(string),in(TODO|IN_PROGRESS|DONE),optional"`
+	ID                  string     `json:"id" from:"id" valid:"type(string)"`
+	Title               string     `json:"title" from:"title" valid:"type(string),optional"`
+	Description         string     `json:"description" from:"description" valid:"type(string),optional"`
+	Status              string     `json:"status" from:"status" valid:"type(string),optional"`
+	StartAt             *time.Time `json:"startAt" from:"startAt" valid:"-"`
+	EndAt               *time.Time `json:"endAt" from:"endAt" valid:"-"`
+	DurationMinutes     int        `json:"durationMinutes" from:"durationMinutes" valid:"-"`
+	Timezone            string     `json:"timezone" from:"timezone" valid:"type(string),optional"`
+	Location            string     `json:"location" from:"location" valid:"type(string),optional"`
+	MeetingURL          string     `json:"meetingUrl" from:"meetingUrl" valid:"type(string),optional"`
+	Tags                *[]string  `json:"tags" from:"tags" valid:"-"`
+	CandidateID         string     `json:"candidateId" from:"candidateId" valid:"type(string),optional"`
+	ScorecardTemplateID string     `json:"scorecardTemplateId" from:"scorecardTemplateId" valid:"type(string),optional"`
+	BlindFeedback       *bool      `json:"blindFeedback" from:"blindFeedback" valid:"-"`
+	HiringManagerID     string     `json:"hiringManagerId" from:"hiringManagerId" valid:"type(string),optional"`
+	UserID              string     `json:"userId" from:"userId" valid:"type(string)"`
+	Role                string     `json:"-" valid:"-"`
+	RequestID           string     `json:"-" valid:"-"`
+	ClientIP            string     `json:"-" valid:"-"`
+	// IfMatch is the version from the If-Match header, or nil without one.
+	IfMatch *int64 `json:"-" valid:"-"`
+}
+
+type AssignInterviewersRequest struct {
+	ID             string   `json:"id" from:"id" valid:"type(string)"`
+	InterviewerIDs []string `json:"interviewerIds" from:"interviewerIds" valid:"-"`
+	UserID         string   `json:"-" valid:"-"`
+	RequestID      string   `json:"-" valid:"-"`
+	ClientIP       string   `json:"-" valid:"-"`
+}
+
+type GetInterviewerAppointmentsRequest struct {
+	InterviewerID string `query:"interviewerId" valid:"type(string)"`
+	Page          uint32 `query:"page" valid:"type(uint32),optional"`
+	Limit         uint32 `query:"limit" valid:"type(uint32),optional"`
+}
+
+// InterviewConflict is an appointment that overlaps the requested slot for
+// one or more of the requested interviewers.
+type InterviewConflict struct {
+	ID             string    `json:"id"`
+	Title          string    `json:"title"`
+	StartAt        time.Time `json:"startAt"`
+	EndAt          time.Time `json:"endAt"`
+	InterviewerIDs []string  `json:"interviewerIds"`
 }
 
 type InterviewAppointmentDetail struct {
-	ID          string             `json:"id"`
-	Title       string             `json:"title"`
-	Description string             `json:"description"`
-	Status      string             `json:"status"`
-	CreateUser  User               `json:"createUser"`
-	CreatedAt   time.Time          `json:"createdAt"`
-	Comments    []InterviewComment `json:"comments"`
+	ID               string                  `json:"id"`
+	Title            string                  `json:"title"`
+	Description      string                  `json:"description"`
+	Status           string                  `json:"status"`
+	StartAt          *time.Time              `json:"startAt,omitempty"`
+	EndAt            *time.Time              `json:"endAt,omitempty"`
+	DurationMinutes  int                     `json:"durationMinutes,omitempty"`
+	Timezone         string                  `json:"timezone,omitempty"`
+	Location         string                  `json:"location,omitempty"`
+	MeetingURL       string                  `json:"meetingUrl,omitempty"`
+	Tags             []string                `json:"tags,omitempty"`
+	Interviewers     []Interviewer           `json:"interviewers,omitempty"`
+	Candidate        *CandidateSummary       `json:"candidate,omitempty"`
+	StatusHistory    []InterviewStatusChange `json:"statusHistory,omitempty"`
+	TimeInStatus     []StatusDuration        `json:"timeInStatus,omitempty"`
+	ScorecardSummary *ScorecardSummary       `json:"scorecardSummary,omitempty"`
+	BlindFeedback    bool                    `json:"blindFeedback"`
+	HiringManagerID  string                  `json:"hiringManagerId,omitempty"`
+	FeedbackHidden   bool                    `json:"feedbackHidden,omitempty"`
+	CreateUser       User                    `json:"createUser"`
+	Version          int64                   `json:"version"`
+	CreatedAt        time.Time               `json:"createdAt"`
+	// Deprecated: Comments only holds the first page of comments. Use
+	// GET /api/interviews/:id/comments instead.
+	Comments []InterviewComment `json:"comments"`
+}
+
+type InterviewStatusChange struct {
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to"`
+	ChangedBy string    `json:"changedBy"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+// StatusDuration is the total time an appointment has spent in a status,
+// including the time so far in its current status.
+type StatusDuration struct {
+	Status  string `json:"status"`
+	Seconds int64  `json:"seconds"`
 }
 
 type InterviewComment struct {
-	ID        string    `json:"id"`
-	Comment   string    `json:"comment"`
-	User      User      `json:"user"`
-	CreatedAt time.Time `json:"CreatedAt"`
+	ID        string     `json:"id"`
+	Comment   string     `json:"comment"`
+	User      User       `json:"user"`
+	CreatedAt time.Time  `json:"CreatedAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	Edited    bool       `json:"edited"`
+	Version   int64      `json:"version"`
+	Deleted   bool       `json:"deleted,omitempty"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	DeletedBy string     `json:"deletedBy,omitempty"`
+}
+
+type Interviewer struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	ImageUrl string `json:"imageUrl"`
 }
 
 type User struct {
@@ -95,6 +317,31 @@ type User struct {
 }
 
 type ErrorResponse struct {
-	StatusCode int    `json:"statusCode" from:"statusCode"`
-	Error      string `json:"error" from:"error"`
+	StatusCode int         `json:"statusCode" from:"statusCode"`
+	Error      string      `json:"error" from:"error"`
+	Details    interface{} `json:"details,omitempty" from:"details"`
+}
+
+type WorkflowStatus struct {
+	Name        string   `json:"name" from:"name"`
+	Transitions []string `json:"transitions" from:"transitions"`
+}
+
+type UpdateWorkflowRequest struct {
+	Statuses      []WorkflowStatus `json:"statuses" from:"statuses" valid:"-"`
+	InitialStatus string           `json:"initialStatus" from:"initialStatus" valid:"type(string),optional"`
+	UserID        string           `json:"userId" from:"userId" valid:"type(string)"`
+	RequestID     string           `json:"-" valid:"-"`
+	ClientIP      string           `json:"-" valid:"-"`
+}
+
+type WorkflowDetail struct {
+	Statuses      []WorkflowStatus `json:"statuses"`
+	InitialStatus string           `json:"initialStatus"`
+	UpdatedAt     *time.Time       `json:"updatedAt,omitempty"`
+}
+
+type WorkflowResponse struct {
+	StatusCode int            `json:"statusCode"`
+	Data       WorkflowDetail `json:"data"`
 }
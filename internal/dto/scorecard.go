@@ -0,0 +1,144 @@
+package dto
+
+import (
+	"time"
+)
+
+type GetScorecardTemplatesRequest struct {
+	Page            uint32 `query:"page" valid:"type(uint32),optional"`
+	Limit           uint32 `query:"limit" valid:"type(uint32),optional"`
+	IncludeArchived bool   `query:"includeArchived" valid:"-"`
+}
+
+type GetScorecardTemplatesResponse struct {
+	StatusCode int                       `json:"statusCode"`
+	Data       []ScorecardTemplateDetail `json:"data"`
+	Pagination Pagination                `json:"pagination"`
+}
+
+type ScorecardTemplateResponse struct {
+	StatusCode int                     `json:"statusCode"`
+	Data       ScorecardTemplateDetail `json:"data"`
+}
+
+type ScorecardCompetency struct {
+	Name        string `json:"name" from:"name"`
+	Description string `json:"description,omitempty" from:"description"`
+}
+
+type ScorecardRatingScale struct {
+	Min int `json:"min" from:"min"`
+	Max int `json:"max" from:"max"`
+}
+
+type ScorecardQuestion struct {
+	ID       string `json:"id,omitempty" from:"id"`
+	Text     string `json:"text" from:"text"`
+	Required bool   `json:"required" from:"required"`
+}
+
+type CreateScorecardTemplateRequest struct {
+	Name         string                `json:"name" from:"name" valid:"type(string)"`
+	Competencies []ScorecardCompetency `json:"competencies" from:"competencies" valid:"-"`
+	RatingScale  *ScorecardRatingScale `json:"ratingScale" from:"ratingScale" valid:"-"`
+	Questions    []ScorecardQuestion   `json:"questions" from:"questions" valid:"-"`
+	CreatedBy    string                `json:"createdBy" from:"createdBy" valid:"type(string)"`
+	RequestID    string                `json:"-" valid:"-"`
+	ClientIP     string                `json:"-" valid:"-"`
+}
+
+type ArchiveScorecardTemplateRequest struct {
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type ScorecardTemplateDetail struct {
+	ID           string                `json:"id"`
+	Name         string                `json:"name"`
+	Competencies []ScorecardCompetency `json:"competencies"`
+	RatingScale  ScorecardRatingScale  `json:"ratingScale"`
+	Questions    []ScorecardQuestion   `json:"questions"`
+	IsArchived   bool                  `json:"isArchived"`
+	CreatedAt    time.Time             `json:"createdAt"`
+}
+
+type ScorecardRating struct {
+	Competency string `json:"competency" from:"competency"`
+	Score      int    `json:"score" from:"score"`
+	Note       string `json:"note,omitempty" from:"note"`
+}
+
+type ScorecardAnswer struct {
+	QuestionID string `json:"questionId" from:"questionId"`
+	Answer     string `json:"answer" from:"answer"`
+}
+
+type GetScorecardsRequest struct {
+	ID     string `json:"id" from:"id" valid:"type(string)"`
+	UserID string `json:"userId" from:"userId" valid:"type(string)"`
+	Role   string `json:"role" from:"role" valid:"type(string)"`
+}
+
+type SubmitScorecardRequest struct {
+	ID             string            `json:"id" from:"id" valid:"type(string)"`
+	Ratings        []ScorecardRating `json:"ratings" from:"ratings" valid:"-"`
+	Answers        []ScorecardAnswer `json:"answers" from:"answers" valid:"-"`
+	Recommendation string            `json:"recommendation" from:"recommendation" valid:"type(string),in(STRONG_NO|NO|YES|STRONG_YES)"`
+	UserID         string            `json:"userId" from:"userId" valid:"type(string)"`
+	RequestID      string            `json:"-" valid:"-"`
+	ClientIP       string            `json:"-" valid:"-"`
+}
+
+type UpdateScorecardRequest struct {
+	ID             string            `json:"id" from:"id" valid:"type(string)"`
+	ScorecardID    string            `json:"scorecardId" from:"scorecardId" valid:"type(string)"`
+	Ratings        []ScorecardRating `json:"ratings" from:"ratings" valid:"-"`
+	Answers        []ScorecardAnswer `json:"answers" from:"answers" valid:"-"`
+	Recommendation string            `json:"recommendation" from:"recommendation" valid:"type(string),in(STRONG_NO|NO|YES|STRONG_YES)"`
+	UserID         string            `json:"userId" from:"userId" valid:"type(string)"`
+	RequestID      string            `json:"-" valid:"-"`
+	ClientIP       string            `json:"-" valid:"-"`
+}
+
+type ScorecardDetail struct {
+	ID             string            `json:"id"`
+	TemplateID     string            `json:"templateId"`
+	Interviewer    *Interviewer      `json:"interviewer,omitempty"`
+	Ratings        []ScorecardRating `json:"ratings"`
+	Answers        []ScorecardAnswer `json:"answers"`
+	Recommendation string            `json:"recommendation"`
+	SubmittedAt    time.Time         `json:"submittedAt"`
+	UpdatedAt      time.Time         `json:"updatedAt"`
+	LockedAt       time.Time         `json:"lockedAt"`
+}
+
+type GetScorecardsResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Data       []ScorecardDetail `json:"data"`
+}
+
+type ScorecardResponse struct {
+	StatusCode int             `json:"statusCode"`
+	Data       ScorecardDetail `json:"data"`
+}
+
+// ScorecardSummary aggregates the scorecards submitted for an appointment.
+// Averages are nil until a score was given.
+type ScorecardSummary struct {
+	TemplateID      string               `json:"templateId"`
+	TemplateName    string               `json:"templateName"`
+	RatingScale     ScorecardRatingScale `json:"ratingScale"`
+	Submitted       int                  `json:"submitted"`
+	Expected        int                  `json:"expected"`
+	AverageScore    *float64             `json:"averageScore"`
+	Competencies    []CompetencyScore    `json:"competencies"`
+	Recommendations map[string]int       `json:"recommendations"`
+}
+
+type CompetencyScore struct {
+	Competency   string   `json:"competency"`
+	AverageScore *float64 `json:"averageScore"`
+	Ratings      int      `json:"ratings"`
+}
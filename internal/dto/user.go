@@ -0,0 +1,86 @@
+package dto
+
+type GetUsersRequest struct {
+	Page   uint32 `query:"page" valid:"type(uint32),optional"`
+	Limit  uint32 `query:"limit" valid:"type(uint32),optional"`
+	Search string `query:"search" valid:"type(string),optional"`
+	Role   string `query:"role" valid:"type(string),in(VIEWER|INTERVIEWER|STAFF|ADMIN),optional"`
+	Status string `query:"status" valid:"type(string),in(ACTIVE|DEACTIVATED),optional"`
+}
+
+type GetUsersResponse struct {
+	StatusCode int          `json:"statusCode"`
+	Data       []UserDetail `json:"data"`
+	Pagination Pagination   `json:"pagination"`
+}
+
+type GetUserResponse struct {
+	StatusCode int        `json:"statusCode"`
+	Data       UserDetail `json:"data"`
+}
+
+type UserDetail struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Email            string `json:"email"`
+	Username         string `json:"username"`
+	ImageUrl         string `json:"imageUrl"`
+	Role             string `json:"role"`
+	IsDeactivated    bool   `json:"isDeactivated"`
+	MFAEnabled       bool   `json:"mfaEnabled"`
+	IsServiceAccount bool   `json:"isServiceAccount"`
+}
+
+type CreateServiceAccountRequest struct {
+	Name      string `json:"name" from:"name" valid:"type(string)"`
+	Username  string `json:"username" from:"username" valid:"type(string)"`
+	Role      string `json:"role" from:"role" valid:"type(string),in(VIEWER|INTERVIEWER|STAFF|ADMIN)"`
+	CreatedBy string `json:"createdBy" from:"createdBy" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type UpdateUserRequest struct {
+	ID       string `json:"id" from:"id" valid:"type(string)"`
+	Name     string `json:"name" from:"name" valid:"type(string),optional"`
+	Email    string `json:"email" from:"email" valid:"type(string),email,optional"`
+	ImageUrl string `json:"imageUrl" from:"imageUrl" valid:"type(string),url,optional"`
+	// UserID is the user making the change, who is ID on /api/me.
+	UserID    string `json:"-" valid:"-"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type UpdateUserRoleRequest struct {
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	Role      string `json:"role" from:"role" valid:"type(string),in(VIEWER|INTERVIEWER|STAFF|ADMIN)"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type UpdateUserStatusRequest struct {
+	ID        string `json:"id" from:"id" valid:"type(string)"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type ChangePasswordRequest struct {
+	OldPassword string `json:"oldPassword" from:"oldPassword" valid:"type(string)"`
+	NewPassword string `json:"newPassword" from:"newPassword" valid:"type(string)"`
+	UserID      string `json:"userId" from:"userId" valid:"type(string)"`
+	SessionID   string `json:"sessionId" from:"sessionId" valid:"type(string)"`
+}
+
+type MFACodeRequest struct {
+	Code      string `json:"code" from:"code" valid:"type(string)"`
+	UserID    string `json:"userId" from:"userId" valid:"type(string)"`
+	RequestID string `json:"-" valid:"-"`
+	ClientIP  string `json:"-" valid:"-"`
+}
+
+type RecoveryCodesResponse struct {
+	StatusCode    int      `json:"statusCode"`
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type apiKeyHandler struct {
+	apiKeyService  ports.APIKeyService
+	apiKeyValidate ports.APIKeyValidate
+}
+
+func NewAPIKeyHandler(apiKeyService ports.APIKeyService, apiKeyValidate ports.APIKeyValidate) ports.APIKeyHandler {
+	return &apiKeyHandler{
+		apiKeyService:  apiKeyService,
+		apiKeyValidate: apiKeyValidate,
+	}
+}
+
+func (h *apiKeyHandler) GetAPIKeys(ctx *gin.Context) {
+	userID, err := h.apiKeyValidate.ValidateGetAPIKeys(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.apiKeyService.GetAPIKeys(ctx, userID)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	keys := make([]dto.APIKeyDetail, len(data))
+	for i := 0; i < len(data); i++ {
+		keys[i] = toAPIKeyDetail(&data[i])
+	}
+	response := dto.GetAPIKeysResponse{
+		StatusCode: http.StatusOK,
+		Data:       keys,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *apiKeyHandler) CreateAPIKey(ctx *gin.Context) {
+	req, err := h.apiKeyValidate.ValidateCreateAPIKey(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.apiKeyService.CreateAPIKey(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.CreateAPIKeyResponse{
+		StatusCode: http.StatusCreated,
+		Key:        data.Key,
+		Data:       toAPIKeyDetail(&data.APIKey),
+	}
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *apiKeyHandler) RevokeAPIKey(ctx *gin.Context) {
+	req, err := h.apiKeyValidate.ValidateRevokeAPIKey(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.apiKeyService.RevokeAPIKey(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func toAPIKeyDetail(key *domains.APIKey) dto.APIKeyDetail {
+	return dto.APIKeyDetail{
+		ID:         key.ID.Hex(),
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
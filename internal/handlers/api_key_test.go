@@ -0,0 +1,159 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/handlers"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testAPIKeyHandler struct {
+	apiKeyService  *mocks.APIKeyService
+	apiKeyValidate *mocks.APIKeyValidate
+	handler        ports.APIKeyHandler
+}
+
+func newTestAPIKeyHandler(t *testing.T) testAPIKeyHandler {
+	apiKeyService := mocks.NewAPIKeyService(t)
+	apiKeyValidate := mocks.NewAPIKeyValidate(t)
+	handler := handlers.NewAPIKeyHandler(apiKeyService, apiKeyValidate)
+	return testAPIKeyHandler{apiKeyService, apiKeyValidate, handler}
+}
+
+var mockAPIKey = domains.APIKey{
+	ID:        primitive.NewObjectID(),
+	UserID:    mockUser.ID,
+	Name:      "Interview sync",
+	Prefix:    "rhk_0a1b2c3d",
+	Scopes:    []string{"interviews:read"},
+	CreatedAt: time.Now(),
+}
+
+func TestGetAPIKeys(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("get api keys success", func(t *testing.T) {
+		res := dto.GetAPIKeysResponse{
+			StatusCode: http.StatusOK,
+			Data: []dto.APIKeyDetail{{
+				ID:        mockAPIKey.ID.Hex(),
+				Name:      mockAPIKey.Name,
+				Prefix:    mockAPIKey.Prefix,
+				Scopes:    mockAPIKey.Scopes,
+				CreatedAt: mockAPIKey.CreatedAt,
+			}},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAPIKeyHandler(t)
+		thld.apiKeyValidate.On("ValidateGetAPIKeys", ctx).Return(mockUser.ID.Hex(), nil)
+		thld.apiKeyService.On("GetAPIKeys", ctx, mockUser.ID.Hex()).Return([]domains.APIKey{mockAPIKey}, nil)
+		thld.handler.GetAPIKeys(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := dto.CreateAPIKeyRequest{
+		UserID:    mockUser.ID.Hex(),
+		Name:      mockAPIKey.Name,
+		Scopes:    mockAPIKey.Scopes,
+		CreatedBy: mockUser.ID.Hex(),
+	}
+	t.Run("create api key success", func(t *testing.T) {
+		issued := &domains.IssuedAPIKey{APIKey: mockAPIKey, Key: "rhk_0a1b2c3d_secret"}
+		res := dto.CreateAPIKeyResponse{
+			StatusCode: http.StatusCreated,
+			Key:        issued.Key,
+			Data: dto.APIKeyDetail{
+				ID:        mockAPIKey.ID.Hex(),
+				Name:      mockAPIKey.Name,
+				Prefix:    mockAPIKey.Prefix,
+				Scopes:    mockAPIKey.Scopes,
+				CreatedAt: mockAPIKey.CreatedAt,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAPIKeyHandler(t)
+		thld.apiKeyValidate.On("ValidateCreateAPIKey", ctx).Return(&req, nil)
+		thld.apiKeyService.On("CreateAPIKey", ctx, &req).Return(issued, nil)
+		thld.handler.CreateAPIKey(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("create api key error when call service fail", func(t *testing.T) {
+		errMsg := "API keys can only be issued to service accounts"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAPIKeyHandler(t)
+		thld.apiKeyValidate.On("ValidateCreateAPIKey", ctx).Return(&req, nil)
+		thld.apiKeyService.On("CreateAPIKey", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.CreateAPIKey(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := dto.RevokeAPIKeyRequest{
+		ID:     mockAPIKey.ID.Hex(),
+		UserID: mockUser.ID.Hex(),
+	}
+	t.Run("revoke api key success", func(t *testing.T) {
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAPIKeyHandler(t)
+		thld.apiKeyValidate.On("ValidateRevokeAPIKey", ctx).Return(&req, nil)
+		thld.apiKeyService.On("RevokeAPIKey", ctx, &req).Return(nil)
+		thld.handler.RevokeAPIKey(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("revoke api key error when not found", func(t *testing.T) {
+		errMsg := "API key not found."
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAPIKeyHandler(t)
+		thld.apiKeyValidate.On("ValidateRevokeAPIKey", ctx).Return(&req, nil)
+		thld.apiKeyService.On("RevokeAPIKey", ctx, &req).Return(helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.RevokeAPIKey(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type auditHandler struct {
+	auditService  ports.AuditService
+	auditValidate ports.AuditValidate
+}
+
+func NewAuditHandler(auditService ports.AuditService, auditValidate ports.AuditValidate) ports.AuditHandler {
+	return &auditHandler{
+		auditService:  auditService,
+		auditValidate: auditValidate,
+	}
+}
+
+func (h *auditHandler) GetAuditEvents(ctx *gin.Context) {
+	req, err := h.auditValidate.ValidateGetAuditEvents(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if req.Limit < 1 {
+		req.Limit = 20
+	}
+	data, err := h.auditService.GetAuditEvents(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	pagination := auditPagination(ctx, &data, req.Limit)
+	events := make([]dto.AuditEvent, len(data))
+	for i := range data {
+		events[i] = toAuditEvent(&data[i])
+	}
+	ctx.JSON(http.StatusOK, dto.GetAuditEventsResponse{
+		StatusCode: http.StatusOK,
+		Data:       events,
+		Pagination: pagination,
+	})
+}
+
+// auditPagination trims data to a page of limit events and links the next
+// page.
+func auditPagination(ctx *gin.Context, data *[]domains.AuditEvent, limit uint32) dto.CursorPagination {
+	size, hasNext := helpers.Paginate(data, int64(limit))
+	pagination := dto.CursorPagination{
+		Size:    uint32(size),
+		HasNext: hasNext,
+	}
+	if hasNext {
+		last := (*data)[len(*data)-1]
+		pagination.NextCursor = helpers.NewCreatedAtCursor(last.CreatedAt, last.ID.Hex(), true)
+		ctx.Header("Link", helpers.LinkHeader(ctx.Request.URL, helpers.PageLink{Rel: "next", Query: map[string]string{"cursor": pagination.NextCursor}}))
+	}
+	return pagination
+}
+
+func toAuditEvent(data *domains.AuditEvent) dto.AuditEvent {
+	event := dto.AuditEvent{
+		ID:         data.ID.Hex(),
+		Action:     data.Action,
+		TargetType: data.TargetType,
+		Metadata:   data.Metadata,
+		RequestID:  data.RequestID,
+		IPAddress:  data.IPAddress,
+		CreatedAt:  data.CreatedAt,
+	}
+	if !data.ActorID.IsZero() {
+		event.ActorID = data.ActorID.Hex()
+	}
+	if data.Actor != nil {
+		event.Actor = &dto.User{
+			Name:     data.Actor.Name,
+			Email:    data.Actor.Email,
+			ImageUrl: data.Actor.ImageUrl,
+		}
+	}
+	if !data.TargetID.IsZero() {
+		event.TargetID = data.TargetID.Hex()
+	}
+	if !data.AppointmentID.IsZero() {
+		event.AppointmentID = data.AppointmentID.Hex()
+	}
+	for _, change := range data.Changes {
+		event.Changes = append(event.Changes, dto.AuditChange{
+			Field:  change.Field,
+			Before: toAuditValue(change.Before),
+			After:  toAuditValue(change.After),
+		})
+	}
+	return event
+}
+
+// toAuditValue turns the documents and arrays that changed values are read
+// back as into plain maps and slices, so they are written as JSON objects.
+func toAuditValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case primitive.D:
+		m := make(map[string]interface{}, len(value))
+		for _, e := range value {
+			m[e.Key] = toAuditValue(e.Value)
+		}
+		return m
+	case primitive.A:
+		a := make([]interface{}, len(value))
+		for i := range value {
+			a[i] = toAuditValue(value[i])
+		}
+		return a
+	}
+	return v
+}
@@ -0,0 +1,131 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/handlers"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testAuditHandler struct {
+	auditService  *mocks.AuditService
+	auditValidate *mocks.AuditValidate
+	handler       ports.AuditHandler
+}
+
+func newTestAuditHandler(t *testing.T) testAuditHandler {
+	auditService := mocks.NewAuditService(t)
+	auditValidate := mocks.NewAuditValidate(t)
+	handler := handlers.NewAuditHandler(auditService, auditValidate)
+	return testAuditHandler{auditService, auditValidate, handler}
+}
+
+func TestGetAuditEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	actor := domains.User{ID: primitive.NewObjectID(), Name: "User name 1", Email: "User email 1", ImageUrl: "https://image-url.com"}
+	appointmentId := primitive.NewObjectID()
+	data := []domains.AuditEvent{
+		{
+			ID:            primitive.NewObjectID(),
+			ActorID:       actor.ID,
+			Actor:         &actor,
+			Action:        "interview.update",
+			TargetType:    "interview",
+			TargetID:      appointmentId,
+			AppointmentID: appointmentId,
+			Changes: []domains.AuditChange{
+				{Field: "title", Before: "Title 1", After: "Title"},
+				{Field: "tags", After: primitive.A{"backend"}},
+			},
+			RequestID: "req-1",
+			IPAddress: "10.0.0.1",
+			CreatedAt: now,
+		},
+		{
+			ID:         primitive.NewObjectID(),
+			Action:     "auth.login.failed",
+			TargetType: "user",
+			Metadata:   map[string]string{"username": "samart", "reason": "locked"},
+			CreatedAt:  now,
+		},
+	}
+	t.Run("get audit events success with next cursor", func(t *testing.T) {
+		req := &dto.GetAuditEventsRequest{TargetType: "interview", Limit: 1}
+		res := dto.GetAuditEventsResponse{
+			StatusCode: http.StatusOK,
+			Data: []dto.AuditEvent{{
+				ID:            data[0].ID.Hex(),
+				Action:        "interview.update",
+				ActorID:       actor.ID.Hex(),
+				Actor:         &dto.User{Name: actor.Name, Email: actor.Email, ImageUrl: actor.ImageUrl},
+				TargetType:    "interview",
+				TargetID:      appointmentId.Hex(),
+				AppointmentID: appointmentId.Hex(),
+				Changes: []dto.AuditChange{
+					{Field: "title", Before: "Title 1", After: "Title"},
+					{Field: "tags", After: []interface{}{"backend"}},
+				},
+				RequestID: "req-1",
+				IPAddress: "10.0.0.1",
+				CreatedAt: now,
+			}},
+			Pagination: dto.CursorPagination{
+				Size:       1,
+				NextCursor: helpers.NewCreatedAtCursor(now, data[0].ID.Hex(), true),
+				HasNext:    true,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request, _ = http.NewRequest(http.MethodGet, "/api/audit?targetType=interview&limit=1", nil)
+		thld := newTestAuditHandler(t)
+		thld.auditValidate.On("ValidateGetAuditEvents", ctx).Return(req, nil)
+		thld.auditService.On("GetAuditEvents", ctx, req).Return(append([]domains.AuditEvent{}, data...), nil)
+		thld.handler.GetAuditEvents(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+		assert.Equal(t, `</api/audit?cursor=`+res.Pagination.NextCursor+`&limit=1&targetType=interview>; rel="next"`, w.Header().Get("Link"))
+	})
+	t.Run("get audit events uses default limit", func(t *testing.T) {
+		req := &dto.GetAuditEventsRequest{}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuditHandler(t)
+		thld.auditValidate.On("ValidateGetAuditEvents", ctx).Return(req, nil)
+		thld.auditService.On("GetAuditEvents", ctx, &dto.GetAuditEventsRequest{Limit: 20}).Return(append([]domains.AuditEvent{}, data...), nil)
+		thld.handler.GetAuditEvents(ctx)
+		decoded := dto.GetAuditEventsResponse{}
+		json.Unmarshal(w.Body.Bytes(), &decoded)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, decoded.Data, 2)
+		assert.Nil(t, decoded.Data[1].Actor)
+		assert.Equal(t, "locked", decoded.Data[1].Metadata["reason"])
+		assert.False(t, decoded.Pagination.HasNext)
+	})
+	t.Run("get audit events error when validate fail", func(t *testing.T) {
+		errMsg := "targetType: Unknown target type template"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuditHandler(t)
+		thld.auditValidate.On("ValidateGetAuditEvents", ctx).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.GetAuditEvents(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/dto"
 
@@ -52,9 +53,254 @@ func (a *authHandler) Login(ctx *gin.Context) {
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
+	if token.MFAToken != "" {
+		ctx.JSON(http.StatusOK, dto.MFAChallengeResponse{
+			StatusCode:        http.StatusOK,
+			MFARequired:       true,
+			MFAEnrollRequired: token.MFAEnrollRequired,
+			MFAToken:          token.MFAToken,
+			ExpiresAt:         token.ExpiresAt,
+		})
+		return
+	}
+	response := dto.LoginResponse{
+		StatusCode:   http.StatusOK,
+		Token:        token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresAt:    token.ExpiresAt,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (a *authHandler) RefreshToken(ctx *gin.Context) {
+	req, err := a.validate.ValidateRefreshToken(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+
+	token, err := a.authSvc.RefreshToken(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
 	response := dto.LoginResponse{
+		StatusCode:   http.StatusOK,
+		Token:        token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresAt:    token.ExpiresAt,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (a *authHandler) Logout(ctx *gin.Context) {
+	req, err := a.validate.ValidateLogout(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+
+	if err := a.authSvc.Logout(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
 		StatusCode: http.StatusOK,
-		Token:      token,
+		Message:    "success",
 	}
 	ctx.JSON(http.StatusOK, response)
 }
+
+func (a *authHandler) EnrollMFA(ctx *gin.Context) {
+	req, err := a.validate.ValidateMFAEnroll(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+
+	enrollment, err := a.authSvc.EnrollMFA(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.MFAEnrollResponse{
+		StatusCode: http.StatusOK,
+		Secret:     enrollment.Secret,
+		URI:        enrollment.URI,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (a *authHandler) VerifyMFA(ctx *gin.Context) {
+	req, err := a.validate.ValidateMFAVerify(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+
+	token, err := a.authSvc.VerifyMFA(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.LoginResponse{
+		StatusCode:    http.StatusOK,
+		Token:         token.AccessToken,
+		RefreshToken:  token.RefreshToken,
+		ExpiresAt:     token.ExpiresAt,
+		RecoveryCodes: token.RecoveryCodes,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (a *authHandler) ForgotPassword(ctx *gin.Context) {
+	req, err := a.validate.ValidateForgotPassword(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+
+	if err := a.authSvc.ForgotPassword(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (a *authHandler) ResetPassword(ctx *gin.Context) {
+	req, err := a.validate.ValidateResetPassword(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+
+	if err := a.authSvc.ResetPassword(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (a *authHandler) JWKS(ctx *gin.Context) {
+	data := a.authSvc.GetJWKS()
+	keys := make([]dto.JSONWebKey, len(data))
+	for i := 0; i < len(data); i++ {
+		keys[i] = dto.JSONWebKey{
+			Kty: data[i].Kty,
+			Kid: data[i].Kid,
+			Use: data[i].Use,
+			Alg: data[i].Alg,
+			N:   data[i].N,
+			E:   data[i].E,
+			Crv: data[i].Crv,
+			X:   data[i].X,
+		}
+	}
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, dto.JWKSResponse{Keys: keys})
+}
+
+func (a *authHandler) OIDCLogin(ctx *gin.Context) {
+	authURL, err := a.authSvc.StartOIDCLogin(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	ctx.Redirect(http.StatusFound, authURL)
+}
+
+func (a *authHandler) OIDCCallback(ctx *gin.Context) {
+	req, err := a.validate.ValidateOIDCCallback(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+
+	token, err := a.authSvc.CompleteOIDCLogin(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if token.MFAToken != "" {
+		ctx.JSON(http.StatusOK, dto.MFAChallengeResponse{
+			StatusCode:        http.StatusOK,
+			MFARequired:       true,
+			MFAEnrollRequired: token.MFAEnrollRequired,
+			MFAToken:          token.MFAToken,
+			ExpiresAt:         token.ExpiresAt,
+		})
+		return
+	}
+	response := dto.LoginResponse{
+		StatusCode:   http.StatusOK,
+		Token:        token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresAt:    token.ExpiresAt,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (a *authHandler) GetAuthSetting(ctx *gin.Context) {
+	setting, err := a.authSvc.GetAuthSetting(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.AuthSettingResponse{
+		StatusCode: http.StatusOK,
+		Data:       toAuthSettingDetail(setting),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (a *authHandler) UpdateAuthSetting(ctx *gin.Context) {
+	req, err := a.validate.ValidateUpdateAuthSetting(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+
+	setting, err := a.authSvc.UpdateAuthSetting(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.AuthSettingResponse{
+		StatusCode: http.StatusOK,
+		Data:       toAuthSettingDetail(setting),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func toAuthSettingDetail(setting *domains.AuthSetting) dto.AuthSettingDetail {
+	return dto.AuthSettingDetail{
+		PasswordLoginEnabled: !setting.PasswordLoginDisabled,
+		OIDCEnabled:          setting.OIDCEnabled,
+	}
+}
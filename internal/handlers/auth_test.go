@@ -5,11 +5,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/core/ports/mocks"
 	"robinhood-assignment/internal/dto"
 	"robinhood-assignment/internal/handlers"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -104,10 +106,16 @@ func TestLogin(t *testing.T) {
 			Username: "Username",
 			Password: "Password",
 		}
-		token := "jwt-token"
+		token := &domains.AuthToken{
+			AccessToken:  "jwt-token",
+			RefreshToken: "refresh-token",
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}
 		res := dto.LoginResponse{
-			StatusCode: http.StatusOK,
-			Token:      token,
+			StatusCode:   http.StatusOK,
+			Token:        token.AccessToken,
+			RefreshToken: token.RefreshToken,
+			ExpiresAt:    token.ExpiresAt,
 		}
 		w := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(w)
@@ -141,7 +149,7 @@ func TestLogin(t *testing.T) {
 			Username: "Username",
 			Password: "Password",
 		}
-		errMsg := "Password is incorrect"
+		errMsg := "Invalid username or password"
 		res := &dto.ErrorResponse{
 			StatusCode: http.StatusUnauthorized,
 			Error:      errMsg,
@@ -150,11 +158,525 @@ func TestLogin(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestAuthHandler(t)
 		thld.authValidate.On("ValidateLogin", ctx).Return(&req, nil)
-		thld.authService.On("Login", ctx, &req).Return("", helpers.NewCustomError(http.StatusUnauthorized, errMsg))
+		thld.authService.On("Login", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusUnauthorized, errMsg))
+		thld.handler.Login(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("login return mfa challenge", func(t *testing.T) {
+		req := dto.LoginRequest{
+			Username: "Username",
+			Password: "Password",
+		}
+		token := &domains.AuthToken{
+			MFAToken:  "mfa-token",
+			ExpiresAt: time.Now().Add(5 * time.Minute),
+		}
+		res := dto.MFAChallengeResponse{
+			StatusCode:  http.StatusOK,
+			MFARequired: true,
+			MFAToken:    token.MFAToken,
+			ExpiresAt:   token.ExpiresAt,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateLogin", ctx).Return(&req, nil)
+		thld.authService.On("Login", ctx, &req).Return(token, nil)
 		thld.handler.Login(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestRefreshToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("refresh token success", func(t *testing.T) {
+		req := dto.RefreshTokenRequest{
+			RefreshToken: "refresh-token",
+		}
+		token := &domains.AuthToken{
+			AccessToken:  "jwt-token",
+			RefreshToken: "new-refresh-token",
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}
+		res := dto.LoginResponse{
+			StatusCode:   http.StatusOK,
+			Token:        token.AccessToken,
+			RefreshToken: token.RefreshToken,
+			ExpiresAt:    token.ExpiresAt,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateRefreshToken", ctx).Return(&req, nil)
+		thld.authService.On("RefreshToken", ctx, &req).Return(token, nil)
+		thld.handler.RefreshToken(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("refresh token error when validate fail", func(t *testing.T) {
+		errMsg := "Invalid input parameter"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateRefreshToken", ctx).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.RefreshToken(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("refresh token error when call service fail", func(t *testing.T) {
+		req := dto.RefreshTokenRequest{
+			RefreshToken: "refresh-token",
+		}
+		errMsg := "Refresh token reuse detected"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateRefreshToken", ctx).Return(&req, nil)
+		thld.authService.On("RefreshToken", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusUnauthorized, errMsg))
+		thld.handler.RefreshToken(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
 		assert.Equal(t, expected, got)
 	})
 }
+
+func TestLogout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("logout success", func(t *testing.T) {
+		req := dto.LogoutRequest{
+			SessionID: "64aaf0156999249a602ff55f",
+		}
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateLogout", ctx).Return(&req, nil)
+		thld.authService.On("Logout", ctx, &req).Return(nil)
+		thld.handler.Logout(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("logout error when call service fail", func(t *testing.T) {
+		req := dto.LogoutRequest{
+			SessionID: "64aaf0156999249a602ff55f",
+		}
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Error:      helpers.InternalError.Error(),
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateLogout", ctx).Return(&req, nil)
+		thld.authService.On("Logout", ctx, &req).Return(helpers.InternalError)
+		thld.handler.Logout(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestEnrollPendingMFA(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := dto.MFAEnrollRequest{MFAToken: "mfa-token"}
+	t.Run("enroll mfa success", func(t *testing.T) {
+		enrollment := &domains.TOTPEnrollment{
+			Secret: "JBSWY3DPEHPK3PXP",
+			URI:    "otpauth://totp/robinhood:Username?secret=JBSWY3DPEHPK3PXP",
+		}
+		res := dto.MFAEnrollResponse{
+			StatusCode: http.StatusOK,
+			Secret:     enrollment.Secret,
+			URI:        enrollment.URI,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateMFAEnroll", ctx).Return(&req, nil)
+		thld.authService.On("EnrollMFA", ctx, &req).Return(enrollment, nil)
+		thld.handler.EnrollMFA(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("enroll mfa error when call service fail", func(t *testing.T) {
+		errMsg := "Invalid mfa token"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateMFAEnroll", ctx).Return(&req, nil)
+		thld.authService.On("EnrollMFA", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusUnauthorized, errMsg))
+		thld.handler.EnrollMFA(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestVerifyMFA(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := dto.MFAVerifyRequest{
+		MFAToken: "mfa-token",
+		Code:     "123456",
+	}
+	t.Run("verify mfa success", func(t *testing.T) {
+		token := &domains.AuthToken{
+			AccessToken:   "jwt-token",
+			RefreshToken:  "refresh-token",
+			ExpiresAt:     time.Now().Add(time.Hour),
+			RecoveryCodes: []string{"abcde-fghij"},
+		}
+		res := dto.LoginResponse{
+			StatusCode:    http.StatusOK,
+			Token:         token.AccessToken,
+			RefreshToken:  token.RefreshToken,
+			ExpiresAt:     token.ExpiresAt,
+			RecoveryCodes: token.RecoveryCodes,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateMFAVerify", ctx).Return(&req, nil)
+		thld.authService.On("VerifyMFA", ctx, &req).Return(token, nil)
+		thld.handler.VerifyMFA(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("verify mfa error when call service fail", func(t *testing.T) {
+		errMsg := "Invalid verification code"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateMFAVerify", ctx).Return(&req, nil)
+		thld.authService.On("VerifyMFA", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusUnauthorized, errMsg))
+		thld.handler.VerifyMFA(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestForgotPassword(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("forgot password success", func(t *testing.T) {
+		req := dto.ForgotPasswordRequest{
+			Email: "samart.ph.work@gmail.com",
+		}
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateForgotPassword", ctx).Return(&req, nil)
+		thld.authService.On("ForgotPassword", ctx, &req).Return(nil)
+		thld.handler.ForgotPassword(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("forgot password error when validate fail", func(t *testing.T) {
+		errMsg := "email: Missing required field"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateForgotPassword", ctx).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.ForgotPassword(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := dto.ResetPasswordRequest{
+		Token:    "reset-token",
+		Password: "0987654321",
+	}
+	t.Run("reset password success", func(t *testing.T) {
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateResetPassword", ctx).Return(&req, nil)
+		thld.authService.On("ResetPassword", ctx, &req).Return(nil)
+		thld.handler.ResetPassword(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("reset password error when call service fail", func(t *testing.T) {
+		errMsg := "Invalid or expired reset token"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateResetPassword", ctx).Return(&req, nil)
+		thld.authService.On("ResetPassword", ctx, &req).Return(helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.ResetPassword(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestJWKS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("jwks success", func(t *testing.T) {
+		data := []domains.JSONWebKey{
+			{Kty: "RSA", Kid: "2023-01", Use: "sig", Alg: "RS256", N: "modulus", E: "AQAB"},
+			{Kty: "OKP", Kid: "2023-07", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "public-key"},
+		}
+		res := dto.JWKSResponse{
+			Keys: []dto.JSONWebKey{
+				{Kty: "RSA", Kid: "2023-01", Use: "sig", Alg: "RS256", N: "modulus", E: "AQAB"},
+				{Kty: "OKP", Kid: "2023-07", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "public-key"},
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authService.On("GetJWKS").Return(data)
+		thld.handler.JWKS(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+		assert.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+	})
+}
+
+func TestOIDCLogin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("oidc login redirect to identity provider", func(t *testing.T) {
+		authURL := "https://idp.example.com/authorize?client_id=app&state=state"
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil)
+		thld := newTestAuthHandler(t)
+		thld.authService.On("StartOIDCLogin", ctx).Return(authURL, nil)
+		thld.handler.OIDCLogin(ctx)
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, authURL, w.Header().Get("Location"))
+	})
+	t.Run("oidc login error when not configured", func(t *testing.T) {
+		errMsg := "OIDC login is not configured"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authService.On("StartOIDCLogin", ctx).Return("", helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.OIDCLogin(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestOIDCCallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("oidc callback success", func(t *testing.T) {
+		req := dto.OIDCCallbackRequest{Code: "code", State: "state"}
+		token := &domains.AuthToken{
+			AccessToken:  "jwt-token",
+			RefreshToken: "refresh-token",
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}
+		res := dto.LoginResponse{
+			StatusCode:   http.StatusOK,
+			Token:        token.AccessToken,
+			RefreshToken: token.RefreshToken,
+			ExpiresAt:    token.ExpiresAt,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateOIDCCallback", ctx).Return(&req, nil)
+		thld.authService.On("CompleteOIDCLogin", ctx, &req).Return(token, nil)
+		thld.handler.OIDCCallback(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("oidc callback require mfa", func(t *testing.T) {
+		req := dto.OIDCCallbackRequest{Code: "code", State: "state"}
+		token := &domains.AuthToken{
+			MFAToken:  "mfa-token",
+			ExpiresAt: time.Now().Add(5 * time.Minute),
+		}
+		res := dto.MFAChallengeResponse{
+			StatusCode:  http.StatusOK,
+			MFARequired: true,
+			MFAToken:    token.MFAToken,
+			ExpiresAt:   token.ExpiresAt,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateOIDCCallback", ctx).Return(&req, nil)
+		thld.authService.On("CompleteOIDCLogin", ctx, &req).Return(token, nil)
+		thld.handler.OIDCCallback(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("oidc callback error when validate fail", func(t *testing.T) {
+		errMsg := "OIDC login was cancelled or denied"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateOIDCCallback", ctx).Return(nil, helpers.NewCustomError(http.StatusUnauthorized, errMsg))
+		thld.handler.OIDCCallback(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("oidc callback error when call service fail", func(t *testing.T) {
+		req := dto.OIDCCallbackRequest{Code: "code", State: "state"}
+		errMsg := "Invalid or expired login state"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateOIDCCallback", ctx).Return(&req, nil)
+		thld.authService.On("CompleteOIDCLogin", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.OIDCCallback(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestGetAuthSetting(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("get auth setting success", func(t *testing.T) {
+		res := dto.AuthSettingResponse{
+			StatusCode: http.StatusOK,
+			Data: dto.AuthSettingDetail{
+				PasswordLoginEnabled: true,
+				OIDCEnabled:          true,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authService.On("GetAuthSetting", ctx).Return(&domains.AuthSetting{OIDCEnabled: true}, nil)
+		thld.handler.GetAuthSetting(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestUpdateAuthSetting(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	disabled := false
+	t.Run("update auth setting success", func(t *testing.T) {
+		req := dto.UpdateAuthSettingRequest{PasswordLoginEnabled: &disabled, UserID: "6476f457e64589e868aac981"}
+		res := dto.AuthSettingResponse{
+			StatusCode: http.StatusOK,
+			Data: dto.AuthSettingDetail{
+				PasswordLoginEnabled: false,
+				OIDCEnabled:          true,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateUpdateAuthSetting", ctx).Return(&req, nil)
+		thld.authService.On("UpdateAuthSetting", ctx, &req).Return(&domains.AuthSetting{PasswordLoginDisabled: true, OIDCEnabled: true}, nil)
+		thld.handler.UpdateAuthSetting(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("update auth setting error when call service fail", func(t *testing.T) {
+		req := dto.UpdateAuthSettingRequest{PasswordLoginEnabled: &disabled, UserID: "6476f457e64589e868aac981"}
+		errMsg := "Cannot disable password login while OIDC login is not configured"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestAuthHandler(t)
+		thld.authValidate.On("ValidateUpdateAuthSetting", ctx).Return(&req, nil)
+		thld.authService.On("UpdateAuthSetting", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.UpdateAuthSetting(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type candidateHandler struct {
+	candidateService  ports.CandidateService
+	candidateValidate ports.CandidateValidate
+}
+
+func NewCandidateHandler(candidateService ports.CandidateService, candidateValidate ports.CandidateValidate) ports.CandidateHandler {
+	return &candidateHandler{
+		candidateService:  candidateService,
+		candidateValidate: candidateValidate,
+	}
+}
+
+func (h *candidateHandler) GetCandidates(ctx *gin.Context) {
+	req, err := h.candidateValidate.ValidateGetCandidates(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 {
+		req.Limit = 20
+	}
+	data, err := h.candidateService.GetCandidates(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	candidates := make([]dto.CandidateDetail, len(data))
+	for i := 0; i < len(data); i++ {
+		candidates[i] = toCandidateDetail(&data[i])
+	}
+	size, hasNext := helpers.Paginate(&candidates, int64(req.Limit))
+	response := dto.GetCandidatesResponse{
+		StatusCode: http.StatusOK,
+		Data:       candidates,
+		Pagination: dto.Pagination{
+			Page:    uint32(req.Page),
+			Size:    uint32(size),
+			HasNext: hasNext,
+		},
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *candidateHandler) GetCandidate(ctx *gin.Context) {
+	id, err := h.candidateValidate.ValidateGetCandidate(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.candidateService.GetCandidate(ctx, id)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	candidate := toCandidateDetail(data)
+	candidate.Interviews = make([]dto.InterviewAppointment, len(data.Interviews))
+	for i := 0; i < len(data.Interviews); i++ {
+		interview := data.Interviews[i]
+		candidate.Interviews[i] = dto.InterviewAppointment{
+			ID:              interview.ID.Hex(),
+			Title:           interview.Title,
+			Description:     interview.Description,
+			Status:          interview.Status,
+			StartAt:         optionalTime(interview.StartAt),
+			EndAt:           optionalTime(interview.EndAt),
+			DurationMinutes: interview.DurationMinutes,
+			Timezone:        interview.Timezone,
+			Location:        interview.Location,
+			MeetingURL:      interview.MeetingURL,
+			Interviewers:    toInterviewers(interview.Interviewers),
+			CreateUser: dto.User{
+				Name:     interview.CreateUser.Name,
+				Email:    interview.CreateUser.Email,
+				ImageUrl: interview.CreateUser.ImageUrl,
+			},
+			CreatedAt: interview.CreatedAt,
+		}
+	}
+	response := dto.GetCandidateResponse{
+		StatusCode: http.StatusOK,
+		Data:       candidate,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *candidateHandler) CreateCandidate(ctx *gin.Context) {
+	req, err := h.candidateValidate.ValidateCreateCandidate(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.candidateService.CreateCandidate(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.CreateCandidateResponse{
+		StatusCode: http.StatusCreated,
+		Data:       toCandidateDetail(data),
+	}
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *candidateHandler) UpdateCandidate(ctx *gin.Context) {
+	req, err := h.candidateValidate.ValidateUpdateCandidate(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.candidateService.UpdateCandidate(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.GetCandidateResponse{
+		StatusCode: http.StatusOK,
+		Data:       toCandidateDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func toCandidateDetail(candidate *domains.Candidate) dto.CandidateDetail {
+	return dto.CandidateDetail{
+		ID:        candidate.ID.Hex(),
+		Name:      candidate.Name,
+		Email:     candidate.Email,
+		Phone:     candidate.Phone,
+		Position:  candidate.Position,
+		Stage:     candidate.Stage,
+		CreatedAt: candidate.CreatedAt,
+		UpdatedAt: candidate.UpdatedAt,
+	}
+}
@@ -0,0 +1,225 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/handlers"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testCandidateHandler struct {
+	candidateService  *mocks.CandidateService
+	candidateValidate *mocks.CandidateValidate
+	handler           ports.CandidateHandler
+}
+
+func newTestCandidateHandler(t *testing.T) testCandidateHandler {
+	candidateService := mocks.NewCandidateService(t)
+	candidateValidate := mocks.NewCandidateValidate(t)
+	handler := handlers.NewCandidateHandler(candidateService, candidateValidate)
+	return testCandidateHandler{candidateService, candidateValidate, handler}
+}
+
+var mockCandidate = domains.Candidate{
+	ID:        primitive.NewObjectID(),
+	Name:      "Candidate 1",
+	Email:     "candidate1@example.com",
+	Phone:     "+66 81 234 5678",
+	Position:  "Backend Engineer",
+	Stage:     constants.CANDIDATE_STAGE_SCREENING,
+	CreatedAt: now,
+	UpdatedAt: now,
+}
+
+func toCandidateDetail(candidate domains.Candidate) dto.CandidateDetail {
+	return dto.CandidateDetail{
+		ID:        candidate.ID.Hex(),
+		Name:      candidate.Name,
+		Email:     candidate.Email,
+		Phone:     candidate.Phone,
+		Position:  candidate.Position,
+		Stage:     candidate.Stage,
+		CreatedAt: candidate.CreatedAt,
+		UpdatedAt: candidate.UpdatedAt,
+	}
+}
+
+func TestGetCandidates(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("get candidates success", func(t *testing.T) {
+		req := dto.GetCandidatesRequest{
+			Page:  1,
+			Limit: 20,
+			Stage: constants.CANDIDATE_STAGE_SCREENING,
+		}
+		data := []domains.Candidate{mockCandidate}
+		res := dto.GetCandidatesResponse{
+			StatusCode: http.StatusOK,
+			Data:       []dto.CandidateDetail{toCandidateDetail(mockCandidate)},
+			Pagination: dto.Pagination{
+				Page: 1,
+				Size: 1,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestCandidateHandler(t)
+		thld.candidateValidate.On("ValidateGetCandidates", ctx).Return(&req, nil)
+		thld.candidateService.On("GetCandidates", ctx, &req).Return(data, nil)
+		thld.handler.GetCandidates(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+	t.Run("get candidates error when validate fail", func(t *testing.T) {
+		errMsg := "Invalid page query parameter"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestCandidateHandler(t)
+		thld.candidateValidate.On("ValidateGetCandidates", ctx).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.GetCandidates(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
+func TestGetCandidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("get candidate success with interview history", func(t *testing.T) {
+		data := mockCandidate
+		data.Interviews = []domains.InterviewAppointment{mockInterviewAppointment1}
+		candidate := toCandidateDetail(data)
+		candidate.Interviews = []dto.InterviewAppointment{
+			{
+				ID:          mockInterviewAppointment1.ID.Hex(),
+				Title:       mockInterviewAppointment1.Title,
+				Description: mockInterviewAppointment1.Description,
+				Status:      mockInterviewAppointment1.Status,
+				CreateUser: dto.User{
+					Name:     mockInterviewAppointment1.CreateUser.Name,
+					Email:    mockInterviewAppointment1.CreateUser.Email,
+					ImageUrl: mockInterviewAppointment1.CreateUser.ImageUrl,
+				},
+				CreatedAt: mockInterviewAppointment1.CreatedAt,
+			},
+		}
+		res := dto.GetCandidateResponse{
+			StatusCode: http.StatusOK,
+			Data:       candidate,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestCandidateHandler(t)
+		id := data.ID.Hex()
+		thld.candidateValidate.On("ValidateGetCandidate", ctx).Return(id, nil)
+		thld.candidateService.On("GetCandidate", ctx, id).Return(&data, nil)
+		thld.handler.GetCandidate(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+	t.Run("get candidate error when not found", func(t *testing.T) {
+		errMsg := "Candidate not found."
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestCandidateHandler(t)
+		id := mockCandidate.ID.Hex()
+		thld.candidateValidate.On("ValidateGetCandidate", ctx).Return(id, nil)
+		thld.candidateService.On("GetCandidate", ctx, id).Return(nil, helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.GetCandidate(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
+func TestCreateCandidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("create candidate success", func(t *testing.T) {
+		req := dto.CreateCandidateRequest{
+			Name:      mockCandidate.Name,
+			Email:     mockCandidate.Email,
+			Phone:     mockCandidate.Phone,
+			Position:  mockCandidate.Position,
+			CreatedBy: primitive.NewObjectID().Hex(),
+		}
+		res := dto.CreateCandidateResponse{
+			StatusCode: http.StatusCreated,
+			Data:       toCandidateDetail(mockCandidate),
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestCandidateHandler(t)
+		thld.candidateValidate.On("ValidateCreateCandidate", ctx).Return(&req, nil)
+		thld.candidateService.On("CreateCandidate", ctx, &req).Return(&mockCandidate, nil)
+		thld.handler.CreateCandidate(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
+func TestUpdateCandidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("update candidate success", func(t *testing.T) {
+		req := dto.UpdateCandidateRequest{
+			ID:    mockCandidate.ID.Hex(),
+			Stage: constants.CANDIDATE_STAGE_OFFER,
+		}
+		updated := mockCandidate
+		updated.Stage = constants.CANDIDATE_STAGE_OFFER
+		res := dto.GetCandidateResponse{
+			StatusCode: http.StatusOK,
+			Data:       toCandidateDetail(updated),
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestCandidateHandler(t)
+		thld.candidateValidate.On("ValidateUpdateCandidate", ctx).Return(&req, nil)
+		thld.candidateService.On("UpdateCandidate", ctx, &req).Return(&updated, nil)
+		thld.handler.UpdateCandidate(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+	t.Run("update candidate error when not found", func(t *testing.T) {
+		req := dto.UpdateCandidateRequest{
+			ID:    mockCandidate.ID.Hex(),
+			Stage: constants.CANDIDATE_STAGE_OFFER,
+		}
+		errMsg := "Candidate not found."
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestCandidateHandler(t)
+		thld.candidateValidate.On("ValidateUpdateCandidate", ctx).Return(&req, nil)
+		thld.candidateService.On("UpdateCandidate", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.UpdateCandidate(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
@@ -3,10 +3,14 @@ package handlers
 import (
 	"net/http"
 	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/dto"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type interviewHandler struct {
@@ -28,89 +32,139 @@ func (h *interviewHandler) GetInterviewAppointments(ctx *gin.Context) {
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
-	if req.Page < 1 {
-		req.Page = 1
-	}
 	if req.Limit < 1 {
 		req.Limit = 20
 	}
-	offset := (req.Page - 1) * req.Limit
-	limit := req.Limit + 1
-
-	data, err := h.interviewService.GetInterviewAppointments(ctx, uint32(offset), uint32(limit))
+	page, err := h.interviewService.GetInterviewAppointments(ctx, req)
 	if err != nil {
 		errRes := helpers.ErrorHandler(err)
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
+	data := page.Items
 	interviews := make([]dto.InterviewAppointment, len(data))
 	for i := 0; i < len(data); i++ {
 		interviews[i] = dto.InterviewAppointment{
-			ID:          data[i].ID.Hex(),
-			Title:       data[i].Title,
-			Description: data[i].Description,
-			Status:      data[i].Status,
+			ID:              data[i].ID.Hex(),
+			Title:           data[i].Title,
+			Description:     data[i].Description,
+			Status:          data[i].Status,
+			StartAt:         optionalTime(data[i].StartAt),
+			EndAt:           optionalTime(data[i].EndAt),
+			DurationMinutes: data[i].DurationMinutes,
+			Timezone:        data[i].Timezone,
+			Location:        data[i].Location,
+			MeetingURL:      data[i].MeetingURL,
+			Tags:            data[i].Tags,
+			Interviewers:    toInterviewers(data[i].Interviewers),
+			Candidate:       toCandidateSummary(data[i].Candidate),
 			CreateUser: dto.User{
 				Name:     data[i].CreateUser.Name,
 				Email:    data[i].CreateUser.Email,
 				ImageUrl: data[i].CreateUser.ImageUrl,
 			},
-			CreatedAt: data[i].CreatedAt,
+			CreatedAt:  data[i].CreatedAt,
+			ArchivedAt: optionalTime(data[i].ArchivedAt),
+			ArchivedBy: optionalObjectID(data[i].ArchivedBy),
 		}
 	}
-	size, hasNext := helpers.Paginate(&interviews, int64(req.Limit))
+	if links := interviewPageLinks(req, page); len(links) > 0 {
+		ctx.Header("Link", helpers.LinkHeader(ctx.Request.URL, links...))
+	}
 	response := dto.GetInterviewAppointmentsResponse{
 		StatusCode: http.StatusOK,
 		Data:       interviews,
-		Pagination: dto.Pagination{
-			Page:    uint32(req.Page),
-			Size:    uint32(size),
-			HasNext: hasNext,
+		Pagination: dto.ListPagination{
+			Page:       req.Page,
+			Size:       uint32(len(interviews)),
+			HasNext:    page.HasNext,
+			NextCursor: page.NextCursor,
+			PrevCursor: page.PrevCursor,
+			TotalCount: page.TotalCount,
 		},
 	}
 	ctx.JSON(http.StatusOK, response)
 }
+
+// interviewPageLinks returns the links to the pages around page. They use
+// page numbers when the list was requested by page, and cursors otherwise.
+func interviewPageLinks(req *dto.GetInterviewAppointmentsRequest, page *domains.InterviewAppointmentPage) []helpers.PageLink {
+	links := []helpers.PageLink{}
+	if req.Page > 0 {
+		if page.HasNext {
+			links = append(links, helpers.PageLink{Rel: "next", Query: map[string]string{"page": strconv.Itoa(int(req.Page) + 1)}})
+		}
+		if req.Page > 1 {
+			links = append(links,
+				helpers.PageLink{Rel: "prev", Query: map[string]string{"page": strconv.Itoa(int(req.Page) - 1)}},
+				helpers.PageLink{Rel: "first", Query: map[string]string{"page": "1"}},
+			)
+		}
+		return links
+	}
+	if page.NextCursor != "" {
+		links = append(links, helpers.PageLink{Rel: "next", Query: map[string]string{"cursor": page.NextCursor}})
+	}
+	if page.PrevCursor != "" {
+		links = append(links,
+			helpers.PageLink{Rel: "prev", Query: map[string]string{"cursor": page.PrevCursor}},
+			helpers.PageLink{Rel: "first", Query: map[string]string{"cursor": ""}},
+		)
+	}
+	return links
+}
+
 func (h *interviewHandler) GetInterviewAppointment(ctx *gin.Context) {
-	id, err := h.interviewValidate.ValidateGetInterviewAppointment(ctx)
+	req, err := h.interviewValidate.ValidateGetInterviewAppointment(ctx)
 	if err != nil {
 		errRes := helpers.ErrorHandler(err)
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
-	data, err := h.interviewService.GetInterviewAppointment(ctx, id)
+	data, err := h.interviewService.GetInterviewAppointment(ctx, req)
 	if err != nil {
 		errRes := helpers.ErrorHandler(err)
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
-
-	comments := []dto.InterviewComment{}
+	etag := interviewAppointmentETag(data)
+	ctx.Header("ETag", etag)
+	if req.IfNoneMatch != "" && helpers.ETagMatch(req.IfNoneMatch, etag) {
+		ctx.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+	comments := make([]dto.InterviewComment, len(data.Comments))
 	for i := 0; i < len(data.Comments); i++ {
-		if !data.Comments[i].ID.IsZero() {
-			comments = append(comments, dto.InterviewComment{
-				ID:      data.Comments[i].ID.Hex(),
-				Comment: data.Comments[i].Comment,
-				User: dto.User{
-					Name:     data.Comments[i].User.Name,
-					Email:    data.Comments[i].User.Email,
-					ImageUrl: data.Comments[i].User.ImageUrl,
-				},
-				CreatedAt: data.CreatedAt,
-			})
-		}
+		comments[i] = toInterviewComment(&data.Comments[i])
 	}
 	response := dto.GetInterviewAppointmentResponse{
 		StatusCode: http.StatusOK,
 		Data: dto.InterviewAppointmentDetail{
-			ID:          data.ID.Hex(),
-			Title:       data.Title,
-			Description: data.Description,
-			Status:      data.Status,
+			ID:               data.ID.Hex(),
+			Title:            data.Title,
+			Description:      data.Description,
+			Status:           data.Status,
+			StartAt:          optionalTime(data.StartAt),
+			EndAt:            optionalTime(data.EndAt),
+			DurationMinutes:  data.DurationMinutes,
+			Timezone:         data.Timezone,
+			Location:         data.Location,
+			MeetingURL:       data.MeetingURL,
+			Tags:             data.Tags,
+			Interviewers:     toInterviewers(data.Interviewers),
+			Candidate:        toCandidateSummary(data.Candidate),
+			StatusHistory:    toStatusHistory(data.StatusHistory),
+			TimeInStatus:     toTimeInStatus(data.TimeInStatus),
+			ScorecardSummary: toScorecardSummary(data.ScorecardSummary),
+			BlindFeedback:    data.BlindFeedback,
+			HiringManagerID:  optionalObjectID(data.HiringManagerID),
+			FeedbackHidden:   data.FeedbackHidden,
 			CreateUser: dto.User{
 				Name:     data.CreateUser.Name,
 				Email:    data.CreateUser.Email,
 				ImageUrl: data.CreateUser.ImageUrl,
 			},
+			Version:   data.Version,
 			CreatedAt: data.CreatedAt,
 			Comments:  comments,
 		},
@@ -118,6 +172,7 @@ func (h *interviewHandler) GetInterviewAppointment(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, response)
 }
+
 func (h *interviewHandler) CreateInterviewAppointment(ctx *gin.Context) {
 	req, err := h.interviewValidate.ValidateCreateInterviewAppointment(ctx)
 	if err != nil {
@@ -134,19 +189,34 @@ func (h *interviewHandler) CreateInterviewAppointment(ctx *gin.Context) {
 	response := dto.CreateInterviewAppointmentResponse{
 		StatusCode: http.StatusCreated,
 		Data: dto.InterviewAppointmentDetail{
-			ID:          data.ID.Hex(),
-			Title:       data.Title,
-			Description: data.Description,
-			Status:      data.Status,
+			ID:               data.ID.Hex(),
+			Title:            data.Title,
+			Description:      data.Description,
+			Status:           data.Status,
+			StartAt:          optionalTime(data.StartAt),
+			EndAt:            optionalTime(data.EndAt),
+			DurationMinutes:  data.DurationMinutes,
+			Timezone:         data.Timezone,
+			Location:         data.Location,
+			MeetingURL:       data.MeetingURL,
+			Tags:             data.Tags,
+			Interviewers:     toInterviewers(data.Interviewers),
+			Candidate:        toCandidateSummary(data.Candidate),
+			StatusHistory:    toStatusHistory(data.StatusHistory),
+			ScorecardSummary: toScorecardSummary(data.ScorecardSummary),
+			BlindFeedback:    data.BlindFeedback,
+			HiringManagerID:  optionalObjectID(data.HiringManagerID),
 			CreateUser: dto.User{
 				Name:     data.CreateUser.Name,
 				Email:    data.CreateUser.Email,
 				ImageUrl: data.CreateUser.ImageUrl,
 			},
+			Version:   data.Version,
 			CreatedAt: data.CreatedAt,
 			Comments:  []dto.InterviewComment{},
 		},
 	}
+	ctx.Header("ETag", helpers.ETag(data.Version))
 	ctx.JSON(http.StatusCreated, response)
 }
 
@@ -157,11 +227,13 @@ func (h *interviewHandler) UpdateInterviewAppointment(ctx *gin.Context) {
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
-	if err := h.interviewService.UpdateInterviewAppointment(ctx, req); err != nil {
+	version, err := h.interviewService.UpdateInterviewAppointment(ctx, req)
+	if err != nil {
 		errRes := helpers.ErrorHandler(err)
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
+	ctx.Header("ETag", helpers.ETag(version))
 	response := dto.BaseResponse{
 		StatusCode: http.StatusOK,
 		Message:    "success",
@@ -170,13 +242,32 @@ func (h *interviewHandler) UpdateInterviewAppointment(ctx *gin.Context) {
 }
 
 func (h *interviewHandler) ArchiveInterviewAppointment(ctx *gin.Context) {
-	id, err := h.interviewValidate.ValidateGetInterviewAppointment(ctx)
+	req, err := h.interviewValidate.ValidateArchiveInterviewAppointment(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.interviewService.ArchiveInterviewAppointment(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *interviewHandler) UnarchiveInterviewAppointment(ctx *gin.Context) {
+	req, err := h.interviewValidate.ValidateUnarchiveInterviewAppointment(ctx)
 	if err != nil {
 		errRes := helpers.ErrorHandler(err)
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
-	if err := h.interviewService.ArchiveInterviewAppointment(ctx, id); err != nil {
+	if err := h.interviewService.UnarchiveInterviewAppointment(ctx, req); err != nil {
 		errRes := helpers.ErrorHandler(err)
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
@@ -188,6 +279,121 @@ func (h *interviewHandler) ArchiveInterviewAppointment(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+func (h *interviewHandler) AssignInterviewers(ctx *gin.Context) {
+	req, err := h.interviewValidate.ValidateAssignInterviewers(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.interviewService.AssignInterviewers(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *interviewHandler) GetInterviewerAppointments(ctx *gin.Context) {
+	req, err := h.interviewValidate.ValidateGetInterviewerAppointments(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 {
+		req.Limit = 20
+	}
+	data, err := h.interviewService.GetInterviewerAppointments(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	interviews := make([]dto.InterviewAppointment, len(data))
+	for i := 0; i < len(data); i++ {
+		interviews[i] = dto.InterviewAppointment{
+			ID:              data[i].ID.Hex(),
+			Title:           data[i].Title,
+			Description:     data[i].Description,
+			Status:          data[i].Status,
+			StartAt:         optionalTime(data[i].StartAt),
+			EndAt:           optionalTime(data[i].EndAt),
+			DurationMinutes: data[i].DurationMinutes,
+			Timezone:        data[i].Timezone,
+			Location:        data[i].Location,
+			MeetingURL:      data[i].MeetingURL,
+			Tags:            data[i].Tags,
+			Interviewers:    toInterviewers(data[i].Interviewers),
+			Candidate:       toCandidateSummary(data[i].Candidate),
+			CreateUser: dto.User{
+				Name:     data[i].CreateUser.Name,
+				Email:    data[i].CreateUser.Email,
+				ImageUrl: data[i].CreateUser.ImageUrl,
+			},
+			CreatedAt:  data[i].CreatedAt,
+			ArchivedAt: optionalTime(data[i].ArchivedAt),
+			ArchivedBy: optionalObjectID(data[i].ArchivedBy),
+		}
+	}
+	size, hasNext := helpers.Paginate(&interviews, int64(req.Limit))
+	response := dto.GetInterviewAppointmentsResponse{
+		StatusCode: http.StatusOK,
+		Data:       interviews,
+		Pagination: dto.ListPagination{
+			Page:    req.Page,
+			Size:    uint32(size),
+			HasNext: hasNext,
+		},
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *interviewHandler) GetInterviewComments(ctx *gin.Context) {
+	req, err := h.interviewValidate.ValidateGetInterviewComments(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if req.Limit < 1 {
+		req.Limit = 20
+	}
+	data, err := h.interviewService.GetInterviewComments(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	size, hasNext := helpers.Paginate(&data, int64(req.Limit))
+	comments := make([]dto.InterviewComment, len(data))
+	for i := 0; i < len(data); i++ {
+		comments[i] = toInterviewComment(&data[i])
+	}
+	pagination := dto.CursorPagination{
+		Size:    uint32(size),
+		HasNext: hasNext,
+	}
+	if hasNext {
+		last := data[len(data)-1]
+		pagination.NextCursor = helpers.NewCreatedAtCursor(last.CreatedAt, last.ID.Hex(), false)
+		ctx.Header("Link", helpers.LinkHeader(ctx.Request.URL, helpers.PageLink{Rel: "next", Query: map[string]string{"cursor": pagination.NextCursor}}))
+	}
+	response := dto.GetInterviewCommentsResponse{
+		StatusCode: http.StatusOK,
+		Data:       comments,
+		Pagination: pagination,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (h *interviewHandler) AddInterviewComment(ctx *gin.Context) {
 	req, err := h.interviewValidate.ValidateAddInterviewComment(ctx)
 	if err != nil {
@@ -215,14 +421,259 @@ func (h *interviewHandler) UpdateInterviewComment(ctx *gin.Context) {
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
-	if err := h.interviewService.UpdateInterviewComment(ctx, req); err != nil {
+	version, err := h.interviewService.UpdateInterviewComment(ctx, req)
+	if err != nil {
 		errRes := helpers.ErrorHandler(err)
 		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
 		return
 	}
+	ctx.Header("ETag", helpers.ETag(version))
 	response := dto.BaseResponse{
 		StatusCode: http.StatusOK,
 		Message:    "success",
 	}
 	ctx.JSON(http.StatusOK, response)
 }
+
+func (h *interviewHandler) GetInterviewCommentRevisions(ctx *gin.Context) {
+	req, err := h.interviewValidate.ValidateGetInterviewCommentRevisions(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.interviewService.GetInterviewCommentRevisions(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	revisions := make([]dto.InterviewCommentRevision, len(data))
+	for i := 0; i < len(data); i++ {
+		revisions[i] = dto.InterviewCommentRevision{
+			Comment:   data[i].Comment,
+			CreatedAt: data[i].CreatedAt,
+		}
+		if data[i].User != nil {
+			revisions[i].User = &dto.User{
+				Name:     data[i].User.Name,
+				Email:    data[i].User.Email,
+				ImageUrl: data[i].User.ImageUrl,
+			}
+		}
+	}
+	response := dto.GetInterviewCommentRevisionsResponse{
+		StatusCode: http.StatusOK,
+		Data:       revisions,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *interviewHandler) DeleteInterviewComment(ctx *gin.Context) {
+	req, err := h.interviewValidate.ValidateDeleteInterviewComment(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.interviewService.DeleteInterviewComment(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// toInterviewComment hides the text of a deleted comment and returns the
+// tombstone instead.
+func toInterviewComment(comment *domains.InterviewComment) dto.InterviewComment {
+	res := dto.InterviewComment{
+		ID:      comment.ID.Hex(),
+		Comment: comment.Comment,
+		User: dto.User{
+			Name:     comment.User.Name,
+			Email:    comment.User.Email,
+			ImageUrl: comment.User.ImageUrl,
+		},
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		Edited:    len(comment.Revisions) > 0,
+		Version:   comment.Version,
+	}
+	if !comment.DeletedAt.IsZero() {
+		res.Comment = ""
+		res.Deleted = true
+		res.DeletedAt = &comment.DeletedAt
+		res.DeletedBy = comment.DeletedBy.Hex()
+	}
+	return res
+}
+
+// optionalTime maps an unset time to nil so it is left out of the response.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func optionalObjectID(id primitive.ObjectID) string {
+	if id.IsZero() {
+		return ""
+	}
+	return id.Hex()
+}
+
+func toInterviewers(users []domains.User) []dto.Interviewer {
+	if len(users) == 0 {
+		return nil
+	}
+	interviewers := make([]dto.Interviewer, len(users))
+	for i := 0; i < len(users); i++ {
+		interviewers[i] = dto.Interviewer{
+			ID:       users[i].ID.Hex(),
+			Name:     users[i].Name,
+			Email:    users[i].Email,
+			ImageUrl: users[i].ImageUrl,
+		}
+	}
+	return interviewers
+}
+
+func toCandidateSummary(candidate *domains.Candidate) *dto.CandidateSummary {
+	if candidate == nil || candidate.ID.IsZero() {
+		return nil
+	}
+	return &dto.CandidateSummary{
+		ID:       candidate.ID.Hex(),
+		Name:     candidate.Name,
+		Position: candidate.Position,
+		Stage:    candidate.Stage,
+	}
+}
+
+func (h *interviewHandler) GetWorkflow(ctx *gin.Context) {
+	data, err := h.interviewService.GetWorkflow(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.WorkflowResponse{
+		StatusCode: http.StatusOK,
+		Data:       toWorkflowDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *interviewHandler) UpdateWorkflow(ctx *gin.Context) {
+	req, err := h.interviewValidate.ValidateUpdateWorkflow(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.interviewService.UpdateWorkflow(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.WorkflowResponse{
+		StatusCode: http.StatusOK,
+		Data:       toWorkflowDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func toWorkflowDetail(workflow *domains.Workflow) dto.WorkflowDetail {
+	statuses := make([]dto.WorkflowStatus, len(workflow.Statuses))
+	for i := 0; i < len(workflow.Statuses); i++ {
+		statuses[i] = dto.WorkflowStatus{
+			Name:        workflow.Statuses[i].Name,
+			Transitions: workflow.Statuses[i].Transitions,
+		}
+	}
+	return dto.WorkflowDetail{
+		Statuses:      statuses,
+		InitialStatus: workflow.InitialStatus,
+		UpdatedAt:     optionalTime(workflow.UpdatedAt),
+	}
+}
+
+func toStatusHistory(history []domains.InterviewStatusChange) []dto.InterviewStatusChange {
+	if len(history) == 0 {
+		return nil
+	}
+	changes := make([]dto.InterviewStatusChange, len(history))
+	for i := 0; i < len(history); i++ {
+		changes[i] = dto.InterviewStatusChange{
+			From:      history[i].From,
+			To:        history[i].To,
+			ChangedBy: history[i].UserID.Hex(),
+			ChangedAt: history[i].ChangedAt,
+		}
+	}
+	return changes
+}
+
+// interviewAppointmentETag tags the appointment detail. Besides the version,
+// the detail depends on the comments and scorecards, which are written
+// without changing it, on whether feedback is hidden from the viewer, and on
+// the time in status, which is counted in whole minutes here.
+func interviewAppointmentETag(data *domains.InterviewAppointment) string {
+	parts := []string{strconv.FormatBool(data.FeedbackHidden)}
+	for _, c := range data.Comments {
+		parts = append(parts, "comment:"+c.ID.Hex()+":"+strconv.FormatInt(c.Version, 10)+":"+c.UpdatedAt.UTC().Format(time.RFC3339Nano))
+	}
+	for _, s := range data.Scorecards {
+		parts = append(parts, "scorecard:"+s.ID.Hex()+":"+s.UpdatedAt.UTC().Format(time.RFC3339Nano))
+	}
+	for _, d := range data.TimeInStatus {
+		parts = append(parts, "status:"+d.Status+":"+strconv.FormatInt(int64(d.Duration/time.Minute), 10))
+	}
+	return helpers.ContentETag(data.Version, parts...)
+}
+
+func toTimeInStatus(data []domains.StatusDuration) []dto.StatusDuration {
+	durations := make([]dto.StatusDuration, len(data))
+	for i, d := range data {
+		durations[i] = dto.StatusDuration{Status: d.Status, Seconds: int64(d.Duration / time.Second)}
+	}
+	return durations
+}
+
+func (h *interviewHandler) GetInterviewActivity(ctx *gin.Context) {
+	req, err := h.interviewValidate.ValidateGetInterviewActivity(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if req.Limit < 1 {
+		req.Limit = 20
+	}
+	data, err := h.interviewService.GetInterviewActivity(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	pagination := auditPagination(ctx, &data, req.Limit)
+	events := make([]dto.AuditEvent, len(data))
+	for i := range data {
+		events[i] = toAuditEvent(&data[i])
+		// Where a request came from is only shown in the audit log.
+		events[i].RequestID = ""
+		events[i].IPAddress = ""
+	}
+	ctx.JSON(http.StatusOK, dto.GetAuditEventsResponse{
+		StatusCode: http.StatusOK,
+		Data:       events,
+		Pagination: pagination,
+	})
+}
@@ -39,7 +39,6 @@ var (
 		ID:          primitive.NewObjectID(),
 		Title:       "Title 1",
 		Description: "Description 1",
-		Comments:    []domains.InterviewComment{},
 		Status:      "TODO",
 		IsArchived:  false,
 		CreateUser: domains.User{
@@ -58,9 +57,9 @@ var (
 		ID:          primitive.NewObjectID(),
 		Title:       "Title 2",
 		Description: "Description 2",
-		Comments:    []domains.InterviewComment{},
 		Status:      "TODO",
 		IsArchived:  false,
+		Tags:        []string{"backend", "senior"},
 		CreateUser: domains.User{
 			ID:       primitive.NewObjectID(),
 			Name:     "User name 2",
@@ -82,14 +81,6 @@ func TestGetInterviewAppointments(t *testing.T) {
 			Page:  1,
 			Limit: 20,
 		}
-		if req.Page < 1 {
-			req.Page = 1
-		}
-		if req.Limit < 1 {
-			req.Limit = 20
-		}
-		offset := (req.Page - 1) * req.Limit
-		limit := req.Limit + 1
 		data := []domains.InterviewAppointment{mockInterviewAppointment1, mockInterviewAppointment2}
 		interviews := make([]dto.InterviewAppointment, len(data))
 		for i := 0; i < len(data); i++ {
@@ -98,6 +89,7 @@ func TestGetInterviewAppointments(t *testing.T) {
 				Title:       data[i].Title,
 				Description: data[i].Description,
 				Status:      data[i].Status,
+				Tags:        data[i].Tags,
 				CreateUser: dto.User{
 					Name:     data[i].CreateUser.Name,
 					Email:    data[i].CreateUser.Email,
@@ -106,14 +98,13 @@ func TestGetInterviewAppointments(t *testing.T) {
 				CreatedAt: data[i].CreatedAt,
 			}
 		}
-		size, hasNext := helpers.Paginate(&interviews, int64(req.Limit))
 		res := dto.GetInterviewAppointmentsResponse{
 			StatusCode: http.StatusOK,
 			Data:       interviews,
-			Pagination: dto.Pagination{
-				Page:    uint32(req.Page),
-				Size:    uint32(size),
-				HasNext: hasNext,
+			Pagination: dto.ListPagination{
+				Page:    req.Page,
+				Size:    uint32(len(interviews)),
+				HasNext: false,
 			},
 		}
 
@@ -121,12 +112,56 @@ func TestGetInterviewAppointments(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
 		thld.interviewValidate.On("ValidateGetInterviewAppointments", ctx).Return(&req, nil)
-		thld.interviewService.On("GetInterviewAppointments", ctx, offset, limit).Return(data, nil)
+		thld.interviewService.On("GetInterviewAppointments", ctx, &req).Return(&domains.InterviewAppointmentPage{Items: data}, nil)
 		thld.handler.GetInterviewAppointments(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Equal(t, expected, got)
+		assert.Empty(t, w.Header().Get("Link"))
+	})
+	t.Run("get interview appointments page links", func(t *testing.T) {
+		req := dto.GetInterviewAppointmentsRequest{
+			Page:  2,
+			Limit: 1,
+		}
+		page := &domains.InterviewAppointmentPage{Items: []domains.InterviewAppointment{mockInterviewAppointment1}, HasNext: true}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request, _ = http.NewRequest(http.MethodGet, "/api/interviews?page=2&limit=1", nil)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewAppointments", ctx).Return(&req, nil)
+		thld.interviewService.On("GetInterviewAppointments", ctx, &req).Return(page, nil)
+		thld.handler.GetInterviewAppointments(ctx)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `</api/interviews?limit=1&page=3>; rel="next", </api/interviews?limit=1&page=1>; rel="prev", </api/interviews?limit=1&page=1>; rel="first"`, w.Header().Get("Link"))
+	})
+	t.Run("get interview appointments cursor mode", func(t *testing.T) {
+		req := dto.GetInterviewAppointmentsRequest{
+			Limit:      1,
+			Cursor:     "abc",
+			TotalCount: true,
+		}
+		total := int64(3)
+		page := &domains.InterviewAppointmentPage{
+			Items:      []domains.InterviewAppointment{mockInterviewAppointment1},
+			HasNext:    true,
+			NextCursor: "next",
+			PrevCursor: "prev",
+			TotalCount: &total,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request, _ = http.NewRequest(http.MethodGet, "/api/interviews?limit=1&cursor=abc&totalCount=true", nil)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewAppointments", ctx).Return(&req, nil)
+		thld.interviewService.On("GetInterviewAppointments", ctx, &req).Return(page, nil)
+		thld.handler.GetInterviewAppointments(ctx)
+		got := dto.GetInterviewAppointmentsResponse{}
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, dto.ListPagination{Size: 1, HasNext: true, NextCursor: "next", PrevCursor: "prev", TotalCount: &total}, got.Pagination)
+		assert.Equal(t, `</api/interviews?cursor=next&limit=1&totalCount=true>; rel="next", </api/interviews?cursor=prev&limit=1&totalCount=true>; rel="prev", </api/interviews?limit=1&totalCount=true>; rel="first"`, w.Header().Get("Link"))
 	})
 	t.Run("get interview appointments error when validate fail", func(t *testing.T) {
 		req := dto.GetInterviewAppointmentsRequest{
@@ -153,14 +188,6 @@ func TestGetInterviewAppointments(t *testing.T) {
 			Page:  1,
 			Limit: 20,
 		}
-		if req.Page < 1 {
-			req.Page = 1
-		}
-		if req.Limit < 1 {
-			req.Limit = 20
-		}
-		offset := (req.Page - 1) * req.Limit
-		limit := req.Limit + 1
 
 		errMsg := "Cannot get interview appointment"
 		res := &dto.ErrorResponse{
@@ -171,7 +198,7 @@ func TestGetInterviewAppointments(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
 		thld.interviewValidate.On("ValidateGetInterviewAppointments", ctx).Return(&req, nil)
-		thld.interviewService.On("GetInterviewAppointments", ctx, offset, limit).Return(nil, helpers.NewCustomError(http.StatusInternalServerError, errMsg))
+		thld.interviewService.On("GetInterviewAppointments", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusInternalServerError, errMsg))
 		thld.handler.GetInterviewAppointments(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
@@ -184,54 +211,20 @@ func TestGetInterviewAppointment(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	t.Run("get interview appointment success", func(t *testing.T) {
 		data := mockInterviewAppointment1
-		data.Comments = append(data.Comments, []domains.InterviewComment{
-			{
-				ID:      primitive.NewObjectID(),
-				Comment: "comment",
-				User: domains.User{
-					ID:       primitive.NewObjectID(),
-					Name:     "User name 1",
-					Email:    "User email 1",
-					Username: "Username 1",
-					Password: "Password 1",
-					ImageUrl: "https://image-url.com",
-					Role:     "ADMIN",
-				},
-				CreatedAt: now,
-				UpdatedAt: now,
-			},
-			{
-				ID:      primitive.NewObjectID(),
-				Comment: "comment 2",
-				User: domains.User{
-					ID:       primitive.NewObjectID(),
-					Name:     "User name 2",
-					Email:    "User email 2",
-					Username: "Username 2",
-					Password: "Password 2",
-					ImageUrl: "https://image-url.com",
-					Role:     "STAFF",
-				},
-				CreatedAt: now,
-				UpdatedAt: now,
-			},
-		}...)
-
-		comments := []dto.InterviewComment{}
-		for i := 0; i < len(data.Comments); i++ {
-			if !data.Comments[i].ID.IsZero() {
-				comments = append(comments, dto.InterviewComment{
-					ID:      data.Comments[i].ID.Hex(),
-					Comment: data.Comments[i].Comment,
-					User: dto.User{
-						Name:     data.Comments[i].User.Name,
-						Email:    data.Comments[i].User.Email,
-						ImageUrl: data.Comments[i].User.ImageUrl,
-					},
-					CreatedAt: data.CreatedAt,
-				})
-			}
+		changedBy := primitive.NewObjectID()
+		data.Status = "IN_PROGRESS"
+		data.StatusHistory = []domains.InterviewStatusChange{
+			{To: "TODO", UserID: changedBy, ChangedAt: now.Add(-2 * time.Hour)},
+			{From: "TODO", To: "IN_PROGRESS", UserID: changedBy, ChangedAt: now.Add(-time.Hour)},
+		}
+		data.TimeInStatus = []domains.StatusDuration{
+			{Status: "TODO", Duration: time.Hour},
+			{Status: "IN_PROGRESS", Duration: time.Hour + 30*time.Second},
+		}
+		data.Comments = []domains.InterviewComment{
+			{ID: primitive.NewObjectID(), Comment: "comment 1", UserID: data.CreateUser.ID, User: data.CreateUser, CreatedAt: now, UpdatedAt: now},
 		}
+
 		res := dto.GetInterviewAppointmentResponse{
 			StatusCode: http.StatusOK,
 			Data: dto.InterviewAppointmentDetail{
@@ -239,26 +232,144 @@ func TestGetInterviewAppointment(t *testing.T) {
 				Title:       data.Title,
 				Description: data.Description,
 				Status:      data.Status,
+				StatusHistory: []dto.InterviewStatusChange{
+					{To: "TODO", ChangedBy: changedBy.Hex(), ChangedAt: data.StatusHistory[0].ChangedAt},
+					{From: "TODO", To: "IN_PROGRESS", ChangedBy: changedBy.Hex(), ChangedAt: data.StatusHistory[1].ChangedAt},
+				},
+				TimeInStatus: []dto.StatusDuration{
+					{Status: "TODO", Seconds: 3600},
+					{Status: "IN_PROGRESS", Seconds: 3630},
+				},
 				CreateUser: dto.User{
 					Name:     data.CreateUser.Name,
 					Email:    data.CreateUser.Email,
 					ImageUrl: data.CreateUser.ImageUrl,
 				},
 				CreatedAt: data.CreatedAt,
-				Comments:  comments,
+				Comments: []dto.InterviewComment{{
+					ID:        data.Comments[0].ID.Hex(),
+					Comment:   data.Comments[0].Comment,
+					User:      dto.User{Name: data.CreateUser.Name, Email: data.CreateUser.Email, ImageUrl: data.CreateUser.ImageUrl},
+					CreatedAt: now,
+					UpdatedAt: now,
+				}},
 			},
 		}
 
 		w := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
-		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(data.ID.Hex(), nil)
-		thld.interviewService.On("GetInterviewAppointment", ctx, data.ID.Hex()).Return(&data, nil)
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: data.CreateUser.ID.Hex(), Role: "ADMIN"}
+		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewAppointment", ctx, req).Return(&data, nil)
 		thld.handler.GetInterviewAppointment(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Equal(t, expected, got)
+		assert.Regexp(t, `^"0-[0-9a-f]{16}"$`, w.Header().Get("ETag"))
+	})
+	// get runs the detail request for data with an If-None-Match header.
+	get := func(t *testing.T, data domains.InterviewAppointment, ifNoneMatch string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: data.CreateUser.ID.Hex(), Role: "ADMIN", IfNoneMatch: ifNoneMatch}
+		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewAppointment", ctx, req).Return(&data, nil)
+		thld.handler.GetInterviewAppointment(ctx)
+		return w
+	}
+	t.Run("get interview appointment not modified", func(t *testing.T) {
+		data := mockInterviewAppointment1
+		data.Version = 3
+		etag := get(t, data, "").Header().Get("ETag")
+		w := get(t, data, etag)
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+		assert.Empty(t, w.Body.Bytes())
+	})
+	t.Run("get interview appointment modified after a new comment", func(t *testing.T) {
+		data := mockInterviewAppointment1
+		data.Version = 3
+		etag := get(t, data, "").Header().Get("ETag")
+		data.Comments = []domains.InterviewComment{
+			{ID: primitive.NewObjectID(), Comment: "comment 1", UserID: data.CreateUser.ID, User: data.CreateUser, CreatedAt: now, UpdatedAt: now},
+		}
+		w := get(t, data, etag)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, etag, w.Header().Get("ETag"))
+	})
+	t.Run("get interview appointment modified after a scorecard", func(t *testing.T) {
+		data := mockInterviewAppointment1
+		etag := get(t, data, "").Header().Get("ETag")
+		data.Scorecards = []domains.Scorecard{{ID: primitive.NewObjectID(), InterviewerID: data.CreateUser.ID, UpdatedAt: now}}
+		w := get(t, data, etag)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("get interview appointment tag differs when feedback is hidden", func(t *testing.T) {
+		data := mockInterviewAppointment1
+		etag := get(t, data, "").Header().Get("ETag")
+		data.FeedbackHidden = true
+		w := get(t, data, etag)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("get interview appointment when If-None-Match is stale", func(t *testing.T) {
+		data := mockInterviewAppointment1
+		data.Version = 4
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: data.CreateUser.ID.Hex(), Role: "ADMIN", IfNoneMatch: `"3"`}
+		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewAppointment", ctx, req).Return(&data, nil)
+		thld.handler.GetInterviewAppointment(ctx)
+		decoded := dto.GetInterviewAppointmentResponse{}
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &decoded))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Regexp(t, `^"4-[0-9a-f]{16}"$`, w.Header().Get("ETag"))
+		assert.Equal(t, int64(4), decoded.Data.Version)
+	})
+	t.Run("get interview appointment with scorecard summary", func(t *testing.T) {
+		data := mockInterviewAppointment1
+		average := func(v float64) *float64 { return &v }
+		data.ScorecardSummary = &domains.ScorecardSummary{
+			TemplateID:   primitive.NewObjectID(),
+			TemplateName: "Backend onsite",
+			RatingScale:  domains.ScorecardRatingScale{Min: 1, Max: 4},
+			Submitted:    2,
+			Expected:     3,
+			AverageScore: average(2.75),
+			Competencies: []domains.CompetencyScore{
+				{Competency: "Coding", AverageScore: average(3.5), Ratings: 2},
+				{Competency: "Culture", Ratings: 0},
+			},
+			Recommendations: map[string]int{"STRONG_NO": 0, "NO": 0, "YES": 1, "STRONG_YES": 1},
+		}
+		expected := &dto.ScorecardSummary{
+			TemplateID:   data.ScorecardSummary.TemplateID.Hex(),
+			TemplateName: "Backend onsite",
+			RatingScale:  dto.ScorecardRatingScale{Min: 1, Max: 4},
+			Submitted:    2,
+			Expected:     3,
+			AverageScore: average(2.75),
+			Competencies: []dto.CompetencyScore{
+				{Competency: "Coding", AverageScore: average(3.5), Ratings: 2},
+				{Competency: "Culture", Ratings: 0},
+			},
+			Recommendations: map[string]int{"STRONG_NO": 0, "NO": 0, "YES": 1, "STRONG_YES": 1},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		req := &dto.GetInterviewAppointmentRequest{ID: data.ID.Hex(), UserID: data.CreateUser.ID.Hex(), Role: "ADMIN"}
+		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewAppointment", ctx, req).Return(&data, nil)
+		thld.handler.GetInterviewAppointment(ctx)
+		decoded := dto.GetInterviewAppointmentResponse{}
+		json.Unmarshal(w.Body.Bytes(), &decoded)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, decoded.Data.ScorecardSummary)
 	})
 	t.Run("get interview appointment error when validate fail", func(t *testing.T) {
 		errMsg := "id: Missing required field"
@@ -270,7 +381,7 @@ func TestGetInterviewAppointment(t *testing.T) {
 		w := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
-		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return("", helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
 
 		thld.handler.GetInterviewAppointment(ctx)
 		expected, _ := json.Marshal(res)
@@ -289,8 +400,9 @@ func TestGetInterviewAppointment(t *testing.T) {
 		w := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
-		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(id, nil)
-		thld.interviewService.On("GetInterviewAppointment", ctx, id).Return(nil, helpers.NewCustomError(http.StatusNotFound, errMsg))
+		req := &dto.GetInterviewAppointmentRequest{ID: id, UserID: "6476f457e64589e868aac977", Role: "ADMIN"}
+		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewAppointment", ctx, req).Return(nil, helpers.NewCustomError(http.StatusNotFound, errMsg))
 		thld.handler.GetInterviewAppointment(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
@@ -336,6 +448,58 @@ func TestCreateInterviewAppointment(t *testing.T) {
 		assert.Equal(t, http.StatusCreated, w.Code)
 		assert.Equal(t, expected, got)
 	})
+	t.Run("create interview appointment with schedule", func(t *testing.T) {
+		startAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		endAt := startAt.Add(time.Hour)
+		req := dto.CreateInterviewAppointmentRequest{
+			Title:           mockInterviewAppointment1.Title,
+			Description:     mockInterviewAppointment1.Description,
+			StartAt:         &startAt,
+			EndAt:           &endAt,
+			DurationMinutes: 60,
+			Timezone:        "Asia/Bangkok",
+			MeetingURL:      "https://meet.example.com/abc",
+			CreatedBy:       mockInterviewAppointment1.CreateUser.ID.Hex(),
+		}
+		data := mockInterviewAppointment1
+		data.StartAt = startAt
+		data.EndAt = endAt
+		data.DurationMinutes = 60
+		data.Timezone = "Asia/Bangkok"
+		data.MeetingURL = "https://meet.example.com/abc"
+		res := dto.CreateInterviewAppointmentResponse{
+			StatusCode: http.StatusCreated,
+			Data: dto.InterviewAppointmentDetail{
+				ID:              data.ID.Hex(),
+				Title:           data.Title,
+				Description:     data.Description,
+				Status:          data.Status,
+				StartAt:         &startAt,
+				EndAt:           &endAt,
+				DurationMinutes: 60,
+				Timezone:        "Asia/Bangkok",
+				MeetingURL:      "https://meet.example.com/abc",
+				CreateUser: dto.User{
+					Name:     data.CreateUser.Name,
+					Email:    data.CreateUser.Email,
+					ImageUrl: data.CreateUser.ImageUrl,
+				},
+				CreatedAt: data.CreatedAt,
+				Comments:  []dto.InterviewComment{},
+			},
+		}
+
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateCreateInterviewAppointment", ctx).Return(&req, nil)
+		thld.interviewService.On("CreateInterviewAppointment", ctx, &req).Return(&data, nil)
+		thld.handler.CreateInterviewAppointment(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, expected, got)
+	})
 	t.Run("create interview appointment error when validate fail", func(t *testing.T) {
 		errMsg := "Invalid input parameter"
 		res := &dto.ErrorResponse{
@@ -394,12 +558,13 @@ func TestUpdateInterviewAppointment(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
 		thld.interviewValidate.On("ValidateUpdateInterviewAppointment", ctx).Return(&req, nil)
-		thld.interviewService.On("UpdateInterviewAppointment", ctx, &req).Return(nil)
+		thld.interviewService.On("UpdateInterviewAppointment", ctx, &req).Return(int64(4), nil)
 		thld.handler.UpdateInterviewAppointment(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Equal(t, expected, got)
+		assert.Equal(t, `"4"`, w.Header().Get("ETag"))
 	})
 	t.Run("update interview appointment error when validate fail", func(t *testing.T) {
 		errMsg := "at least one field required"
@@ -433,7 +598,7 @@ func TestUpdateInterviewAppointment(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
 		thld.interviewValidate.On("ValidateUpdateInterviewAppointment", ctx).Return(&req, nil)
-		thld.interviewService.On("UpdateInterviewAppointment", ctx, &req).Return(helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.interviewService.On("UpdateInterviewAppointment", ctx, &req).Return(int64(0), helpers.NewCustomError(http.StatusNotFound, errMsg))
 		thld.handler.UpdateInterviewAppointment(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
@@ -442,10 +607,204 @@ func TestUpdateInterviewAppointment(t *testing.T) {
 	})
 }
 
+func TestUnarchiveInterviewAppointment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("unarchive interview appointment success", func(t *testing.T) {
+		req := &dto.UnarchiveInterviewAppointmentRequest{
+			ID:     "6476f457e64589e868aac981",
+			UserID: "6476f457e64589e868aac982",
+			Role:   "STAFF",
+		}
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateUnarchiveInterviewAppointment", ctx).Return(req, nil)
+		thld.interviewService.On("UnarchiveInterviewAppointment", ctx, req).Return(nil)
+		thld.handler.UnarchiveInterviewAppointment(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+	t.Run("unarchive interview appointment error when not archived", func(t *testing.T) {
+		req := &dto.UnarchiveInterviewAppointmentRequest{
+			ID:     "6476f457e64589e868aac981",
+			UserID: "6476f457e64589e868aac982",
+			Role:   "STAFF",
+		}
+		errMsg := "Archived interview appointment not found"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateUnarchiveInterviewAppointment", ctx).Return(req, nil)
+		thld.interviewService.On("UnarchiveInterviewAppointment", ctx, req).Return(helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.UnarchiveInterviewAppointment(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
+func TestAssignInterviewers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("assign interviewers success", func(t *testing.T) {
+		req := &dto.AssignInterviewersRequest{
+			ID:             "6476f457e64589e868aac981",
+			InterviewerIDs: []string{"6476f457e64589e868aac982"},
+		}
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateAssignInterviewers", ctx).Return(req, nil)
+		thld.interviewService.On("AssignInterviewers", ctx, req).Return(nil)
+		thld.handler.AssignInterviewers(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("assign interviewers error when validate fail", func(t *testing.T) {
+		errMsg := "interviewerIds: Missing required field"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateAssignInterviewers", ctx).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.AssignInterviewers(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("assign interviewers error when interviewer is double-booked", func(t *testing.T) {
+		req := &dto.AssignInterviewersRequest{
+			ID:             "6476f457e64589e868aac981",
+			InterviewerIDs: []string{"6476f457e64589e868aac982"},
+		}
+		conflicts := []dto.InterviewConflict{
+			{
+				ID:             "6476f457e64589e868aac983",
+				Title:          "Other interview",
+				StartAt:        time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC),
+				EndAt:          time.Date(2023, 7, 10, 3, 0, 0, 0, time.UTC),
+				InterviewerIDs: []string{"6476f457e64589e868aac982"},
+			},
+		}
+		errMsg := "Interviewer is already booked at this time"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusConflict,
+			Error:      errMsg,
+			Details:    conflicts,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateAssignInterviewers", ctx).Return(req, nil)
+		thld.interviewService.On("AssignInterviewers", ctx, req).Return(helpers.NewCustomErrorWithDetails(http.StatusConflict, errMsg, conflicts))
+		thld.handler.AssignInterviewers(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestGetInterviewerAppointments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("get interviewer appointments success", func(t *testing.T) {
+		req := dto.GetInterviewerAppointmentsRequest{
+			InterviewerID: "6476f457e64589e868aac982",
+		}
+		data := mockInterviewAppointment1
+		data.StartAt = time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		data.EndAt = data.StartAt.Add(time.Hour)
+		data.DurationMinutes = 60
+		data.Timezone = "UTC"
+		data.Interviewers = []domains.User{{ID: primitive.NewObjectID(), Name: "Interviewer", Email: "interviewer@example.com"}}
+		res := dto.GetInterviewAppointmentsResponse{
+			StatusCode: http.StatusOK,
+			Data: []dto.InterviewAppointment{
+				{
+					ID:              data.ID.Hex(),
+					Title:           data.Title,
+					Description:     data.Description,
+					Status:          data.Status,
+					StartAt:         &data.StartAt,
+					EndAt:           &data.EndAt,
+					DurationMinutes: 60,
+					Timezone:        "UTC",
+					Interviewers: []dto.Interviewer{
+						{ID: data.Interviewers[0].ID.Hex(), Name: "Interviewer", Email: "interviewer@example.com"},
+					},
+					CreateUser: dto.User{
+						Name:     data.CreateUser.Name,
+						Email:    data.CreateUser.Email,
+						ImageUrl: data.CreateUser.ImageUrl,
+					},
+					CreatedAt: data.CreatedAt,
+				},
+			},
+			Pagination: dto.ListPagination{
+				Page:    1,
+				Size:    1,
+				HasNext: false,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewerAppointments", ctx).Return(&req, nil)
+		thld.interviewService.On("GetInterviewerAppointments", ctx, &req).Return([]domains.InterviewAppointment{data}, nil)
+		thld.handler.GetInterviewerAppointments(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get interviewer appointments error when call service fail", func(t *testing.T) {
+		req := dto.GetInterviewerAppointmentsRequest{
+			InterviewerID: "6476f457e64589e868aac982",
+		}
+		errMsg := "Cannot get interview appointment."
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewerAppointments", ctx).Return(&req, nil)
+		thld.interviewService.On("GetInterviewerAppointments", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusInternalServerError, errMsg))
+		thld.handler.GetInterviewerAppointments(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
 func TestArchiveInterviewAppointment(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	t.Run("archive interview appointment success", func(t *testing.T) {
-		id := "6476f457e64589e868aac981"
+		req := &dto.ArchiveInterviewAppointmentRequest{
+			ID:     "6476f457e64589e868aac981",
+			UserID: "6476f457e64589e868aac982",
+			Role:   "STAFF",
+		}
 		res := dto.BaseResponse{
 			StatusCode: http.StatusOK,
 			Message:    "success",
@@ -453,8 +812,8 @@ func TestArchiveInterviewAppointment(t *testing.T) {
 		w := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
-		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(id, nil)
-		thld.interviewService.On("ArchiveInterviewAppointment", ctx, id).Return(nil)
+		thld.interviewValidate.On("ValidateArchiveInterviewAppointment", ctx).Return(req, nil)
+		thld.interviewService.On("ArchiveInterviewAppointment", ctx, req).Return(nil)
 		thld.handler.ArchiveInterviewAppointment(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
@@ -470,7 +829,7 @@ func TestArchiveInterviewAppointment(t *testing.T) {
 		w := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
-		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return("", helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.interviewValidate.On("ValidateArchiveInterviewAppointment", ctx).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
 		thld.handler.ArchiveInterviewAppointment(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
@@ -478,7 +837,11 @@ func TestArchiveInterviewAppointment(t *testing.T) {
 		assert.Equal(t, expected, got)
 	})
 	t.Run("archive interview appointment error when call service fail", func(t *testing.T) {
-		id := "6476f457e64589e868aac981"
+		req := &dto.ArchiveInterviewAppointmentRequest{
+			ID:     "6476f457e64589e868aac981",
+			UserID: "6476f457e64589e868aac982",
+			Role:   "STAFF",
+		}
 		errMsg := "Interview appointment not found or archived"
 		res := &dto.ErrorResponse{
 			StatusCode: http.StatusNotFound,
@@ -487,8 +850,8 @@ func TestArchiveInterviewAppointment(t *testing.T) {
 		w := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
-		thld.interviewValidate.On("ValidateGetInterviewAppointment", ctx).Return(id, nil)
-		thld.interviewService.On("ArchiveInterviewAppointment", ctx, id).Return(helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.interviewValidate.On("ValidateArchiveInterviewAppointment", ctx).Return(req, nil)
+		thld.interviewService.On("ArchiveInterviewAppointment", ctx, req).Return(helpers.NewCustomError(http.StatusNotFound, errMsg))
 		thld.handler.ArchiveInterviewAppointment(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
@@ -497,6 +860,111 @@ func TestArchiveInterviewAppointment(t *testing.T) {
 	})
 }
 
+func TestGetInterviewComments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := &dto.GetInterviewCommentsRequest{
+		ID:     "6476f457e64589e868aac981",
+		Limit:  2,
+		UserID: "6476f457e64589e868aac982",
+		Role:   "STAFF",
+	}
+	user := domains.User{ID: primitive.NewObjectID(), Name: "User name 1", Email: "User email 1", ImageUrl: "https://image-url.com"}
+	data := []domains.InterviewComment{
+		{ID: primitive.NewObjectID(), Comment: "comment 1", UserID: user.ID, User: user, CreatedAt: now, UpdatedAt: now},
+		{
+			ID:        primitive.NewObjectID(),
+			Comment:   "comment 2",
+			UserID:    user.ID,
+			User:      user,
+			CreatedAt: now,
+			UpdatedAt: now.Add(time.Hour),
+			Revisions: []domains.InterviewCommentRevision{{Comment: "comment", UserID: user.ID, CreatedAt: now}},
+		},
+		{ID: primitive.NewObjectID(), Comment: "comment 3", UserID: user.ID, User: user, CreatedAt: now, UpdatedAt: now},
+	}
+	t.Run("get interview comments success with next cursor", func(t *testing.T) {
+		items := append([]domains.InterviewComment{}, data...)
+		res := dto.GetInterviewCommentsResponse{
+			StatusCode: http.StatusOK,
+			Data: []dto.InterviewComment{
+				{
+					ID:        data[0].ID.Hex(),
+					Comment:   data[0].Comment,
+					User:      dto.User{Name: user.Name, Email: user.Email, ImageUrl: user.ImageUrl},
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+				{
+					ID:        data[1].ID.Hex(),
+					Comment:   data[1].Comment,
+					User:      dto.User{Name: user.Name, Email: user.Email, ImageUrl: user.ImageUrl},
+					CreatedAt: now,
+					UpdatedAt: now.Add(time.Hour),
+					Edited:    true,
+				},
+			},
+			Pagination: dto.CursorPagination{
+				Size:       2,
+				NextCursor: helpers.NewCreatedAtCursor(now, data[1].ID.Hex(), false),
+				HasNext:    true,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request, _ = http.NewRequest(http.MethodGet, "/api/interviews/6476f457e64589e868aac981/comments?limit=2", nil)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewComments", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewComments", ctx, req).Return(items, nil)
+		thld.handler.GetInterviewComments(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+		assert.Equal(t, `</api/interviews/6476f457e64589e868aac981/comments?cursor=`+res.Pagination.NextCursor+`&limit=2>; rel="next"`, w.Header().Get("Link"))
+	})
+	t.Run("get interview comments with deleted comment", func(t *testing.T) {
+		deleterId := primitive.NewObjectID()
+		items := []domains.InterviewComment{{
+			ID:        primitive.NewObjectID(),
+			Comment:   "Wrong candidate",
+			User:      domains.User{ID: primitive.NewObjectID(), Name: "Author"},
+			DeletedAt: now.UTC().Truncate(time.Second),
+			DeletedBy: deleterId,
+		}}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewComments", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewComments", ctx, req).Return(items, nil)
+		thld.handler.GetInterviewComments(ctx)
+		decoded := dto.GetInterviewCommentsResponse{}
+		json.Unmarshal(w.Body.Bytes(), &decoded)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, decoded.Data, 1)
+		assert.Equal(t, "", decoded.Data[0].Comment)
+		assert.True(t, decoded.Data[0].Deleted)
+		assert.Equal(t, deleterId.Hex(), decoded.Data[0].DeletedBy)
+		assert.Equal(t, items[0].DeletedAt, *decoded.Data[0].DeletedAt)
+		assert.False(t, decoded.Pagination.HasNext)
+		assert.Empty(t, decoded.Pagination.NextCursor)
+	})
+	t.Run("get interview comments error when interview appointment not found", func(t *testing.T) {
+		errMsg := "Interview appointment not found."
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewComments", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewComments", ctx, req).Return(nil, helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.GetInterviewComments(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
 func TestAddInterviewComment(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	t.Run("add interview comment success", func(t *testing.T) {
@@ -577,12 +1045,13 @@ func TestUpdateInterviewComment(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
 		thld.interviewValidate.On("ValidateUpdateInterviewComment", ctx).Return(&req, nil)
-		thld.interviewService.On("UpdateInterviewComment", ctx, &req).Return(nil)
+		thld.interviewService.On("UpdateInterviewComment", ctx, &req).Return(int64(2), nil)
 		thld.handler.UpdateInterviewComment(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Equal(t, expected, got)
+		assert.Equal(t, `"2"`, w.Header().Get("ETag"))
 	})
 	t.Run("update interview comment error when validate fail", func(t *testing.T) {
 		errMsg := "id: Missing required field"
@@ -616,7 +1085,7 @@ func TestUpdateInterviewComment(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(w)
 		thld := newTestInterviewHandler(t)
 		thld.interviewValidate.On("ValidateUpdateInterviewComment", ctx).Return(&req, nil)
-		thld.interviewService.On("UpdateInterviewComment", ctx, &req).Return(helpers.NewCustomError(http.StatusForbidden, errMsg))
+		thld.interviewService.On("UpdateInterviewComment", ctx, &req).Return(int64(0), helpers.NewCustomError(http.StatusForbidden, errMsg))
 		thld.handler.UpdateInterviewComment(ctx)
 		expected, _ := json.Marshal(res)
 		got := w.Body.Bytes()
@@ -624,3 +1093,154 @@ func TestUpdateInterviewComment(t *testing.T) {
 		assert.Equal(t, expected, got)
 	})
 }
+
+func TestDeleteInterviewComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("delete interview comment success", func(t *testing.T) {
+		req := &dto.DeleteInterviewCommentRequest{
+			ID:        "6476f457e64589e868aac981",
+			CommentID: "6476f457e64589e868aac983",
+			UserID:    "6476f457e64589e868aac982",
+			Role:      "STAFF",
+		}
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateDeleteInterviewComment", ctx).Return(req, nil)
+		thld.interviewService.On("DeleteInterviewComment", ctx, req).Return(nil)
+		thld.handler.DeleteInterviewComment(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+	t.Run("delete interview comment error when not the author", func(t *testing.T) {
+		req := &dto.DeleteInterviewCommentRequest{
+			ID:        "6476f457e64589e868aac981",
+			CommentID: "6476f457e64589e868aac983",
+			UserID:    "6476f457e64589e868aac982",
+			Role:      "STAFF",
+		}
+		errMsg := "You don't have permission to delete this comment"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateDeleteInterviewComment", ctx).Return(req, nil)
+		thld.interviewService.On("DeleteInterviewComment", ctx, req).Return(helpers.NewCustomError(http.StatusForbidden, errMsg))
+		thld.handler.DeleteInterviewComment(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
+func TestGetInterviewCommentRevisions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := &dto.GetInterviewCommentRevisionsRequest{
+		ID:        "6476f457e64589e868aac981",
+		CommentID: "6476f457e64589e868aac983",
+		UserID:    "6476f457e64589e868aac982",
+		Role:      "STAFF",
+	}
+	t.Run("get interview comment revisions success", func(t *testing.T) {
+		user := domains.User{ID: primitive.NewObjectID(), Name: "User name 1", Email: "User email 1", ImageUrl: "https://image-url.com"}
+		data := []domains.InterviewCommentRevision{
+			{Comment: "comment 1", UserID: user.ID, CreatedAt: now, User: &user},
+			{Comment: "comment 2", UserID: primitive.NewObjectID(), CreatedAt: now.Add(time.Hour)},
+		}
+		res := dto.GetInterviewCommentRevisionsResponse{
+			StatusCode: http.StatusOK,
+			Data: []dto.InterviewCommentRevision{
+				{Comment: "comment 1", User: &dto.User{Name: user.Name, Email: user.Email, ImageUrl: user.ImageUrl}, CreatedAt: now},
+				{Comment: "comment 2", CreatedAt: now.Add(time.Hour)},
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewCommentRevisions", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewCommentRevisions", ctx, req).Return(data, nil)
+		thld.handler.GetInterviewCommentRevisions(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+	t.Run("get interview comment revisions error when comment not found", func(t *testing.T) {
+		errMsg := "Interview comment not found."
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewCommentRevisions", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewCommentRevisions", ctx, req).Return(nil, helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.GetInterviewCommentRevisions(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
+func TestGetInterviewActivity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := &dto.GetInterviewActivityRequest{
+		ID:     "6476f457e64589e868aac981",
+		Limit:  20,
+		UserID: "6476f457e64589e868aac982",
+		Role:   "STAFF",
+	}
+	t.Run("get interview activity success", func(t *testing.T) {
+		commentId := primitive.NewObjectID()
+		items := []domains.AuditEvent{{
+			ID:         primitive.NewObjectID(),
+			ActorID:    primitive.NewObjectID(),
+			Action:     "comment.create",
+			TargetType: "comment",
+			TargetID:   commentId,
+			Changes:    []domains.AuditChange{{Field: "comment", After: "comment"}},
+			RequestID:  "req-1",
+			IPAddress:  "10.0.0.1",
+			CreatedAt:  now,
+		}}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewActivity", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewActivity", ctx, req).Return(items, nil)
+		thld.handler.GetInterviewActivity(ctx)
+		decoded := dto.GetAuditEventsResponse{}
+		json.Unmarshal(w.Body.Bytes(), &decoded)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, decoded.Data, 1)
+		assert.Equal(t, commentId.Hex(), decoded.Data[0].TargetID)
+		assert.Equal(t, "comment", decoded.Data[0].Changes[0].After)
+		assert.Empty(t, decoded.Data[0].RequestID)
+		assert.Empty(t, decoded.Data[0].IPAddress)
+		assert.False(t, decoded.Pagination.HasNext)
+	})
+	t.Run("get interview activity error when interview appointment not found", func(t *testing.T) {
+		errMsg := "Interview appointment not found."
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestInterviewHandler(t)
+		thld.interviewValidate.On("ValidateGetInterviewActivity", ctx).Return(req, nil)
+		thld.interviewService.On("GetInterviewActivity", ctx, req).Return(nil, helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.GetInterviewActivity(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type scorecardHandler struct {
+	scorecardService  ports.ScorecardService
+	scorecardValidate ports.ScorecardValidate
+}
+
+func NewScorecardHandler(scorecardService ports.ScorecardService, scorecardValidate ports.ScorecardValidate) ports.ScorecardHandler {
+	return &scorecardHandler{
+		scorecardService:  scorecardService,
+		scorecardValidate: scorecardValidate,
+	}
+}
+
+func (h *scorecardHandler) GetScorecardTemplates(ctx *gin.Context) {
+	req, err := h.scorecardValidate.ValidateGetScorecardTemplates(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 {
+		req.Limit = 20
+	}
+	data, err := h.scorecardService.GetScorecardTemplates(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	templates := make([]dto.ScorecardTemplateDetail, len(data))
+	for i := 0; i < len(data); i++ {
+		templates[i] = toScorecardTemplateDetail(&data[i])
+	}
+	size, hasNext := helpers.Paginate(&templates, int64(req.Limit))
+	response := dto.GetScorecardTemplatesResponse{
+		StatusCode: http.StatusOK,
+		Data:       templates,
+		Pagination: dto.Pagination{
+			Page:    uint32(req.Page),
+			Size:    uint32(size),
+			HasNext: hasNext,
+		},
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *scorecardHandler) GetScorecardTemplate(ctx *gin.Context) {
+	id, err := h.scorecardValidate.ValidateGetScorecardTemplate(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.scorecardService.GetScorecardTemplate(ctx, id)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.ScorecardTemplateResponse{
+		StatusCode: http.StatusOK,
+		Data:       toScorecardTemplateDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *scorecardHandler) CreateScorecardTemplate(ctx *gin.Context) {
+	req, err := h.scorecardValidate.ValidateCreateScorecardTemplate(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.scorecardService.CreateScorecardTemplate(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.ScorecardTemplateResponse{
+		StatusCode: http.StatusCreated,
+		Data:       toScorecardTemplateDetail(data),
+	}
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *scorecardHandler) ArchiveScorecardTemplate(ctx *gin.Context) {
+	req, err := h.scorecardValidate.ValidateArchiveScorecardTemplate(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.scorecardService.ArchiveScorecardTemplate(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.ScorecardTemplateResponse{
+		StatusCode: http.StatusOK,
+		Data:       toScorecardTemplateDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *scorecardHandler) GetScorecards(ctx *gin.Context) {
+	req, err := h.scorecardValidate.ValidateGetScorecards(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.scorecardService.GetScorecards(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	scorecards := make([]dto.ScorecardDetail, len(data))
+	for i := 0; i < len(data); i++ {
+		scorecards[i] = toScorecardDetail(&data[i])
+	}
+	response := dto.GetScorecardsResponse{
+		StatusCode: http.StatusOK,
+		Data:       scorecards,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *scorecardHandler) SubmitScorecard(ctx *gin.Context) {
+	req, err := h.scorecardValidate.ValidateSubmitScorecard(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.scorecardService.SubmitScorecard(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.ScorecardResponse{
+		StatusCode: http.StatusCreated,
+		Data:       toScorecardDetail(data),
+	}
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (h *scorecardHandler) UpdateScorecard(ctx *gin.Context) {
+	req, err := h.scorecardValidate.ValidateUpdateScorecard(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.scorecardService.UpdateScorecard(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.ScorecardResponse{
+		StatusCode: http.StatusOK,
+		Data:       toScorecardDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func toScorecardTemplateDetail(template *domains.ScorecardTemplate) dto.ScorecardTemplateDetail {
+	competencies := make([]dto.ScorecardCompetency, len(template.Competencies))
+	for i, competency := range template.Competencies {
+		competencies[i] = dto.ScorecardCompetency{
+			Name:        competency.Name,
+			Description: competency.Description,
+		}
+	}
+	questions := make([]dto.ScorecardQuestion, len(template.Questions))
+	for i, question := range template.Questions {
+		questions[i] = dto.ScorecardQuestion{
+			ID:       question.ID.Hex(),
+			Text:     question.Text,
+			Required: question.Required,
+		}
+	}
+	return dto.ScorecardTemplateDetail{
+		ID:           template.ID.Hex(),
+		Name:         template.Name,
+		Competencies: competencies,
+		RatingScale: dto.ScorecardRatingScale{
+			Min: template.RatingScale.Min,
+			Max: template.RatingScale.Max,
+		},
+		Questions:  questions,
+		IsArchived: template.IsArchived,
+		CreatedAt:  template.CreatedAt,
+	}
+}
+
+func toScorecardDetail(scorecard *domains.Scorecard) dto.ScorecardDetail {
+	ratings := make([]dto.ScorecardRating, len(scorecard.Ratings))
+	for i, rating := range scorecard.Ratings {
+		ratings[i] = dto.ScorecardRating{
+			Competency: rating.Competency,
+			Score:      rating.Score,
+			Note:       rating.Note,
+		}
+	}
+	answers := make([]dto.ScorecardAnswer, len(scorecard.Answers))
+	for i, answer := range scorecard.Answers {
+		answers[i] = dto.ScorecardAnswer{
+			QuestionID: answer.QuestionID.Hex(),
+			Answer:     answer.Answer,
+		}
+	}
+	detail := dto.ScorecardDetail{
+		ID:             scorecard.ID.Hex(),
+		TemplateID:     scorecard.TemplateID.Hex(),
+		Ratings:        ratings,
+		Answers:        answers,
+		Recommendation: scorecard.Recommendation,
+		SubmittedAt:    scorecard.SubmittedAt,
+		UpdatedAt:      scorecard.UpdatedAt,
+		LockedAt:       scorecard.LockedAt,
+	}
+	if scorecard.Interviewer != nil {
+		detail.Interviewer = &dto.Interviewer{
+			ID:       scorecard.Interviewer.ID.Hex(),
+			Name:     scorecard.Interviewer.Name,
+			Email:    scorecard.Interviewer.Email,
+			ImageUrl: scorecard.Interviewer.ImageUrl,
+		}
+	}
+	return detail
+}
+
+func toScorecardSummary(data *domains.ScorecardSummary) *dto.ScorecardSummary {
+	if data == nil {
+		return nil
+	}
+	competencies := make([]dto.CompetencyScore, len(data.Competencies))
+	for i, competency := range data.Competencies {
+		competencies[i] = dto.CompetencyScore{
+			Competency:   competency.Competency,
+			AverageScore: competency.AverageScore,
+			Ratings:      competency.Ratings,
+		}
+	}
+	return &dto.ScorecardSummary{
+		TemplateID:   data.TemplateID.Hex(),
+		TemplateName: data.TemplateName,
+		RatingScale: dto.ScorecardRatingScale{
+			Min: data.RatingScale.Min,
+			Max: data.RatingScale.Max,
+		},
+		Submitted:       data.Submitted,
+		Expected:        data.Expected,
+		AverageScore:    data.AverageScore,
+		Competencies:    competencies,
+		Recommendations: data.Recommendations,
+	}
+}
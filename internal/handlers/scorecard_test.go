@@ -0,0 +1,193 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/handlers"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testScorecardHandler struct {
+	scorecardService  *mocks.ScorecardService
+	scorecardValidate *mocks.ScorecardValidate
+	handler           ports.ScorecardHandler
+}
+
+func newTestScorecardHandler(t *testing.T) testScorecardHandler {
+	scorecardService := mocks.NewScorecardService(t)
+	scorecardValidate := mocks.NewScorecardValidate(t)
+	handler := handlers.NewScorecardHandler(scorecardService, scorecardValidate)
+	return testScorecardHandler{scorecardService, scorecardValidate, handler}
+}
+
+var (
+	mockScorecardTemplate = domains.ScorecardTemplate{
+		ID:           primitive.NewObjectID(),
+		Name:         "Backend onsite",
+		Competencies: []domains.ScorecardCompetency{{Name: "Coding", Description: "Writes working code"}},
+		RatingScale:  domains.ScorecardRatingScale{Min: 1, Max: 4},
+		Questions:    []domains.ScorecardQuestion{{ID: primitive.NewObjectID(), Text: "What stood out?", Required: true}},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	mockScorecard = domains.Scorecard{
+		ID:            primitive.NewObjectID(),
+		AppointmentID: mockInterviewAppointment1.ID,
+		TemplateID:    mockScorecardTemplate.ID,
+		InterviewerID: primitive.NewObjectID(),
+		Ratings:       []domains.ScorecardRating{{Competency: "Coding", Score: 3}},
+		Answers: []domains.ScorecardAnswer{
+			{QuestionID: mockScorecardTemplate.Questions[0].ID, Answer: "Clear thinking"},
+		},
+		Recommendation: "YES",
+		SubmittedAt:    now,
+		UpdatedAt:      now,
+		LockedAt:       now.Add(24 * time.Hour),
+	}
+)
+
+func TestCreateScorecardTemplate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("create scorecard template success", func(t *testing.T) {
+		req := dto.CreateScorecardTemplateRequest{Name: "Backend onsite"}
+		res := dto.ScorecardTemplateResponse{
+			StatusCode: http.StatusCreated,
+			Data: dto.ScorecardTemplateDetail{
+				ID:           mockScorecardTemplate.ID.Hex(),
+				Name:         "Backend onsite",
+				Competencies: []dto.ScorecardCompetency{{Name: "Coding", Description: "Writes working code"}},
+				RatingScale:  dto.ScorecardRatingScale{Min: 1, Max: 4},
+				Questions: []dto.ScorecardQuestion{
+					{ID: mockScorecardTemplate.Questions[0].ID.Hex(), Text: "What stood out?", Required: true},
+				},
+				CreatedAt: now,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestScorecardHandler(t)
+		thld.scorecardValidate.On("ValidateCreateScorecardTemplate", ctx).Return(&req, nil)
+		thld.scorecardService.On("CreateScorecardTemplate", ctx, &req).Return(&mockScorecardTemplate, nil)
+		thld.handler.CreateScorecardTemplate(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+	t.Run("create scorecard template error when validate fail", func(t *testing.T) {
+		errMsg := "competencies: Missing required field"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestScorecardHandler(t)
+		thld.scorecardValidate.On("ValidateCreateScorecardTemplate", ctx).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.CreateScorecardTemplate(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
+func TestGetScorecards(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("get scorecards success", func(t *testing.T) {
+		data := mockScorecard
+		data.Interviewer = &domains.User{ID: data.InterviewerID, Name: "Interviewer", Email: "interviewer@example.com"}
+		res := dto.GetScorecardsResponse{
+			StatusCode: http.StatusOK,
+			Data: []dto.ScorecardDetail{{
+				ID:         data.ID.Hex(),
+				TemplateID: data.TemplateID.Hex(),
+				Interviewer: &dto.Interviewer{
+					ID:    data.InterviewerID.Hex(),
+					Name:  "Interviewer",
+					Email: "interviewer@example.com",
+				},
+				Ratings:        []dto.ScorecardRating{{Competency: "Coding", Score: 3}},
+				Answers:        []dto.ScorecardAnswer{{QuestionID: data.Answers[0].QuestionID.Hex(), Answer: "Clear thinking"}},
+				Recommendation: "YES",
+				SubmittedAt:    data.SubmittedAt,
+				UpdatedAt:      data.UpdatedAt,
+				LockedAt:       data.LockedAt,
+			}},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestScorecardHandler(t)
+		req := &dto.GetScorecardsRequest{ID: mockInterviewAppointment1.ID.Hex(), UserID: data.InterviewerID.Hex(), Role: "INTERVIEWER"}
+		thld.scorecardValidate.On("ValidateGetScorecards", ctx).Return(req, nil)
+		thld.scorecardService.On("GetScorecards", ctx, req).Return([]domains.Scorecard{data}, nil)
+		thld.handler.GetScorecards(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
+func TestSubmitScorecard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("submit scorecard success", func(t *testing.T) {
+		req := dto.SubmitScorecardRequest{ID: mockInterviewAppointment1.ID.Hex()}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestScorecardHandler(t)
+		thld.scorecardValidate.On("ValidateSubmitScorecard", ctx).Return(&req, nil)
+		thld.scorecardService.On("SubmitScorecard", ctx, &req).Return(&mockScorecard, nil)
+		thld.handler.SubmitScorecard(ctx)
+		decoded := dto.ScorecardResponse{}
+		json.Unmarshal(w.Body.Bytes(), &decoded)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, mockScorecard.ID.Hex(), decoded.Data.ID)
+		assert.Nil(t, decoded.Data.Interviewer)
+	})
+	t.Run("submit scorecard error when already submitted", func(t *testing.T) {
+		req := dto.SubmitScorecardRequest{ID: mockInterviewAppointment1.ID.Hex()}
+		errMsg := "You have already submitted a scorecard for this interview appointment"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusConflict,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestScorecardHandler(t)
+		thld.scorecardValidate.On("ValidateSubmitScorecard", ctx).Return(&req, nil)
+		thld.scorecardService.On("SubmitScorecard", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusConflict, errMsg))
+		thld.handler.SubmitScorecard(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
+
+func TestUpdateScorecard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("update scorecard error when locked", func(t *testing.T) {
+		req := dto.UpdateScorecardRequest{ID: mockInterviewAppointment1.ID.Hex(), ScorecardID: mockScorecard.ID.Hex()}
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusConflict,
+			Error:      "Scorecard is locked",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestScorecardHandler(t)
+		thld.scorecardValidate.On("ValidateUpdateScorecard", ctx).Return(&req, nil)
+		thld.scorecardService.On("UpdateScorecard", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusConflict, "Scorecard is locked"))
+		thld.handler.UpdateScorecard(ctx)
+		expected, _ := json.Marshal(res)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, expected, w.Body.Bytes())
+	})
+}
@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type userHandler struct {
+	userService  ports.UserService
+	userValidate ports.UserValidate
+}
+
+func NewUserHandler(userService ports.UserService, userValidate ports.UserValidate) ports.UserHandler {
+	return &userHandler{
+		userService:  userService,
+		userValidate: userValidate,
+	}
+}
+
+func (h *userHandler) GetUsers(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateGetUsers(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 {
+		req.Limit = 20
+	}
+	data, err := h.userService.GetUsers(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	users := make([]dto.UserDetail, len(data))
+	for i := 0; i < len(data); i++ {
+		users[i] = toUserDetail(&data[i])
+	}
+	size, hasNext := helpers.Paginate(&users, int64(req.Limit))
+	response := dto.GetUsersResponse{
+		StatusCode: http.StatusOK,
+		Data:       users,
+		Pagination: dto.Pagination{
+			Page:    req.Page,
+			Size:    uint32(size),
+			HasNext: hasNext,
+		},
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) GetUser(ctx *gin.Context) {
+	id, err := h.userValidate.ValidateGetUser(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.userService.GetUser(ctx, id)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.GetUserResponse{
+		StatusCode: http.StatusOK,
+		Data:       toUserDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) UpdateUser(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateUpdateUser(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.userService.UpdateUser(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.GetUserResponse{
+		StatusCode: http.StatusOK,
+		Data:       toUserDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) UpdateUserRole(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateUpdateUserRole(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.userService.UpdateUserRole(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) DeactivateUser(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateUpdateUserStatus(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.userService.DeactivateUser(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) ReactivateUser(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateUpdateUserStatus(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.userService.ReactivateUser(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) UnlockUser(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateUnlockUser(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.userService.UnlockUser(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) GetMe(ctx *gin.Context) {
+	id, err := h.userValidate.ValidateGetMe(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.userService.GetUser(ctx, id)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.GetUserResponse{
+		StatusCode: http.StatusOK,
+		Data:       toUserDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) UpdateMe(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateUpdateMe(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.userService.UpdateUser(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.GetUserResponse{
+		StatusCode: http.StatusOK,
+		Data:       toUserDetail(data),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) ChangePassword(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateChangePassword(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.userService.ChangePassword(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) EnrollMFA(ctx *gin.Context) {
+	id, err := h.userValidate.ValidateGetMe(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	enrollment, err := h.userService.EnrollMFA(ctx, id)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.MFAEnrollResponse{
+		StatusCode: http.StatusOK,
+		Secret:     enrollment.Secret,
+		URI:        enrollment.URI,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) ConfirmMFA(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateMFACode(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	codes, err := h.userService.ConfirmMFA(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.RecoveryCodesResponse{
+		StatusCode:    http.StatusOK,
+		RecoveryCodes: codes,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) DisableMFA(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateMFACode(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	if err := h.userService.DisableMFA(ctx, req); err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.BaseResponse{
+		StatusCode: http.StatusOK,
+		Message:    "success",
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *userHandler) CreateServiceAccount(ctx *gin.Context) {
+	req, err := h.userValidate.ValidateCreateServiceAccount(ctx)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	data, err := h.userService.CreateServiceAccount(ctx, req)
+	if err != nil {
+		errRes := helpers.ErrorHandler(err)
+		ctx.AbortWithStatusJSON(errRes.StatusCode, errRes)
+		return
+	}
+	response := dto.GetUserResponse{
+		StatusCode: http.StatusCreated,
+		Data:       toUserDetail(data),
+	}
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func toUserDetail(user *domains.User) dto.UserDetail {
+	return dto.UserDetail{
+		ID:               user.ID.Hex(),
+		Name:             user.Name,
+		Email:            user.Email,
+		Username:         user.Username,
+		ImageUrl:         user.ImageUrl,
+		Role:             user.Role,
+		IsDeactivated:    user.IsDeactivated,
+		MFAEnabled:       user.MFAEnabled,
+		IsServiceAccount: user.IsServiceAccount,
+	}
+}
@@ -0,0 +1,574 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/core/ports/mocks"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/handlers"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testUserHandler struct {
+	userService  *mocks.UserService
+	userValidate *mocks.UserValidate
+	handler      ports.UserHandler
+}
+
+func newTestUserHandler(t *testing.T) testUserHandler {
+	userService := mocks.NewUserService(t)
+	userValidate := mocks.NewUserValidate(t)
+	handler := handlers.NewUserHandler(userService, userValidate)
+	return testUserHandler{userService, userValidate, handler}
+}
+
+var mockUser = domains.User{
+	ID:       primitive.NewObjectID(),
+	Name:     "User name 1",
+	Email:    "User email 1",
+	Username: "Username 1",
+	Password: "Password 1",
+	ImageUrl: "https://image-url.com",
+	Role:     "STAFF",
+}
+
+func TestGetUsers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("get users success", func(t *testing.T) {
+		req := dto.GetUsersRequest{
+			Page:  1,
+			Limit: 1,
+		}
+		second := mockUser
+		second.ID = primitive.NewObjectID()
+		data := []domains.User{mockUser, second}
+		res := dto.GetUsersResponse{
+			StatusCode: http.StatusOK,
+			Data: []dto.UserDetail{
+				{
+					ID:       mockUser.ID.Hex(),
+					Name:     mockUser.Name,
+					Email:    mockUser.Email,
+					Username: mockUser.Username,
+					ImageUrl: mockUser.ImageUrl,
+					Role:     mockUser.Role,
+				},
+			},
+			Pagination: dto.Pagination{
+				Page:    1,
+				Size:    1,
+				HasNext: true,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateGetUsers", ctx).Return(&req, nil)
+		thld.userService.On("GetUsers", ctx, &req).Return(data, nil)
+		thld.handler.GetUsers(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get users error when validate fail", func(t *testing.T) {
+		errMsg := "Invalid page query parameter"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateGetUsers", ctx).Return(nil, helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.GetUsers(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestGetUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("get user success", func(t *testing.T) {
+		res := dto.GetUserResponse{
+			StatusCode: http.StatusOK,
+			Data: dto.UserDetail{
+				ID:       mockUser.ID.Hex(),
+				Name:     mockUser.Name,
+				Email:    mockUser.Email,
+				Username: mockUser.Username,
+				ImageUrl: mockUser.ImageUrl,
+				Role:     mockUser.Role,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateGetUser", ctx).Return(mockUser.ID.Hex(), nil)
+		thld.userService.On("GetUser", ctx, mockUser.ID.Hex()).Return(&mockUser, nil)
+		thld.handler.GetUser(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("get user error when call service fail", func(t *testing.T) {
+		errMsg := "User not found."
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateGetUser", ctx).Return(mockUser.ID.Hex(), nil)
+		thld.userService.On("GetUser", ctx, mockUser.ID.Hex()).Return(nil, helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.GetUser(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestUpdateUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("update user success", func(t *testing.T) {
+		req := dto.UpdateUserRequest{
+			ID:   mockUser.ID.Hex(),
+			Name: "New name",
+		}
+		updated := mockUser
+		updated.Name = "New name"
+		res := dto.GetUserResponse{
+			StatusCode: http.StatusOK,
+			Data: dto.UserDetail{
+				ID:       updated.ID.Hex(),
+				Name:     updated.Name,
+				Email:    updated.Email,
+				Username: updated.Username,
+				ImageUrl: updated.ImageUrl,
+				Role:     updated.Role,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateUpdateUser", ctx).Return(&req, nil)
+		thld.userService.On("UpdateUser", ctx, &req).Return(&updated, nil)
+		thld.handler.UpdateUser(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("update user role success", func(t *testing.T) {
+		req := dto.UpdateUserRoleRequest{
+			ID:     mockUser.ID.Hex(),
+			Role:   "ADMIN",
+			UserID: primitive.NewObjectID().Hex(),
+		}
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateUpdateUserRole", ctx).Return(&req, nil)
+		thld.userService.On("UpdateUserRole", ctx, &req).Return(nil)
+		thld.handler.UpdateUserRole(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("update user role error when call service fail", func(t *testing.T) {
+		req := dto.UpdateUserRoleRequest{
+			ID:     mockUser.ID.Hex(),
+			Role:   "ADMIN",
+			UserID: mockUser.ID.Hex(),
+		}
+		errMsg := "You cannot change your own role"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateUpdateUserRole", ctx).Return(&req, nil)
+		thld.userService.On("UpdateUserRole", ctx, &req).Return(helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.UpdateUserRole(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestDeactivateUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("deactivate user success", func(t *testing.T) {
+		req := dto.UpdateUserStatusRequest{
+			ID:     mockUser.ID.Hex(),
+			UserID: primitive.NewObjectID().Hex(),
+		}
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateUpdateUserStatus", ctx).Return(&req, nil)
+		thld.userService.On("DeactivateUser", ctx, &req).Return(nil)
+		thld.handler.DeactivateUser(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestReactivateUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("reactivate user success", func(t *testing.T) {
+		req := dto.UpdateUserStatusRequest{
+			ID:     mockUser.ID.Hex(),
+			UserID: primitive.NewObjectID().Hex(),
+		}
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateUpdateUserStatus", ctx).Return(&req, nil)
+		thld.userService.On("ReactivateUser", ctx, &req).Return(nil)
+		thld.handler.ReactivateUser(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestUnlockUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := &dto.UpdateUserStatusRequest{ID: mockUser.ID.Hex(), UserID: primitive.NewObjectID().Hex()}
+	t.Run("unlock user success", func(t *testing.T) {
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateUnlockUser", ctx).Return(req, nil)
+		thld.userService.On("UnlockUser", ctx, req).Return(nil)
+		thld.handler.UnlockUser(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("unlock user error when call service fail", func(t *testing.T) {
+		errMsg := "User not found."
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateUnlockUser", ctx).Return(req, nil)
+		thld.userService.On("UnlockUser", ctx, req).Return(helpers.NewCustomError(http.StatusNotFound, errMsg))
+		thld.handler.UnlockUser(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestGetMe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("get me success", func(t *testing.T) {
+		res := dto.GetUserResponse{
+			StatusCode: http.StatusOK,
+			Data: dto.UserDetail{
+				ID:       mockUser.ID.Hex(),
+				Name:     mockUser.Name,
+				Email:    mockUser.Email,
+				Username: mockUser.Username,
+				ImageUrl: mockUser.ImageUrl,
+				Role:     mockUser.Role,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateGetMe", ctx).Return(mockUser.ID.Hex(), nil)
+		thld.userService.On("GetUser", ctx, mockUser.ID.Hex()).Return(&mockUser, nil)
+		thld.handler.GetMe(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestUpdateMe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("update me success", func(t *testing.T) {
+		req := dto.UpdateUserRequest{
+			ID:   mockUser.ID.Hex(),
+			Name: "New name",
+		}
+		updated := mockUser
+		updated.Name = "New name"
+		res := dto.GetUserResponse{
+			StatusCode: http.StatusOK,
+			Data: dto.UserDetail{
+				ID:       updated.ID.Hex(),
+				Name:     updated.Name,
+				Email:    updated.Email,
+				Username: updated.Username,
+				ImageUrl: updated.ImageUrl,
+				Role:     updated.Role,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateUpdateMe", ctx).Return(&req, nil)
+		thld.userService.On("UpdateUser", ctx, &req).Return(&updated, nil)
+		thld.handler.UpdateMe(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := dto.ChangePasswordRequest{
+		OldPassword: "1234567890",
+		NewPassword: "0987654321",
+		UserID:      mockUser.ID.Hex(),
+		SessionID:   primitive.NewObjectID().Hex(),
+	}
+	t.Run("change password success", func(t *testing.T) {
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateChangePassword", ctx).Return(&req, nil)
+		thld.userService.On("ChangePassword", ctx, &req).Return(nil)
+		thld.handler.ChangePassword(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("change password error when call service fail", func(t *testing.T) {
+		errMsg := "Old password is incorrect"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateChangePassword", ctx).Return(&req, nil)
+		thld.userService.On("ChangePassword", ctx, &req).Return(helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.ChangePassword(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestEnrollMFA(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("enroll mfa success", func(t *testing.T) {
+		enrollment := &domains.TOTPEnrollment{
+			Secret: "JBSWY3DPEHPK3PXP",
+			URI:    "otpauth://totp/robinhood:Username?secret=JBSWY3DPEHPK3PXP",
+		}
+		res := dto.MFAEnrollResponse{
+			StatusCode: http.StatusOK,
+			Secret:     enrollment.Secret,
+			URI:        enrollment.URI,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateGetMe", ctx).Return(mockUser.ID.Hex(), nil)
+		thld.userService.On("EnrollMFA", ctx, mockUser.ID.Hex()).Return(enrollment, nil)
+		thld.handler.EnrollMFA(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestConfirmMFA(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := dto.MFACodeRequest{
+		Code:   "123456",
+		UserID: mockUser.ID.Hex(),
+	}
+	t.Run("confirm mfa success", func(t *testing.T) {
+		codes := []string{"abcde-fghij", "klmno-pqrst"}
+		res := dto.RecoveryCodesResponse{
+			StatusCode:    http.StatusOK,
+			RecoveryCodes: codes,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateMFACode", ctx).Return(&req, nil)
+		thld.userService.On("ConfirmMFA", ctx, &req).Return(codes, nil)
+		thld.handler.ConfirmMFA(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("confirm mfa error when call service fail", func(t *testing.T) {
+		errMsg := "Invalid verification code"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateMFACode", ctx).Return(&req, nil)
+		thld.userService.On("ConfirmMFA", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusUnauthorized, errMsg))
+		thld.handler.ConfirmMFA(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestDisableMFA(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := dto.MFACodeRequest{
+		Code:   "123456",
+		UserID: mockUser.ID.Hex(),
+	}
+	t.Run("disable mfa success", func(t *testing.T) {
+		res := dto.BaseResponse{
+			StatusCode: http.StatusOK,
+			Message:    "success",
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateMFACode", ctx).Return(&req, nil)
+		thld.userService.On("DisableMFA", ctx, &req).Return(nil)
+		thld.handler.DisableMFA(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("disable mfa error when mfa is mandatory", func(t *testing.T) {
+		errMsg := "Two-factor authentication is required for admins"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateMFACode", ctx).Return(&req, nil)
+		thld.userService.On("DisableMFA", ctx, &req).Return(helpers.NewCustomError(http.StatusBadRequest, errMsg))
+		thld.handler.DisableMFA(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestCreateServiceAccount(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	req := dto.CreateServiceAccountRequest{
+		Name:     "Interview sync",
+		Username: "interview-sync",
+		Role:     "STAFF",
+	}
+	t.Run("create service account success", func(t *testing.T) {
+		serviceAccount := domains.User{
+			ID:               primitive.NewObjectID(),
+			Name:             req.Name,
+			Username:         req.Username,
+			Role:             req.Role,
+			IsServiceAccount: true,
+		}
+		res := dto.GetUserResponse{
+			StatusCode: http.StatusCreated,
+			Data: dto.UserDetail{
+				ID:               serviceAccount.ID.Hex(),
+				Name:             serviceAccount.Name,
+				Username:         serviceAccount.Username,
+				Role:             serviceAccount.Role,
+				IsServiceAccount: true,
+			},
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateCreateServiceAccount", ctx).Return(&req, nil)
+		thld.userService.On("CreateServiceAccount", ctx, &req).Return(&serviceAccount, nil)
+		thld.handler.CreateServiceAccount(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("create service account error when call service fail", func(t *testing.T) {
+		errMsg := "Duplicate username"
+		res := &dto.ErrorResponse{
+			StatusCode: http.StatusConflict,
+			Error:      errMsg,
+		}
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		thld := newTestUserHandler(t)
+		thld.userValidate.On("ValidateCreateServiceAccount", ctx).Return(&req, nil)
+		thld.userService.On("CreateServiceAccount", ctx, &req).Return(nil, helpers.NewCustomError(http.StatusConflict, errMsg))
+		thld.handler.CreateServiceAccount(ctx)
+		expected, _ := json.Marshal(res)
+		got := w.Body.Bytes()
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, expected, got)
+	})
+}
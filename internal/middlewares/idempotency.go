@@ -0,0 +1,175 @@
+package middlewares
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/dto"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// idempotencyKeyPattern allows printable ASCII keys such as UUIDs.
+var idempotencyKeyPattern = regexp.MustCompile(`^[\x21-\x7e]{1,255}$`)
+
+// perRequestHeaders are response headers that belong to one request and are
+// not kept for a replay.
+var perRequestHeaders = []string{"X-Request-Id", "Date", "Set-Cookie", "Idempotent-Replayed"}
+
+// Idempotent lets a client send a request again with the same
+// Idempotency-Key header without it running twice. The first response is
+// kept for the user and key and returned again; the same key with another
+// request is rejected. It must run after the authenticating middleware.
+// Requests without the header are not affected.
+func (m middlewares) Idempotent(ctx *gin.Context) {
+	key := ctx.GetHeader("Idempotency-Key")
+	if key == "" {
+		ctx.Next()
+		return
+	}
+	if !idempotencyKeyPattern.MatchString(key) {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      "Invalid Idempotency-Key header",
+		})
+		return
+	}
+	userID, err := primitive.ObjectIDFromHex(ctx.GetString("userId"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, dto.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Error:      "Something went wrong please contact developer.",
+		})
+		return
+	}
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, dto.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Error:      "Invalid input parameter",
+		})
+		return
+	}
+	ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+	fingerprint := helpers.HashToken(ctx.Request.Method + " " + ctx.Request.URL.Path + "\n" + string(body))
+	params := &domains.CreateIdempotencyKeyParams{
+		UserID:      userID,
+		Key:         key,
+		Fingerprint: fingerprint,
+		ExpiresAt:   time.Now().Add(config.Get().HTTPServer.IdempotencyKeyTTL),
+	}
+	record, err := m.idempotencyKeyRepo.Create(ctx, params)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, dto.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Error:      "Something went wrong please contact developer.",
+		})
+		return
+	}
+	if record == nil {
+		m.replayIdempotent(ctx, userID, key, fingerprint)
+		return
+	}
+	recorder := &responseRecorder{ResponseWriter: ctx.Writer}
+	ctx.Writer = recorder
+	// A panic is answered with a 500 by the recovery middleware after this
+	// one has returned, so the key is released on the way out as well.
+	defer func() {
+		if r := recover(); r != nil {
+			m.releaseIdempotencyKey(ctx, record.ID)
+			panic(r)
+		}
+	}()
+	ctx.Next()
+	// A request that failed on our side may succeed when it is sent again,
+	// so its key is released instead of keeping the error.
+	if recorder.Status() >= http.StatusInternalServerError {
+		m.releaseIdempotencyKey(ctx, record.ID)
+		return
+	}
+	response := &domains.SaveIdempotentResponseParams{
+		ID:         record.ID,
+		StatusCode: recorder.Status(),
+		Header:     replayableHeader(recorder.Header()),
+		Body:       recorder.body.Bytes(),
+	}
+	if err := m.idempotencyKeyRepo.SaveResponse(ctx, response); err != nil {
+		log.Printf("failed to save idempotent response: %s\n", err.Error())
+		m.releaseIdempotencyKey(ctx, record.ID)
+	}
+}
+
+// releaseIdempotencyKey deletes a key without a saved response, so that the
+// request can be sent again with it.
+func (m middlewares) releaseIdempotencyKey(ctx *gin.Context, id primitive.ObjectID) {
+	if err := m.idempotencyKeyRepo.Delete(ctx, id); err != nil {
+		log.Printf("failed to release idempotency key: %s\n", err.Error())
+	}
+}
+
+// replayIdempotent answers a request whose key was used before with the
+// response to the first request.
+func (m middlewares) replayIdempotent(ctx *gin.Context, userID primitive.ObjectID, key string, fingerprint string) {
+	record, err := m.idempotencyKeyRepo.Get(ctx, userID, key)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, dto.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Error:      "Something went wrong please contact developer.",
+		})
+		return
+	}
+	if record != nil && record.Fingerprint != fingerprint {
+		ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, dto.ErrorResponse{
+			StatusCode: http.StatusUnprocessableEntity,
+			Error:      "Idempotency-Key was already used for a different request",
+		})
+		return
+	}
+	if record == nil || record.StatusCode == 0 {
+		ctx.AbortWithStatusJSON(http.StatusConflict, dto.ErrorResponse{
+			StatusCode: http.StatusConflict,
+			Error:      "A request with this Idempotency-Key is still being processed",
+		})
+		return
+	}
+	for name, values := range replayableHeader(record.Header) {
+		ctx.Writer.Header()[name] = values
+	}
+	ctx.Header("Idempotent-Replayed", "true")
+	ctx.AbortWithStatus(record.StatusCode)
+	if _, err := ctx.Writer.Write(record.Body); err != nil {
+		log.Printf("failed to replay idempotent response: %s\n", err.Error())
+	}
+}
+
+// replayableHeader returns a copy of header without the perRequestHeaders.
+func replayableHeader(header http.Header) http.Header {
+	res := header.Clone()
+	for _, name := range perRequestHeaders {
+		res.Del(name)
+	}
+	return res
+}
+
+// responseRecorder keeps a copy of the response body while writing it.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,149 @@
+package middlewares_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/domains"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestIdempotent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	userId := primitive.NewObjectID()
+	body := `{"title":"Title 1"}`
+	fingerprint := helpers.HashToken("POST /api/interviews\n" + body)
+	// serve runs a request through Idempotent and a handler that answers
+	// with status and counts its calls.
+	serve := func(tmid testMiddlewares, key string, body string, status int) (*httptest.ResponseRecorder, int) {
+		calls := 0
+		w := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(w)
+		r.POST("/api/interviews", func(ctx *gin.Context) {
+			ctx.Set("userId", userId.Hex())
+			ctx.Header("X-Request-Id", "req-2")
+		}, tmid.middleware.Idempotent, func(ctx *gin.Context) {
+			calls++
+			ctx.JSON(status, gin.H{"statusCode": status})
+		})
+		req, _ := http.NewRequest(http.MethodPost, "/api/interviews", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		r.ServeHTTP(w, req)
+		return w, calls
+	}
+	t.Run("request without key", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		w, calls := serve(tmid, "", body, http.StatusCreated)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("first request saves the response", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		record := &domains.IdempotencyKey{ID: primitive.NewObjectID(), UserID: userId, Key: "key-1", Fingerprint: fingerprint}
+		tmid.idempotencyKeyRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *domains.CreateIdempotencyKeyParams) bool {
+			return p.UserID == userId && p.Key == "key-1" && p.Fingerprint == fingerprint
+		})).Return(record, nil)
+		tmid.idempotencyKeyRepo.On("SaveResponse", mock.Anything, mock.MatchedBy(func(p *domains.SaveIdempotentResponseParams) bool {
+			return p.ID == record.ID && p.StatusCode == http.StatusCreated && string(p.Body) == `{"statusCode":201}` &&
+				p.Header["Content-Type"][0] == "application/json; charset=utf-8" && len(p.Header["X-Request-Id"]) == 0
+		})).Return(nil)
+		w, calls := serve(tmid, "key-1", body, http.StatusCreated)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, `{"statusCode":201}`, w.Body.String())
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("replay returns the first response", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		record := &domains.IdempotencyKey{
+			ID:          primitive.NewObjectID(),
+			UserID:      userId,
+			Key:         "key-1",
+			Fingerprint: fingerprint,
+			StatusCode:  http.StatusCreated,
+			Header:      map[string][]string{"Content-Type": {"application/json; charset=utf-8"}, "Etag": {`"1"`}, "X-Request-Id": {"req-1"}},
+			Body:        []byte(`{"statusCode":201,"data":{"id":"1"}}`),
+		}
+		tmid.idempotencyKeyRepo.On("Create", mock.Anything, mock.Anything).Return(nil, nil)
+		tmid.idempotencyKeyRepo.On("Get", mock.Anything, userId, "key-1").Return(record, nil)
+		w, calls := serve(tmid, "key-1", body, http.StatusCreated)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, string(record.Body), w.Body.String())
+		assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+		assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, "req-2", w.Header().Get("X-Request-Id"))
+		assert.Equal(t, 0, calls)
+	})
+	t.Run("error when key is reused with another body", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		record := &domains.IdempotencyKey{ID: primitive.NewObjectID(), UserID: userId, Key: "key-1", Fingerprint: fingerprint, StatusCode: http.StatusCreated}
+		tmid.idempotencyKeyRepo.On("Create", mock.Anything, mock.Anything).Return(nil, nil)
+		tmid.idempotencyKeyRepo.On("Get", mock.Anything, userId, "key-1").Return(record, nil)
+		w, calls := serve(tmid, "key-1", `{"title":"Title 2"}`, http.StatusCreated)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, `{"statusCode":422,"error":"Idempotency-Key was already used for a different request"}`, w.Body.String())
+		assert.Equal(t, 0, calls)
+	})
+	t.Run("error when first request is still running", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		record := &domains.IdempotencyKey{ID: primitive.NewObjectID(), UserID: userId, Key: "key-1", Fingerprint: fingerprint}
+		tmid.idempotencyKeyRepo.On("Create", mock.Anything, mock.Anything).Return(nil, nil)
+		tmid.idempotencyKeyRepo.On("Get", mock.Anything, userId, "key-1").Return(record, nil)
+		w, calls := serve(tmid, "key-1", body, http.StatusCreated)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, 0, calls)
+	})
+	t.Run("server error releases the key", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		record := &domains.IdempotencyKey{ID: primitive.NewObjectID(), UserID: userId, Key: "key-1", Fingerprint: fingerprint}
+		tmid.idempotencyKeyRepo.On("Create", mock.Anything, mock.Anything).Return(record, nil)
+		tmid.idempotencyKeyRepo.On("Delete", mock.Anything, record.ID).Return(nil)
+		w, calls := serve(tmid, "key-1", body, http.StatusInternalServerError)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, 1, calls)
+		tmid.idempotencyKeyRepo.AssertNotCalled(t, "SaveResponse", mock.Anything, mock.Anything)
+	})
+	t.Run("panic releases the key", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		record := &domains.IdempotencyKey{ID: primitive.NewObjectID(), UserID: userId, Key: "key-1", Fingerprint: fingerprint}
+		tmid.idempotencyKeyRepo.On("Create", mock.Anything, mock.Anything).Return(record, nil)
+		tmid.idempotencyKeyRepo.On("Delete", mock.Anything, record.ID).Return(nil)
+		w := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(w)
+		r.Use(gin.CustomRecovery(func(ctx *gin.Context, err any) {
+			ctx.AbortWithStatus(http.StatusInternalServerError)
+		}))
+		r.POST("/api/interviews", func(ctx *gin.Context) {
+			ctx.Set("userId", userId.Hex())
+		}, tmid.middleware.Idempotent, func(ctx *gin.Context) {
+			panic("some error")
+		})
+		req, _ := http.NewRequest(http.MethodPost, "/api/interviews", strings.NewReader(body))
+		req.Header.Set("Idempotency-Key", "key-1")
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		tmid.idempotencyKeyRepo.AssertNotCalled(t, "SaveResponse", mock.Anything, mock.Anything)
+	})
+	t.Run("error when key is invalid", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		w, calls := serve(tmid, strings.Repeat("k", 256), body, http.StatusCreated)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, `{"statusCode":400,"error":"Invalid Idempotency-Key header"}`, w.Body.String())
+		assert.Equal(t, 0, calls)
+	})
+	t.Run("error when key cannot be stored", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		tmid.idempotencyKeyRepo.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("some error"))
+		w, calls := serve(tmid, "key-1", body, http.StatusCreated)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, 0, calls)
+	})
+}
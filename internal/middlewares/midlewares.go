@@ -2,52 +2,86 @@ package middlewares
 
 import (
 	"net/http"
-	"robinhood-assignment/config"
+	"robinhood-assignment/helpers"
 	"robinhood-assignment/internal/core/constants"
 	"robinhood-assignment/internal/core/domains"
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/dto"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type middlewares struct {
-	myJWT ports.MyJWT
+	myJWT              ports.MyJWT
+	userRepo           ports.UserRepository
+	refreshTokenRepo   ports.RefreshTokenRepository
+	apiKeyRepo         ports.APIKeyRepository
+	idempotencyKeyRepo ports.IdempotencyKeyRepository
 }
 
-func NewMidlewares(myJWT ports.MyJWT) ports.Middlewares {
-	return &middlewares{myJWT}
+func NewMidlewares(myJWT ports.MyJWT, userRepo ports.UserRepository, refreshTokenRepo ports.RefreshTokenRepository, apiKeyRepo ports.APIKeyRepository, idempotencyKeyRepo ports.IdempotencyKeyRepository) ports.Middlewares {
+	return &middlewares{myJWT, userRepo, refreshTokenRepo, apiKeyRepo, idempotencyKeyRepo}
+}
+
+func (m middlewares) RequirePermission(permission string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		claims, ok := m.authenticate(ctx)
+		if !ok {
+			return
+		}
+		if !constants.HasPermission(claims.Role, permission) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+				StatusCode: http.StatusForbidden,
+				Error:      "You don't have permission for this API",
+			})
+			return
+		}
+		ctx.Set("userId", claims.UserID)
+		ctx.Set("role", claims.Role)
+		ctx.Set("sessionId", claims.SessionID)
+		ctx.Next()
+	}
+}
+
+// APIKeyScope allows API keys with the given scope on the route. It must run
+// before the authenticating middleware; routes without it only accept
+// JWTs.
+func (m middlewares) APIKeyScope(scope string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Set("apiKeyScope", scope)
+		ctx.Next()
+	}
 }
 
 func (m middlewares) AdminMiddleware(ctx *gin.Context) {
-	authorization := ctx.GetHeader("Authorization")
-	if authorization == "" {
-		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
-			StatusCode: http.StatusUnauthorized,
-			Error:      "Authorization is missing",
-		})
+	claims, ok := m.authenticate(ctx)
+	if !ok {
 		return
 	}
-	jwtToken := strings.Split(authorization, "Bearer ")
-	if len(jwtToken) != 2 {
-		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
-			StatusCode: http.StatusUnauthorized,
-			Error:      "Invalid token format",
+	if claims.Role != constants.ADMIN_ROLE {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Error:      "You don't have permission for this API",
 		})
 		return
 	}
-	tokenString := jwtToken[1]
-	claims := &domains.Claims{}
-	if _, err := m.myJWT.ParseWithClaims(tokenString, claims, m.myJWT.ParseToken); err != nil {
-		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
-			StatusCode: http.StatusUnauthorized,
-			Error:      err.Error(),
-		})
+	ctx.Set("userId", claims.UserID)
+	ctx.Set("role", claims.Role)
+	ctx.Set("sessionId", claims.SessionID)
+	ctx.Next()
+}
+
+// StaffMiddleware lets any user with a built-in role through. Routes that need
+// more than authentication use RequirePermission.
+func (m middlewares) StaffMiddleware(ctx *gin.Context) {
+	claims, ok := m.authenticate(ctx)
+	if !ok {
 		return
 	}
-	if claims.Role != constants.ADMIN_ROLE {
+	if !constants.IsRole(claims.Role) {
 		ctx.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
 			StatusCode: http.StatusForbidden,
 			Error:      "You don't have permission for this API",
@@ -56,17 +90,18 @@ func (m middlewares) AdminMiddleware(ctx *gin.Context) {
 	}
 	ctx.Set("userId", claims.UserID)
 	ctx.Set("role", claims.Role)
+	ctx.Set("sessionId", claims.SessionID)
 	ctx.Next()
 }
 
-func (m middlewares) StaffMiddleware(ctx *gin.Context) {
+func (m middlewares) authenticate(ctx *gin.Context) (*domains.Claims, bool) {
 	authorization := ctx.GetHeader("Authorization")
 	if authorization == "" {
 		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
 			StatusCode: http.StatusUnauthorized,
 			Error:      "Authorization is missing",
 		})
-		return
+		return nil, false
 	}
 	jwtToken := strings.Split(authorization, "Bearer ")
 	if len(jwtToken) != 2 {
@@ -74,27 +109,130 @@ func (m middlewares) StaffMiddleware(ctx *gin.Context) {
 			StatusCode: http.StatusUnauthorized,
 			Error:      "Invalid token format",
 		})
-		return
+		return nil, false
 	}
 	tokenString := jwtToken[1]
+	if helpers.IsAPIKey(tokenString) {
+		return m.authenticateAPIKey(ctx, tokenString)
+	}
 	claims := &domains.Claims{}
-	if _, err := m.myJWT.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(config.Get().Auth.JwtSecret), nil
-	}); err != nil {
+	if _, err := m.myJWT.ParseWithClaims(tokenString, claims, m.myJWT.ParseToken); err != nil {
 		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
 			StatusCode: http.StatusUnauthorized,
 			Error:      err.Error(),
 		})
-		return
+		return nil, false
 	}
-	if claims.Role != constants.STAFF_ROLE && claims.Role != constants.ADMIN_ROLE {
+	sessionID, err := primitive.ObjectIDFromHex(claims.SessionID)
+	if err != nil || claims.Purpose != "" {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      "Invalid token session",
+		})
+		return nil, false
+	}
+	revoked, err := m.refreshTokenRepo.IsSessionRevoked(ctx, sessionID)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, dto.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Error:      "Something went wrong please contact developer.",
+		})
+		return nil, false
+	}
+	if revoked {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      "Session has been revoked",
+		})
+		return nil, false
+	}
+	userID, err := primitive.ObjectIDFromHex(claims.UserID)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      "Invalid user token",
+		})
+		return nil, false
+	}
+	user, ok := m.activeUser(ctx, userID)
+	if !ok {
+		return nil, false
+	}
+	claims.Role = user.Role
+	return claims, true
+}
+
+func (m middlewares) authenticateAPIKey(ctx *gin.Context, key string) (*domains.Claims, bool) {
+	apiKey, err := m.apiKeyRepo.GetByKeyHash(ctx, helpers.HashToken(key))
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, dto.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Error:      "Something went wrong please contact developer.",
+		})
+		return nil, false
+	}
+	now := time.Now()
+	if apiKey == nil || apiKey.RevokedAt != nil || (apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(now)) {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      "Invalid API key",
+		})
+		return nil, false
+	}
+	scope := ctx.GetString("apiKeyScope")
+	if scope == "" {
 		ctx.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
 			StatusCode: http.StatusForbidden,
-			Error:      "You don't have permission for this API",
+			Error:      "API keys are not allowed for this API",
 		})
-		return
+		return nil, false
 	}
-	ctx.Set("userId", claims.UserID)
-	ctx.Set("role", claims.Role)
-	ctx.Next()
+	if !hasScope(apiKey, scope) {
+		ctx.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Error:      "API key does not have the required scope",
+		})
+		return nil, false
+	}
+	user, ok := m.activeUser(ctx, apiKey.UserID)
+	if !ok {
+		return nil, false
+	}
+	if err := m.apiKeyRepo.Touch(ctx, apiKey.ID, now); err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, dto.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Error:      "Something went wrong please contact developer.",
+		})
+		return nil, false
+	}
+	ctx.Set("apiKeyId", apiKey.ID.Hex())
+	return &domains.Claims{UserID: user.ID.Hex(), Role: user.Role}, true
+}
+
+func (m middlewares) activeUser(ctx *gin.Context, userID primitive.ObjectID) (*domains.User, bool) {
+	user, err := m.userRepo.Get(ctx, userID)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusInternalServerError, dto.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Error:      "Something went wrong please contact developer.",
+		})
+		return nil, false
+	}
+	if user == nil || user.IsDeactivated {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Error:      "Account is deactivated",
+		})
+		return nil, false
+	}
+	return user, true
+}
+
+func hasScope(apiKey *domains.APIKey, scope string) bool {
+	for _, s := range apiKey.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
 }
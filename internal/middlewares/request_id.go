@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// requestIDPattern allows ids such as UUIDs from a proxy or client.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestID gives every request an id, taken from the X-Request-Id header
+// when it has a usable one. The id is stored as "requestId" for the audit log
+// and sent back in the X-Request-Id response header.
+func (m middlewares) RequestID(ctx *gin.Context) {
+	id := ctx.GetHeader("X-Request-Id")
+	if !requestIDPattern.MatchString(id) {
+		id = primitive.NewObjectID().Hex()
+	}
+	ctx.Set("requestId", id)
+	ctx.Header("X-Request-Id", id)
+	ctx.Next()
+}
@@ -0,0 +1,47 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	serve := func(tmid testMiddlewares, header string) (*httptest.ResponseRecorder, string) {
+		var requestId string
+		w := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(w)
+		r.GET("/healthz", tmid.middleware.RequestID, func(ctx *gin.Context) {
+			requestId = ctx.GetString("requestId")
+			ctx.Status(http.StatusOK)
+		})
+		req, _ := http.NewRequest(http.MethodGet, "/healthz", nil)
+		if header != "" {
+			req.Header.Set("X-Request-Id", header)
+		}
+		r.ServeHTTP(w, req)
+		return w, requestId
+	}
+	t.Run("keeps request id from header", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		w, requestId := serve(tmid, "3f2c6b0e-1d7a-4c55-9a0e-7f1f0b8e2a11")
+		assert.Equal(t, "3f2c6b0e-1d7a-4c55-9a0e-7f1f0b8e2a11", requestId)
+		assert.Equal(t, requestId, w.Header().Get("X-Request-Id"))
+	})
+	t.Run("generates request id without header", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		w, requestId := serve(tmid, "")
+		assert.Len(t, requestId, 24)
+		assert.Equal(t, requestId, w.Header().Get("X-Request-Id"))
+	})
+	t.Run("replaces invalid request id", func(t *testing.T) {
+		tmid := newMiddlewares(t)
+		w, requestId := serve(tmid, "bad id\n")
+		assert.Len(t, requestId, 24)
+		assert.Equal(t, requestId, w.Header().Get("X-Request-Id"))
+	})
+}
@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type apiKeyRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewAPIKeyRepository(mc *mongo.Client, db string) ports.APIKeyRepository {
+	cn := "apiKey"
+	return &apiKeyRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *apiKeyRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "keyHash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "userId", Value: 1}},
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, params *domains.CreateAPIKeyParams) (*domains.APIKey, error) {
+	key := domains.APIKey{
+		ID:        primitive.NewObjectID(),
+		UserID:    params.UserID,
+		Name:      params.Name,
+		Prefix:    params.Prefix,
+		KeyHash:   params.KeyHash,
+		Scopes:    params.Scopes,
+		ExpiresAt: params.ExpiresAt,
+		CreatedBy: params.CreatedBy,
+		CreatedAt: time.Now(),
+	}
+	if _, err := r.col.InsertOne(ctx, key); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *apiKeyRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.APIKey, error) {
+	filter := bson.D{{Key: "_id", Value: id}}
+	res := domains.APIKey{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *apiKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*domains.APIKey, error) {
+	filter := bson.D{{Key: "keyHash", Value: keyHash}}
+	res := domains.APIKey{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *apiKeyRepository) GetByUserID(ctx context.Context, userID primitive.ObjectID) ([]domains.APIKey, error) {
+	filter := bson.D{{Key: "userId", Value: userID}}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	res := []domains.APIKey{}
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id primitive.ObjectID) error {
+	filter := bson.D{{Key: "_id", Value: id}, {Key: "revokedAt", Value: nil}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "revokedAt", Value: time.Now()}}}}
+	if _, err := r.col.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) Touch(ctx context.Context, id primitive.ObjectID, usedAt time.Time) error {
+	filter := bson.D{{Key: "_id", Value: id}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "lastUsedAt", Value: usedAt}}}}
+	if _, err := r.col.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
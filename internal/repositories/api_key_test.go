@@ -0,0 +1,141 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testAPIKeyRepository struct {
+	apiKeyRepo ports.APIKeyRepository
+}
+
+func newTestAPIKeyRepository(mc *mongo.Client, db string) testAPIKeyRepository {
+	apiKeyRepo := repositories.NewAPIKeyRepository(mc, db)
+	return testAPIKeyRepository{apiKeyRepo}
+}
+
+var (
+	apiKeyCollectionName = "apiKey"
+	mockAPIKey           = domains.APIKey{
+		ID:        primitive.NewObjectID(),
+		UserID:    userId,
+		Name:      "Interview sync",
+		Prefix:    "rhk_0a1b2c3d",
+		KeyHash:   "api-key-hash",
+		Scopes:    []string{"interviews:read"},
+		CreatedBy: userId,
+		CreatedAt: time.Now().Truncate(time.Millisecond).UTC(),
+	}
+)
+
+func apiKeyDocument(key domains.APIKey) bson.D {
+	return bson.D{
+		{Key: "_id", Value: key.ID},
+		{Key: "userId", Value: key.UserID},
+		{Key: "name", Value: key.Name},
+		{Key: "prefix", Value: key.Prefix},
+		{Key: "keyHash", Value: key.KeyHash},
+		{Key: "scopes", Value: bson.A{"interviews:read"}},
+		{Key: "expiresAt", Value: nil},
+		{Key: "lastUsedAt", Value: nil},
+		{Key: "revokedAt", Value: nil},
+		{Key: "createdBy", Value: key.CreatedBy},
+		{Key: "createdAt", Value: key.CreatedAt},
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("create api key success", func(mt *mtest.T) {
+		trepo := newTestAPIKeyRepository(mt.Client, dbName)
+		params := &domains.CreateAPIKeyParams{
+			UserID:    mockAPIKey.UserID,
+			Name:      mockAPIKey.Name,
+			Prefix:    mockAPIKey.Prefix,
+			KeyHash:   mockAPIKey.KeyHash,
+			Scopes:    mockAPIKey.Scopes,
+			CreatedBy: mockAPIKey.CreatedBy,
+		}
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		data, err := trepo.apiKeyRepo.Create(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, params.KeyHash, data.KeyHash)
+		assert.Equal(t, params.Scopes, data.Scopes)
+		assert.Nil(t, data.RevokedAt)
+	})
+	mt.Run("create api key error", func(mt *mtest.T) {
+		trepo := newTestAPIKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "duplicate key error",
+		}))
+		data, err := trepo.apiKeyRepo.Create(ctx, &domains.CreateAPIKeyParams{KeyHash: mockAPIKey.KeyHash})
+		assert.Nil(t, data)
+		assert.True(t, mongo.IsDuplicateKeyError(err))
+	})
+}
+
+func TestGetAPIKeyByKeyHash(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get api key success", func(mt *mtest.T) {
+		trepo := newTestAPIKeyRepository(mt.Client, dbName)
+		expected := mockAPIKey
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, apiKeyCollectionName), mtest.FirstBatch, apiKeyDocument(mockAPIKey)))
+		data, err := trepo.apiKeyRepo.GetByKeyHash(ctx, mockAPIKey.KeyHash)
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, data)
+	})
+	mt.Run("get api key not found", func(mt *mtest.T) {
+		trepo := newTestAPIKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, apiKeyCollectionName), mtest.FirstBatch))
+		data, err := trepo.apiKeyRepo.GetByKeyHash(ctx, mockAPIKey.KeyHash)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestGetAPIKeysByUserID(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get api keys success", func(mt *mtest.T) {
+		trepo := newTestAPIKeyRepository(mt.Client, dbName)
+		ns := fmt.Sprintf("%s.%s", dbName, apiKeyCollectionName)
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(1, ns, mtest.FirstBatch, apiKeyDocument(mockAPIKey)),
+			mtest.CreateCursorResponse(0, ns, mtest.NextBatch),
+		)
+		data, err := trepo.apiKeyRepo.GetByUserID(ctx, userId)
+		assert.NoError(t, err)
+		assert.Equal(t, []domains.APIKey{mockAPIKey}, data)
+	})
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("revoke api key success", func(mt *mtest.T) {
+		trepo := newTestAPIKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}})
+		err := trepo.apiKeyRepo.Revoke(ctx, mockAPIKey.ID)
+		assert.NoError(t, err)
+	})
+	mt.Run("touch api key success", func(mt *mtest.T) {
+		trepo := newTestAPIKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}})
+		err := trepo.apiKeyRepo.Touch(ctx, mockAPIKey.ID, time.Now())
+		assert.NoError(t, err)
+	})
+}
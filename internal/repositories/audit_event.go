@@ -0,0 +1,168 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type auditEventRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+// auditActorLookup joins the user who made a change into "actor".
+var auditActorLookup = []bson.D{
+	{{
+		Key: "$lookup",
+		Value: bson.D{
+			{Key: "from", Value: "user"},
+			{Key: "localField", Value: "actorId"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "actor"},
+		},
+	}},
+	{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$actor"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
+}
+
+func NewAuditEventRepository(mc *mongo.Client, db string) ports.AuditEventRepository {
+	cn := "auditEvent"
+	return &auditEventRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *auditEventRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "appointmentId", Value: 1}, {Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "actorId", Value: 1}, {Key: "createdAt", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "targetId", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "requestId", Value: 1}},
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *auditEventRepository) Create(ctx context.Context, params *domains.CreateAuditEventParams) error {
+	event := domains.AuditEvent{
+		ID:            primitive.NewObjectID(),
+		ActorID:       params.ActorID,
+		Action:        params.Action,
+		TargetType:    params.TargetType,
+		TargetID:      params.TargetID,
+		AppointmentID: params.AppointmentID,
+		Changes:       params.Changes,
+		Metadata:      params.Metadata,
+		RequestID:     params.RequestID,
+		IPAddress:     params.IPAddress,
+		CreatedAt:     time.Now(),
+	}
+	if _, err := r.col.InsertOne(ctx, event); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetAll returns a page of the events matching params with the user who made
+// them, newest first.
+func (r *auditEventRepository) GetAll(ctx context.Context, params *domains.GetAuditEventsParams) ([]domains.AuditEvent, error) {
+	match := bson.D{}
+	if !params.ActorID.IsZero() {
+		match = append(match, bson.E{Key: "actorId", Value: params.ActorID})
+	}
+	if params.Action != "" {
+		match = append(match, bson.E{Key: "action", Value: params.Action})
+	}
+	if params.TargetType != "" {
+		match = append(match, bson.E{Key: "targetType", Value: params.TargetType})
+	}
+	if !params.TargetID.IsZero() {
+		match = append(match, bson.E{Key: "targetId", Value: params.TargetID})
+	}
+	if !params.AppointmentID.IsZero() {
+		match = append(match, bson.E{Key: "appointmentId", Value: params.AppointmentID})
+	}
+	if params.RequestID != "" {
+		match = append(match, bson.E{Key: "requestId", Value: params.RequestID})
+	}
+	createdAt := bson.D{}
+	if params.From != nil {
+		createdAt = append(createdAt, bson.E{Key: "$gte", Value: *params.From})
+	}
+	if params.To != nil {
+		createdAt = append(createdAt, bson.E{Key: "$lte", Value: *params.To})
+	}
+	if len(createdAt) > 0 {
+		match = append(match, bson.E{Key: "createdAt", Value: createdAt})
+	}
+	if !params.BeforeID.IsZero() {
+		match = append(match, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "createdAt", Value: bson.D{{Key: "$lt", Value: params.BeforeCreatedAt}}}},
+			bson.D{{Key: "createdAt", Value: params.BeforeCreatedAt}, {Key: "_id", Value: bson.D{{Key: "$lt", Value: params.BeforeID}}}},
+		}})
+	}
+	pipeline := []bson.D{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}}}},
+		{{Key: "$limit", Value: params.Limit}},
+	}
+	pipeline = append(pipeline, auditActorLookup...)
+
+	res := []domains.AuditEvent{}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// RedactTarget removes the changes from the events of a target that was
+// permanently deleted, leaving who did what and when.
+func (r *auditEventRepository) RedactTarget(ctx context.Context, targetID primitive.ObjectID) error {
+	filter := bson.D{{Key: "targetId", Value: targetID}, {Key: "changes", Value: bson.D{{Key: "$exists", Value: true}}}}
+	update := bson.D{{Key: "$unset", Value: bson.D{{Key: "changes", Value: ""}}}}
+	if _, err := r.col.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RedactAppointments removes the changes from the events of appointments
+// that were purged and of their comments.
+func (r *auditEventRepository) RedactAppointments(ctx context.Context, appointmentIDs []primitive.ObjectID) error {
+	filter := bson.D{
+		{Key: "appointmentId", Value: bson.D{{Key: "$in", Value: appointmentIDs}}},
+		{Key: "changes", Value: bson.D{{Key: "$exists", Value: true}}},
+	}
+	update := bson.D{{Key: "$unset", Value: bson.D{{Key: "changes", Value: ""}}}}
+	if _, err := r.col.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
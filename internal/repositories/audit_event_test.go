@@ -0,0 +1,143 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testAuditEventRepository struct {
+	auditEventRepo ports.AuditEventRepository
+}
+
+func newTestAuditEventRepository(mc *mongo.Client, db string) testAuditEventRepository {
+	auditEventRepo := repositories.NewAuditEventRepository(mc, db)
+	return testAuditEventRepository{auditEventRepo}
+}
+
+var (
+	auditEventCollectionName = "auditEvent"
+	mockAuditEvent           = domains.AuditEvent{
+		ID:            primitive.NewObjectID(),
+		ActorID:       userId,
+		Actor:         &domains.User{ID: userId, Name: "User name 1"},
+		Action:        "interview.update",
+		TargetType:    "interview",
+		TargetID:      mockInterviewComment.AppointmentID,
+		AppointmentID: mockInterviewComment.AppointmentID,
+		Changes:       []domains.AuditChange{{Field: "title", Before: "Title 1", After: "Title"}},
+		RequestID:     "req-1",
+		IPAddress:     "10.0.0.1",
+		CreatedAt:     time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC),
+	}
+)
+
+func auditEventDocument(event domains.AuditEvent) bson.D {
+	return bson.D{
+		{Key: "_id", Value: event.ID},
+		{Key: "actorId", Value: event.ActorID},
+		{Key: "actor", Value: bson.D{{Key: "_id", Value: event.Actor.ID}, {Key: "name", Value: event.Actor.Name}}},
+		{Key: "action", Value: event.Action},
+		{Key: "targetType", Value: event.TargetType},
+		{Key: "targetId", Value: event.TargetID},
+		{Key: "appointmentId", Value: event.AppointmentID},
+		{Key: "changes", Value: bson.A{bson.D{{Key: "field", Value: "title"}, {Key: "before", Value: "Title 1"}, {Key: "after", Value: "Title"}}}},
+		{Key: "requestId", Value: event.RequestID},
+		{Key: "ipAddress", Value: event.IPAddress},
+		{Key: "createdAt", Value: event.CreatedAt},
+	}
+}
+
+func TestCreateAuditEvent(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.CreateAuditEventParams{
+		ActorID:       userId,
+		Action:        "comment.create",
+		TargetType:    "comment",
+		TargetID:      mockInterviewComment.ID,
+		AppointmentID: mockInterviewComment.AppointmentID,
+		Changes:       []domains.AuditChange{{Field: "comment", After: "comment"}},
+	}
+	mt.Run("create audit event success", func(mt *mtest.T) {
+		trepo := newTestAuditEventRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		err := trepo.auditEventRepo.Create(ctx, params)
+		assert.NoError(t, err)
+	})
+	mt.Run("create audit event error", func(mt *mtest.T) {
+		trepo := newTestAuditEventRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "insert fail",
+		}))
+		err := trepo.auditEventRepo.Create(ctx, params)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetAuditEvents(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	ns := fmt.Sprintf("%s.%s", dbName, auditEventCollectionName)
+	from := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	params := &domains.GetAuditEventsParams{
+		AppointmentID:   mockAuditEvent.AppointmentID,
+		From:            &from,
+		BeforeCreatedAt: mockAuditEvent.CreatedAt.Add(time.Hour),
+		BeforeID:        primitive.NewObjectID(),
+		Limit:           21,
+	}
+	mt.Run("get audit events success", func(mt *mtest.T) {
+		trepo := newTestAuditEventRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, auditEventDocument(mockAuditEvent)))
+		got, err := trepo.auditEventRepo.GetAll(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, []domains.AuditEvent{mockAuditEvent}, got)
+	})
+	mt.Run("get audit events error", func(mt *mtest.T) {
+		trepo := newTestAuditEventRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+		_, err := trepo.auditEventRepo.GetAll(ctx, params)
+		assert.Error(t, err)
+	})
+}
+
+func TestRedactAuditEvents(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("redact target success", func(mt *mtest.T) {
+		trepo := newTestAuditEventRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 2}, {Key: "nModified", Value: 2}})
+		err := trepo.auditEventRepo.RedactTarget(ctx, mockInterviewComment.ID)
+		assert.NoError(t, err)
+	})
+	mt.Run("redact target error", func(mt *mtest.T) {
+		trepo := newTestAuditEventRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+		err := trepo.auditEventRepo.RedactTarget(ctx, mockInterviewComment.ID)
+		assert.Error(t, err)
+	})
+	mt.Run("redact appointments success", func(mt *mtest.T) {
+		trepo := newTestAuditEventRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 3}, {Key: "nModified", Value: 3}})
+		err := trepo.auditEventRepo.RedactAppointments(ctx, []primitive.ObjectID{mockInterviewComment.AppointmentID})
+		assert.NoError(t, err)
+	})
+	mt.Run("redact appointments error", func(mt *mtest.T) {
+		trepo := newTestAuditEventRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+		err := trepo.auditEventRepo.RedactAppointments(ctx, []primitive.ObjectID{mockInterviewComment.AppointmentID})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// authSettingID is the id of the single document holding the settings.
+const authSettingID = "auth"
+
+type authSettingRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewAuthSettingRepository(mc *mongo.Client, db string) ports.AuthSettingRepository {
+	cn := "setting"
+	return &authSettingRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+// Get returns the zero value settings until an admin saves them for the first
+// time.
+func (r *authSettingRepository) Get(ctx context.Context) (*domains.AuthSetting, error) {
+	filter := bson.D{{Key: "_id", Value: authSettingID}}
+	res := domains.AuthSetting{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return &res, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *authSettingRepository) Update(ctx context.Context, params *domains.UpdateAuthSettingParams) (*domains.AuthSetting, error) {
+	filter := bson.D{{Key: "_id", Value: authSettingID}}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "passwordLoginDisabled", Value: params.PasswordLoginDisabled},
+		{Key: "updatedBy", Value: params.UpdatedBy},
+		{Key: "updatedAt", Value: time.Now()},
+	}}}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetReturnDocument(options.After).SetUpsert(true)
+	res := domains.AuthSetting{}
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,81 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testAuthSettingRepository struct {
+	authSettingRepo ports.AuthSettingRepository
+}
+
+func newTestAuthSettingRepository(mc *mongo.Client, db string) testAuthSettingRepository {
+	authSettingRepo := repositories.NewAuthSettingRepository(mc, db)
+	return testAuthSettingRepository{authSettingRepo}
+}
+
+var settingCollectionName = "setting"
+
+func TestGetAuthSetting(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get auth setting success", func(mt *mtest.T) {
+		trepo := newTestAuthSettingRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, settingCollectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "auth"},
+			{Key: "passwordLoginDisabled", Value: true},
+		}))
+		data, err := trepo.authSettingRepo.Get(ctx)
+		assert.NoError(t, err)
+		assert.True(t, data.PasswordLoginDisabled)
+	})
+	mt.Run("get auth setting default when not saved", func(mt *mtest.T) {
+		trepo := newTestAuthSettingRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, settingCollectionName), mtest.FirstBatch))
+		data, err := trepo.authSettingRepo.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domains.AuthSetting{}, data)
+	})
+}
+
+func TestUpdateAuthSetting(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	adminId := primitive.NewObjectID()
+	now := time.Now().Truncate(time.Millisecond).UTC()
+	mt.Run("update auth setting success", func(mt *mtest.T) {
+		trepo := newTestAuthSettingRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: bson.D{
+				{Key: "_id", Value: "auth"},
+				{Key: "passwordLoginDisabled", Value: true},
+				{Key: "updatedBy", Value: adminId},
+				{Key: "updatedAt", Value: now},
+			}},
+		})
+		data, err := trepo.authSettingRepo.Update(ctx, &domains.UpdateAuthSettingParams{PasswordLoginDisabled: true, UpdatedBy: adminId})
+		assert.NoError(t, err)
+		assert.Equal(t, &domains.AuthSetting{PasswordLoginDisabled: true, UpdatedBy: adminId, UpdatedAt: now}, data)
+	})
+	mt.Run("update auth setting error", func(mt *mtest.T) {
+		trepo := newTestAuthSettingRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    1,
+			Message: "some error",
+		}))
+		data, err := trepo.authSettingRepo.Update(ctx, &domains.UpdateAuthSettingParams{PasswordLoginDisabled: true, UpdatedBy: adminId})
+		assert.Nil(t, data)
+		assert.Error(t, err)
+	})
+}
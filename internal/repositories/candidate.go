@@ -0,0 +1,187 @@
+package repositories
+
+import (
+	"context"
+	"regexp"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type candidateRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewCandidateRepository(mc *mongo.Client, db string) ports.CandidateRepository {
+	cn := "candidate"
+	return &candidateRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *candidateRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "email", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "stage", Value: 1}, {Key: "position", Value: 1}},
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *candidateRepository) GetAll(ctx context.Context, params *domains.GetCandidatesParams) ([]domains.Candidate, error) {
+	filter := bson.D{}
+	if params.Search != "" {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(params.Search), Options: "i"}
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "name", Value: pattern}},
+			bson.D{{Key: "email", Value: pattern}},
+		}})
+	}
+	if params.Position != "" {
+		filter = append(filter, bson.E{Key: "position", Value: params.Position})
+	}
+	if params.Stage != "" {
+		filter = append(filter, bson.E{Key: "stage", Value: params.Stage})
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "createdAt", Value: -1}}).
+		SetSkip(int64(params.Offset)).
+		SetLimit(int64(params.Limit))
+
+	res := []domains.Candidate{}
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (r *candidateRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.Candidate, error) {
+	filter := bson.D{{Key: "_id", Value: id}}
+	res := domains.Candidate{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetWithInterviews returns the candidate with every appointment linked to
+// them in "interviews", oldest first.
+func (r *candidateRepository) GetWithInterviews(ctx context.Context, id primitive.ObjectID) (*domains.Candidate, error) {
+	pipeline := []bson.D{
+		{{Key: "$match", Value: bson.D{{Key: "_id", Value: id}}}},
+		{{
+			Key: "$lookup",
+			Value: bson.D{
+				{Key: "from", Value: "interviewAppointment"},
+				{Key: "let", Value: bson.D{{Key: "candidateId", Value: "$_id"}}},
+				{Key: "pipeline", Value: bson.A{
+					bson.D{{Key: "$match", Value: bson.D{{Key: "$expr", Value: bson.D{{Key: "$and", Value: bson.A{
+						bson.D{{Key: "$eq", Value: bson.A{"$candidateId", "$$candidateId"}}},
+						bson.D{{Key: "$eq", Value: bson.A{"$isArchived", false}}},
+					}}}}}}},
+					bson.D{{Key: "$sort", Value: bson.D{{Key: "startAt", Value: 1}, {Key: "createdAt", Value: 1}}}},
+					bson.D{{Key: "$project", Value: bson.D{{Key: "comments", Value: 0}}}},
+					bson.D{{
+						Key: "$lookup",
+						Value: bson.D{
+							{Key: "from", Value: "user"},
+							{Key: "localField", Value: "createUserId"},
+							{Key: "foreignField", Value: "_id"},
+							{Key: "as", Value: "createUser"},
+						},
+					}},
+					bson.D{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$createUser"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
+					interviewersLookup,
+				}},
+				{Key: "as", Value: "interviews"},
+			},
+		}},
+		{{Key: "$limit", Value: 1}},
+	}
+	res := []domains.Candidate{}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return &res[0], nil
+}
+
+func (r *candidateRepository) Create(ctx context.Context, params *domains.CreateCandidateParams) (*domains.Candidate, error) {
+	now := time.Now()
+	candidate := domains.Candidate{
+		ID:        primitive.NewObjectID(),
+		Name:      params.Name,
+		Email:     params.Email,
+		Phone:     params.Phone,
+		Position:  params.Position,
+		Stage:     params.Stage,
+		CreatedBy: params.CreatedBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := r.col.InsertOne(ctx, candidate); err != nil {
+		return nil, err
+	}
+	return &candidate, nil
+}
+
+func (r *candidateRepository) Update(ctx context.Context, params *domains.UpdateCandidateParams) (*domains.Candidate, error) {
+	filter := bson.D{{Key: "_id", Value: params.ID}}
+	updateValue := bson.D{{Key: "updatedAt", Value: time.Now()}}
+	if params.Name != "" {
+		updateValue = append(updateValue, bson.E{Key: "name", Value: params.Name})
+	}
+	if params.Email != "" {
+		updateValue = append(updateValue, bson.E{Key: "email", Value: params.Email})
+	}
+	if params.Phone != "" {
+		updateValue = append(updateValue, bson.E{Key: "phone", Value: params.Phone})
+	}
+	if params.Position != "" {
+		updateValue = append(updateValue, bson.E{Key: "position", Value: params.Position})
+	}
+	if params.Stage != "" {
+		updateValue = append(updateValue, bson.E{Key: "stage", Value: params.Stage})
+	}
+	update := bson.D{{Key: "$set", Value: updateValue}}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetReturnDocument(options.After).SetUpsert(false)
+	res := domains.Candidate{}
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,201 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testCandidateRepository struct {
+	candidateRepo ports.CandidateRepository
+}
+
+func newTestCandidateRepository(mc *mongo.Client, db string) testCandidateRepository {
+	candidateRepo := repositories.NewCandidateRepository(mc, db)
+	return testCandidateRepository{candidateRepo}
+}
+
+var (
+	candidateCollectionName = "candidate"
+	mockCandidate           = domains.Candidate{
+		ID:        primitive.NewObjectID(),
+		Name:      "Jane Doe",
+		Email:     "jane@example.com",
+		Phone:     "+66 81 234 5678",
+		Position:  "Designer",
+		Stage:     constants.CANDIDATE_STAGE_SCREENING,
+		CreatedBy: userId,
+		CreatedAt: time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC),
+	}
+)
+
+func candidateDocument(candidate domains.Candidate) bson.D {
+	return bson.D{
+		{Key: "_id", Value: candidate.ID},
+		{Key: "name", Value: candidate.Name},
+		{Key: "email", Value: candidate.Email},
+		{Key: "phone", Value: candidate.Phone},
+		{Key: "position", Value: candidate.Position},
+		{Key: "stage", Value: candidate.Stage},
+		{Key: "createdBy", Value: candidate.CreatedBy},
+		{Key: "createdAt", Value: candidate.CreatedAt},
+		{Key: "updatedAt", Value: candidate.UpdatedAt},
+	}
+}
+
+func TestGetAllCandidates(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.GetCandidatesParams{
+		Search: "jane",
+		Stage:  constants.CANDIDATE_STAGE_SCREENING,
+		Offset: 0,
+		Limit:  20,
+	}
+	mt.Run("get all candidates success", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, candidateCollectionName), mtest.FirstBatch, candidateDocument(mockCandidate)))
+		data, err := trepo.candidateRepo.GetAll(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.Candidate{mockCandidate}, data)
+	})
+	mt.Run("get all candidates error", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "bad query",
+		}))
+		_, err := trepo.candidateRepo.GetAll(ctx, params)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestGetCandidate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get candidate success", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, candidateCollectionName), mtest.FirstBatch, candidateDocument(mockCandidate)))
+		data, err := trepo.candidateRepo.Get(ctx, mockCandidate.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, &mockCandidate, data)
+	})
+	mt.Run("get candidate not found", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, candidateCollectionName), mtest.FirstBatch))
+		data, err := trepo.candidateRepo.Get(ctx, mockCandidate.ID)
+		assert.Nil(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestGetCandidateWithInterviews(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get candidate with interviews success", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		interview := domains.InterviewAppointment{
+			ID:          primitive.NewObjectID(),
+			Title:       "Onsite",
+			Description: "Design review",
+			Status:      "TODO",
+			CandidateID: mockCandidate.ID,
+			CreateUser:  user,
+		}
+		expected := mockCandidate
+		expected.Interviews = []domains.InterviewAppointment{interview}
+		doc := append(candidateDocument(mockCandidate), bson.E{Key: "interviews", Value: bson.A{bson.D{
+			{Key: "_id", Value: interview.ID},
+			{Key: "title", Value: interview.Title},
+			{Key: "description", Value: interview.Description},
+			{Key: "status", Value: interview.Status},
+			{Key: "isArchived", Value: false},
+			{Key: "candidateId", Value: mockCandidate.ID},
+			{Key: "createUser", Value: user},
+		}}})
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, candidateCollectionName), mtest.FirstBatch, doc))
+		data, err := trepo.candidateRepo.GetWithInterviews(ctx, mockCandidate.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, &expected, data)
+	})
+	mt.Run("get candidate with interviews not found", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, candidateCollectionName), mtest.FirstBatch))
+		data, err := trepo.candidateRepo.GetWithInterviews(ctx, mockCandidate.ID)
+		assert.Nil(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestCreateCandidate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.CreateCandidateParams{
+		Name:      mockCandidate.Name,
+		Email:     mockCandidate.Email,
+		Position:  mockCandidate.Position,
+		Stage:     constants.CANDIDATE_STAGE_APPLIED,
+		CreatedBy: userId,
+	}
+	mt.Run("create candidate success", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		data, err := trepo.candidateRepo.Create(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, params.Name, data.Name)
+		assert.Equal(t, params.Stage, data.Stage)
+		assert.False(t, data.ID.IsZero())
+	})
+	mt.Run("create candidate error", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "bad insert",
+		}))
+		data, err := trepo.candidateRepo.Create(ctx, params)
+		assert.NotNil(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestUpdateCandidate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.UpdateCandidateParams{
+		ID:    mockCandidate.ID,
+		Stage: constants.CANDIDATE_STAGE_ONSITE,
+	}
+	mt.Run("update candidate success", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		expected := mockCandidate
+		expected.Stage = constants.CANDIDATE_STAGE_ONSITE
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: candidateDocument(expected)},
+		})
+		data, err := trepo.candidateRepo.Update(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, &expected, data)
+	})
+	mt.Run("update candidate not found", func(mt *mtest.T) {
+		trepo := newTestCandidateRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		data, err := trepo.candidateRepo.Update(ctx, params)
+		assert.Nil(t, err)
+		assert.Nil(t, data)
+	})
+}
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type idempotencyKeyRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewIdempotencyKeyRepository(mc *mongo.Client, db string) ports.IdempotencyKeyRepository {
+	cn := "idempotencyKey"
+	return &idempotencyKeyRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *idempotencyKeyRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Create reserves a key for a user. It returns nil when the user already
+// used the key.
+func (r *idempotencyKeyRepository) Create(ctx context.Context, params *domains.CreateIdempotencyKeyParams) (*domains.IdempotencyKey, error) {
+	key := domains.IdempotencyKey{
+		ID:          primitive.NewObjectID(),
+		UserID:      params.UserID,
+		Key:         params.Key,
+		Fingerprint: params.Fingerprint,
+		ExpiresAt:   params.ExpiresAt,
+		CreatedAt:   time.Now(),
+	}
+	if _, err := r.col.InsertOne(ctx, key); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+// Get returns the key of a user, or nil when there is none. Expired keys may
+// still be returned until MongoDB removes them.
+func (r *idempotencyKeyRepository) Get(ctx context.Context, userID primitive.ObjectID, key string) (*domains.IdempotencyKey, error) {
+	filter := bson.D{{Key: "userId", Value: userID}, {Key: "key", Value: key}}
+	res := domains.IdempotencyKey{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *idempotencyKeyRepository) SaveResponse(ctx context.Context, params *domains.SaveIdempotentResponseParams) error {
+	filter := bson.D{{Key: "_id", Value: params.ID}}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "statusCode", Value: params.StatusCode},
+		{Key: "header", Value: params.Header},
+		{Key: "body", Value: params.Body},
+	}}}
+	if _, err := r.col.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Delete releases a key so the request can be sent again.
+func (r *idempotencyKeyRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	if _, err := r.col.DeleteOne(ctx, bson.D{{Key: "_id", Value: id}}); err != nil {
+		return err
+	}
+	return nil
+}
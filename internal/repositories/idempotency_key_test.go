@@ -0,0 +1,150 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testIdempotencyKeyRepository struct {
+	idempotencyKeyRepo ports.IdempotencyKeyRepository
+}
+
+func newTestIdempotencyKeyRepository(mc *mongo.Client, db string) testIdempotencyKeyRepository {
+	idempotencyKeyRepo := repositories.NewIdempotencyKeyRepository(mc, db)
+	return testIdempotencyKeyRepository{idempotencyKeyRepo}
+}
+
+var mockIdempotencyKey = domains.IdempotencyKey{
+	ID:          primitive.NewObjectID(),
+	UserID:      primitive.NewObjectID(),
+	Key:         "key-1",
+	Fingerprint: "fingerprint",
+	StatusCode:  201,
+	Header:      map[string][]string{"Content-Type": {"application/json; charset=utf-8"}},
+	Body:        []byte(`{"statusCode":201}`),
+	ExpiresAt:   time.Now().Add(24 * time.Hour).Truncate(time.Millisecond).UTC(),
+	CreatedAt:   time.Now().Truncate(time.Millisecond).UTC(),
+}
+
+func TestCreateIdempotencyKey(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.CreateIdempotencyKeyParams{
+		UserID:      mockIdempotencyKey.UserID,
+		Key:         mockIdempotencyKey.Key,
+		Fingerprint: mockIdempotencyKey.Fingerprint,
+		ExpiresAt:   mockIdempotencyKey.ExpiresAt,
+	}
+	mt.Run("create idempotency key success", func(mt *mtest.T) {
+		trepo := newTestIdempotencyKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		data, err := trepo.idempotencyKeyRepo.Create(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, params.UserID, data.UserID)
+		assert.Equal(t, params.Key, data.Key)
+		assert.Equal(t, params.Fingerprint, data.Fingerprint)
+		assert.Equal(t, 0, data.StatusCode)
+	})
+	mt.Run("create idempotency key already used", func(mt *mtest.T) {
+		trepo := newTestIdempotencyKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "duplicate key error",
+		}))
+		data, err := trepo.idempotencyKeyRepo.Create(ctx, params)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+	})
+	mt.Run("create idempotency key error", func(mt *mtest.T) {
+		trepo := newTestIdempotencyKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    2,
+			Message: "insert fail",
+		}))
+		data, err := trepo.idempotencyKeyRepo.Create(ctx, params)
+		assert.Error(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestGetIdempotencyKey(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get idempotency key success", func(mt *mtest.T) {
+		trepo := newTestIdempotencyKeyRepository(mt.Client, dbName)
+		expected := mockIdempotencyKey
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, "idempotencyKey"), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: expected.ID},
+			{Key: "userId", Value: expected.UserID},
+			{Key: "key", Value: expected.Key},
+			{Key: "fingerprint", Value: expected.Fingerprint},
+			{Key: "statusCode", Value: expected.StatusCode},
+			{Key: "header", Value: expected.Header},
+			{Key: "body", Value: expected.Body},
+			{Key: "expiresAt", Value: expected.ExpiresAt},
+			{Key: "createdAt", Value: expected.CreatedAt},
+		}))
+		data, err := trepo.idempotencyKeyRepo.Get(ctx, expected.UserID, expected.Key)
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, data)
+	})
+	mt.Run("get idempotency key not found", func(mt *mtest.T) {
+		trepo := newTestIdempotencyKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, "idempotencyKey"), mtest.FirstBatch))
+		data, err := trepo.idempotencyKeyRepo.Get(ctx, mockIdempotencyKey.UserID, mockIdempotencyKey.Key)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestSaveIdempotentResponse(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.SaveIdempotentResponseParams{
+		ID:         mockIdempotencyKey.ID,
+		StatusCode: mockIdempotencyKey.StatusCode,
+		Header:     mockIdempotencyKey.Header,
+		Body:       mockIdempotencyKey.Body,
+	}
+	mt.Run("save idempotent response success", func(mt *mtest.T) {
+		trepo := newTestIdempotencyKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}})
+		err := trepo.idempotencyKeyRepo.SaveResponse(ctx, params)
+		assert.NoError(t, err)
+	})
+	mt.Run("save idempotent response error", func(mt *mtest.T) {
+		trepo := newTestIdempotencyKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 2, Message: "update fail"}))
+		err := trepo.idempotencyKeyRepo.SaveResponse(ctx, params)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteIdempotencyKey(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("delete idempotency key success", func(mt *mtest.T) {
+		trepo := newTestIdempotencyKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}})
+		err := trepo.idempotencyKeyRepo.Delete(ctx, mockIdempotencyKey.ID)
+		assert.NoError(t, err)
+	})
+	mt.Run("delete idempotency key error", func(mt *mtest.T) {
+		trepo := newTestIdempotencyKeyRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 2, Message: "delete fail"}))
+		err := trepo.idempotencyKeyRepo.Delete(ctx, mockIdempotencyKey.ID)
+		assert.Error(t, err)
+	})
+}
@@ -19,6 +19,55 @@ type interviewAppointmentRepository struct {
 	col *mongo.Collection
 }
 
+// interviewersLookup joins the assigned interviewers into "interviewers".
+var interviewersLookup = bson.D{{
+	Key: "$lookup",
+	Value: bson.D{
+		{Key: "from", Value: "user"},
+		{Key: "localField", Value: "interviewerIds"},
+		{Key: "foreignField", Value: "_id"},
+		{Key: "as", Value: "interviewers"},
+	},
+}}
+
+// candidateLookup joins the linked candidate into "candidate".
+var candidateLookup = []bson.D{
+	{{
+		Key: "$lookup",
+		Value: bson.D{
+			{Key: "from", Value: "candidate"},
+			{Key: "localField", Value: "candidateId"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "candidate"},
+		},
+	}},
+	{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$candidate"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
+}
+
+// scorecardLookup joins the scorecard template into "scorecardTemplate" and
+// the submitted scorecards into "scorecards".
+var scorecardLookup = []bson.D{
+	{{
+		Key: "$lookup",
+		Value: bson.D{
+			{Key: "from", Value: "scorecardTemplate"},
+			{Key: "localField", Value: "scorecardTemplateId"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "scorecardTemplate"},
+		},
+	}},
+	{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$scorecardTemplate"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
+	{{
+		Key: "$lookup",
+		Value: bson.D{
+			{Key: "from", Value: "scorecard"},
+			{Key: "localField", Value: "_id"},
+			{Key: "foreignField", Value: "appointmentId"},
+			{Key: "as", Value: "scorecards"},
+		},
+	}},
+}
+
 func NewInterviewAppointmentRepository(mc *mongo.Client, db string) ports.InterviewAppointmentRepository {
 	cn := "interviewAppointment"
 	return &interviewAppointmentRepository{
@@ -29,10 +78,97 @@ func NewInterviewAppointmentRepository(mc *mongo.Client, db string) ports.Interv
 	}
 }
 
-func (r *interviewAppointmentRepository) GetAll(ctx context.Context, offset uint32, limit uint32) ([]domains.InterviewAppointment, error) {
-	pipeline := []bson.D{
-		{{Key: "$match", Value: bson.D{{Key: "isArchived", Value: false}}}},
-		{{
+func (r *interviewAppointmentRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "isArchived", Value: 1}, {Key: "startAt", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "interviewerIds", Value: 1}, {Key: "startAt", Value: 1}, {Key: "endAt", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "candidateId", Value: 1}, {Key: "startAt", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "isArchived", Value: 1}, {Key: "createdAt", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "tags", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "title", Value: "text"}, {Key: "description", Value: "text"}},
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetAll returns a page of appointments. The total is only counted, with a
+// $facet next to the page, when params.WithTotal is set.
+func (r *interviewAppointmentRepository) GetAll(ctx context.Context, params *domains.GetInterviewAppointmentsParams) ([]domains.InterviewAppointment, *int64, error) {
+	match := bson.D{}
+	if params.Search != "" {
+		// $text has to be in the first stage, and inside $or only next to
+		// indexed fields.
+		text := bson.D{{Key: "$text", Value: bson.D{{Key: "$search", Value: params.Search}}}}
+		if len(params.SearchIDs) > 0 {
+			match = append(match, bson.E{Key: "$or", Value: bson.A{
+				text,
+				bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: params.SearchIDs}}}},
+			}})
+		} else {
+			match = append(match, text[0])
+		}
+	}
+	match = append(match, bson.E{Key: "isArchived", Value: params.Archived})
+	if params.Status != "" {
+		match = append(match, bson.E{Key: "status", Value: params.Status})
+	}
+	if !params.CreatedBy.IsZero() {
+		match = append(match, bson.E{Key: "createUserId", Value: params.CreatedBy})
+	}
+	if params.Tag != "" {
+		match = append(match, bson.E{Key: "tags", Value: params.Tag})
+	}
+	if params.StartFrom != nil || params.StartTo != nil {
+		startAt := bson.D{}
+		if params.StartFrom != nil {
+			startAt = append(startAt, bson.E{Key: "$gte", Value: *params.StartFrom})
+		}
+		if params.StartTo != nil {
+			startAt = append(startAt, bson.E{Key: "$lt", Value: *params.StartTo})
+		}
+		match = append(match, bson.E{Key: "startAt", Value: startAt})
+	}
+
+	// Going back from a cursor walks the list in the opposite direction.
+	direction := 1
+	if params.SortDesc != (params.Cursor != nil && params.Cursor.Before) {
+		direction = -1
+	}
+	items := []bson.D{}
+	if params.SortBy == "archivedAt" {
+		// Appointments archived before archivedAt was recorded sort, and
+		// page, by updatedAt instead.
+		items = append(items, bson.D{{Key: "$set", Value: bson.D{
+			{Key: "archivedAt", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$archivedAt", "$updatedAt"}}}},
+		}}})
+	}
+	if params.Cursor != nil {
+		op := "$gt"
+		if direction < 0 {
+			op = "$lt"
+		}
+		items = append(items, bson.D{{Key: "$match", Value: bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: params.SortBy, Value: bson.D{{Key: op, Value: params.Cursor.SortValue}}}},
+			bson.D{{Key: params.SortBy, Value: params.Cursor.SortValue}, {Key: "_id", Value: bson.D{{Key: op, Value: params.Cursor.ID}}}},
+		}}}}})
+	}
+	items = append(items,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: params.SortBy, Value: direction}, {Key: "_id", Value: direction}}}},
+		bson.D{{
 			Key: "$lookup",
 			Value: bson.D{
 				{Key: "from", Value: "user"},
@@ -41,56 +177,72 @@ func (r *interviewAppointmentRepository) GetAll(ctx context.Context, offset uint
 				{Key: "as", Value: "createUser"},
 			},
 		}},
-		{{
+		bson.D{{
 			Key: "$unwind",
 			Value: bson.D{
 				{Key: "path", Value: "$createUser"},
 				{Key: "preserveNullAndEmptyArrays", Value: false},
 			},
 		}},
-		{{Key: "$skip", Value: offset}},
-		{{Key: "$limit", Value: limit}},
+		bson.D{{Key: "$skip", Value: params.Offset}},
+		bson.D{{Key: "$limit", Value: params.Limit}},
+		interviewersLookup,
+	)
+	items = append(items, candidateLookup...)
+
+	pipeline := []bson.D{{{Key: "$match", Value: match}}}
+	if !params.WithTotal {
+		pipeline = append(pipeline, items...)
+		res := []domains.InterviewAppointment{}
+		cur, err := r.col.Aggregate(ctx, pipeline)
+		if err != nil {
+			return res, nil, err
+		}
+		if err := cur.All(ctx, &res); err != nil {
+			return res, nil, err
+		}
+		return res, nil, nil
 	}
 
-	res := []domains.InterviewAppointment{}
+	pipeline = append(pipeline, bson.D{{Key: "$facet", Value: bson.D{
+		{Key: "items", Value: items},
+		{Key: "total", Value: bson.A{bson.D{{Key: "$count", Value: "count"}}}},
+	}}})
+	res := []struct {
+		Items []domains.InterviewAppointment `bson:"items"`
+		Total []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+	}{}
 	cur, err := r.col.Aggregate(ctx, pipeline)
 	if err != nil {
-		return res, err
+		return []domains.InterviewAppointment{}, nil, err
 	}
 	if err := cur.All(ctx, &res); err != nil {
-		return res, err
+		return []domains.InterviewAppointment{}, nil, err
 	}
-	return res, nil
+	var total int64
+	if len(res) == 0 {
+		return []domains.InterviewAppointment{}, &total, nil
+	}
+	if len(res[0].Total) > 0 {
+		total = res[0].Total[0].Count
+	}
+	return res[0].Items, &total, nil
 }
 
 func (r *interviewAppointmentRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.InterviewAppointment, error) {
+	return r.getOne(ctx, id, false)
+}
+
+// GetArchived returns an archived appointment, or nil when id is not archived.
+func (r *interviewAppointmentRepository) GetArchived(ctx context.Context, id primitive.ObjectID) (*domains.InterviewAppointment, error) {
+	return r.getOne(ctx, id, true)
+}
+
+func (r *interviewAppointmentRepository) getOne(ctx context.Context, id primitive.ObjectID, archived bool) (*domains.InterviewAppointment, error) {
 	pipeline := []bson.D{
-		{{Key: "$match", Value: bson.D{{Key: "_id", Value: id}, {Key: "isArchived", Value: false}}}},
-		{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$comments"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
-		{{
-			Key: "$lookup",
-			Value: bson.D{
-				{Key: "from", Value: "user"},
-				{Key: "localField", Value: "comments.userId"},
-				{Key: "foreignField", Value: "_id"},
-				{Key: "as", Value: "comments.user"},
-			},
-		}},
-		{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$comments.userId"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
-		{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$comments.user"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
-		{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$_id"}, {Key: "comments", Value: bson.D{{Key: "$push", Value: "$comments"}}}}}},
-		{{
-			Key: "$lookup",
-			Value: bson.D{
-				{Key: "from", Value: "interviewAppointment"},
-				{Key: "localField", Value: "_id"},
-				{Key: "foreignField", Value: "_id"},
-				{Key: "as", Value: "interviewDetail"},
-			},
-		}},
-		{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$interviewDetail"}}}},
-		{{Key: "$addFields", Value: bson.D{{Key: "interviewDetail.comments", Value: "$comments"}}}},
-		{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: "$interviewDetail"}}}},
+		{{Key: "$match", Value: bson.D{{Key: "_id", Value: id}, {Key: "isArchived", Value: archived}}}},
 		{{
 			Key: "$lookup",
 			Value: bson.D{
@@ -101,8 +253,11 @@ func (r *interviewAppointmentRepository) Get(ctx context.Context, id primitive.O
 			},
 		}},
 		{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$createUser"}, {Key: "preserveNullAndEmptyArrays", Value: false}}}},
+		interviewersLookup,
 		{{Key: "$limit", Value: 1}},
 	}
+	pipeline = append(pipeline, candidateLookup...)
+	pipeline = append(pipeline, scorecardLookup...)
 	res := []domains.InterviewAppointment{}
 	cur, err := r.col.Aggregate(ctx, pipeline)
 	if err != nil {
@@ -120,14 +275,29 @@ func (r *interviewAppointmentRepository) Get(ctx context.Context, id primitive.O
 func (r *interviewAppointmentRepository) Create(ctx context.Context, params *domains.CreateInterviewAppointmentParams) (*domains.CreateInterviewAppointment, error) {
 	now := time.Now()
 	interviewAppointment := domains.CreateInterviewAppointment{
-		ID:           primitive.NewObjectID(),
-		Title:        params.Title,
-		Description:  params.Description,
-		Status:       "TODO",
-		Comments:     []domains.InterviewComment{},
-		CreateUserId: params.UserID,
-		CreatedAt:    now,
-		UpdatedAt:    now,
+		ID:          primitive.NewObjectID(),
+		Title:       params.Title,
+		Description: params.Description,
+		Status:      params.Status,
+		StatusHistory: []domains.InterviewStatusChange{
+			{To: params.Status, UserID: params.UserID, ChangedAt: now},
+		},
+		InterviewerIDs:      []primitive.ObjectID{},
+		CandidateID:         params.CandidateID,
+		ScorecardTemplateID: params.ScorecardTemplateID,
+		BlindFeedback:       params.BlindFeedback,
+		HiringManagerID:     params.HiringManagerID,
+		StartAt:             params.Schedule.StartAt,
+		EndAt:               params.Schedule.EndAt,
+		DurationMinutes:     params.Schedule.DurationMinutes,
+		Timezone:            params.Schedule.Timezone,
+		Location:            params.Schedule.Location,
+		MeetingURL:          params.Schedule.MeetingURL,
+		Tags:                params.Tags,
+		CreateUserId:        params.UserID,
+		Version:             1,
+		CreatedAt:           now,
+		UpdatedAt:           now,
 	}
 	if _, err := r.col.InsertOne(ctx, interviewAppointment); err != nil {
 		return nil, err
@@ -137,6 +307,9 @@ func (r *interviewAppointmentRepository) Create(ctx context.Context, params *dom
 
 func (r *interviewAppointmentRepository) Update(ctx context.Context, params *domains.UpdateInterviewAppointmentParams) (*domains.InterviewAppointment, error) {
 	filter := bson.D{{Key: "_id", Value: params.ID}, {Key: "isArchived", Value: false}}
+	if params.Version != nil {
+		filter = append(filter, versionFilter(*params.Version))
+	}
 	updateValue := bson.D{{Key: "updatedAt", Value: time.Now()}}
 	if params.Title != "" {
 		updateValue = append(updateValue, bson.E{Key: "title", Value: params.Title})
@@ -144,10 +317,45 @@ func (r *interviewAppointmentRepository) Update(ctx context.Context, params *dom
 	if params.Description != "" {
 		updateValue = append(updateValue, bson.E{Key: "description", Value: params.Description})
 	}
-	if params.Status != "" {
-		updateValue = append(updateValue, bson.E{Key: "status", Value: params.Status})
+	if params.StatusChange != nil {
+		filter = append(filter, bson.E{Key: "status", Value: params.StatusChange.From})
+		updateValue = append(updateValue, bson.E{Key: "status", Value: params.StatusChange.To})
+	}
+	if !params.CandidateID.IsZero() {
+		updateValue = append(updateValue, bson.E{Key: "candidateId", Value: params.CandidateID})
+	}
+	if !params.ScorecardTemplateID.IsZero() {
+		updateValue = append(updateValue, bson.E{Key: "scorecardTemplateId", Value: params.ScorecardTemplateID})
+	}
+	if params.BlindFeedback != nil {
+		updateValue = append(updateValue, bson.E{Key: "blindFeedback", Value: *params.BlindFeedback})
+	}
+	if params.Tags != nil {
+		updateValue = append(updateValue, bson.E{Key: "tags", Value: *params.Tags})
+	}
+	if !params.HiringManagerID.IsZero() {
+		updateValue = append(updateValue, bson.E{Key: "hiringManagerId", Value: params.HiringManagerID})
+	}
+	if !params.Schedule.StartAt.IsZero() {
+		updateValue = append(updateValue,
+			bson.E{Key: "startAt", Value: params.Schedule.StartAt},
+			bson.E{Key: "endAt", Value: params.Schedule.EndAt},
+			bson.E{Key: "durationMinutes", Value: params.Schedule.DurationMinutes},
+		)
+	}
+	if params.Schedule.Timezone != "" {
+		updateValue = append(updateValue, bson.E{Key: "timezone", Value: params.Schedule.Timezone})
+	}
+	if params.Schedule.Location != "" {
+		updateValue = append(updateValue, bson.E{Key: "location", Value: params.Schedule.Location})
+	}
+	if params.Schedule.MeetingURL != "" {
+		updateValue = append(updateValue, bson.E{Key: "meetingUrl", Value: params.Schedule.MeetingURL})
+	}
+	update := bson.D{{Key: "$set", Value: updateValue}, {Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}}}
+	if params.StatusChange != nil {
+		update = append(update, bson.E{Key: "$push", Value: bson.D{{Key: "statusHistory", Value: params.StatusChange}}})
 	}
-	update := bson.D{{Key: "$set", Value: updateValue}}
 	opts := &options.FindOneAndUpdateOptions{}
 	opts.SetReturnDocument(options.After).SetUpsert(false)
 	res := domains.InterviewAppointment{}
@@ -164,28 +372,41 @@ func (r *interviewAppointmentRepository) Update(ctx context.Context, params *dom
 	return &res, nil
 }
 
-func (r *interviewAppointmentRepository) ArchiveInterviewAppointment(ctx context.Context, id primitive.ObjectID) error {
-	filter := bson.D{{Key: "_id", Value: id}, {Key: "isArchived", Value: false}}
-	update := bson.D{{Key: "$set", Value: bson.D{{Key: "isArchived", Value: true}}}}
-	opts := &options.FindOneAndUpdateOptions{}
-	opts.SetUpsert(false)
-	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Err(); err != nil {
-		return err
+// versionFilter matches documents at version. Documents written before
+// versions were added have no version field and count as version 0.
+func versionFilter(version int64) bson.E {
+	if version == 0 {
+		return bson.E{Key: "version", Value: bson.D{{Key: "$in", Value: bson.A{0, nil}}}}
 	}
-	return nil
+	return bson.E{Key: "version", Value: version}
 }
 
-func (r *interviewAppointmentRepository) AddComment(ctx context.Context, params *domains.AddInterviewCommentParams) error {
-	now := time.Now()
+// GetStatusesInUse returns the distinct statuses of appointments that are not
+// archived.
+func (r *interviewAppointmentRepository) GetStatusesInUse(ctx context.Context) ([]string, error) {
+	filter := bson.D{{Key: "isArchived", Value: false}}
+	values, err := r.col.Distinct(ctx, "status", filter)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, 0, len(values))
+	for _, value := range values {
+		if status, ok := value.(string); ok {
+			res = append(res, status)
+		}
+	}
+	return res, nil
+}
+
+func (r *interviewAppointmentRepository) UpdateInterviewers(ctx context.Context, params *domains.UpdateInterviewersParams) error {
 	filter := bson.D{{Key: "_id", Value: params.ID}, {Key: "isArchived", Value: false}}
-	comment := domains.AddInterviewComment{
-		ID:        primitive.NewObjectID(),
-		Comment:   params.Comment,
-		UserID:    params.UserID,
-		CreatedAt: now,
-		UpdatedAt: now,
-	}
-	update := bson.D{{Key: "$push", Value: bson.D{{Key: "comments", Value: comment}}}}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "interviewerIds", Value: params.InterviewerIDs},
+			{Key: "updatedAt", Value: time.Now()},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+	}
 	opts := &options.FindOneAndUpdateOptions{}
 	opts.SetUpsert(false)
 	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Err(); err != nil {
@@ -194,18 +415,108 @@ func (r *interviewAppointmentRepository) AddComment(ctx context.Context, params
 	return nil
 }
 
-func (r *interviewAppointmentRepository) UpdateComment(ctx context.Context, params *domains.UpdateInterviewCommentParams) error {
-	now := time.Now()
+func (r *interviewAppointmentRepository) FindConflicts(ctx context.Context, params *domains.FindInterviewConflictsParams) ([]domains.InterviewAppointment, error) {
 	filter := bson.D{
-		{Key: "_id", Value: params.ID},
+		{Key: "interviewerIds", Value: bson.D{{Key: "$in", Value: params.InterviewerIDs}}},
+		{Key: "startAt", Value: bson.D{{Key: "$lt", Value: params.EndAt}}},
+		{Key: "endAt", Value: bson.D{{Key: "$gt", Value: params.StartAt}}},
 		{Key: "isArchived", Value: false},
-		{Key: "comments._id", Value: params.CommentID},
+		{Key: "_id", Value: bson.D{{Key: "$ne", Value: params.ExcludeID}}},
 	}
+	opts := options.Find().SetSort(bson.D{{Key: "startAt", Value: 1}})
+
+	res := []domains.InterviewAppointment{}
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (r *interviewAppointmentRepository) GetByInterviewer(ctx context.Context, params *domains.GetInterviewerAppointmentsParams) ([]domains.InterviewAppointment, error) {
+	pipeline := []bson.D{
+		{{Key: "$match", Value: bson.D{
+			{Key: "interviewerIds", Value: params.InterviewerID},
+			{Key: "endAt", Value: bson.D{{Key: "$gt", Value: params.EndAfter}}},
+			{Key: "isArchived", Value: false},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "startAt", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$skip", Value: params.Offset}},
+		{{Key: "$limit", Value: params.Limit}},
+		{{
+			Key: "$lookup",
+			Value: bson.D{
+				{Key: "from", Value: "user"},
+				{Key: "localField", Value: "createUserId"},
+				{Key: "foreignField", Value: "_id"},
+				{Key: "as", Value: "createUser"},
+			},
+		}},
+		{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$createUser"}, {Key: "preserveNullAndEmptyArrays", Value: false}}}},
+		interviewersLookup,
+	}
+	pipeline = append(pipeline, candidateLookup...)
+
+	res := []domains.InterviewAppointment{}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// GetBlindByIDs returns the appointments in ids that have blind feedback,
+// with their scorecards.
+func (r *interviewAppointmentRepository) GetBlindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]domains.InterviewAppointment, error) {
+	pipeline := []bson.D{
+		{{Key: "$match", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}},
+			{Key: "blindFeedback", Value: true},
+		}}},
+	}
+	pipeline = append(pipeline, scorecardLookup...)
+
+	res := []domains.InterviewAppointment{}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (r *interviewAppointmentRepository) ArchiveInterviewAppointment(ctx context.Context, params *domains.ArchiveInterviewAppointmentParams) error {
+	filter := bson.D{{Key: "_id", Value: params.ID}, {Key: "isArchived", Value: false}}
 	update := bson.D{
 		{Key: "$set", Value: bson.D{
-			{Key: "comments.$.comment", Value: params.Comment},
-			{Key: "comments.$.updatedAt", Value: now},
+			{Key: "isArchived", Value: true},
+			{Key: "archivedAt", Value: time.Now()},
+			{Key: "archivedBy", Value: params.UserID},
 		}},
+		{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+	}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetUpsert(false)
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *interviewAppointmentRepository) UnarchiveInterviewAppointment(ctx context.Context, id primitive.ObjectID) error {
+	filter := bson.D{{Key: "_id", Value: id}, {Key: "isArchived", Value: true}}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{{Key: "isArchived", Value: false}, {Key: "updatedAt", Value: time.Now()}}},
+		{Key: "$unset", Value: bson.D{{Key: "archivedAt", Value: ""}, {Key: "archivedBy", Value: ""}}},
+		{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
 	}
 	opts := &options.FindOneAndUpdateOptions{}
 	opts.SetUpsert(false)
@@ -214,3 +525,72 @@ func (r *interviewAppointmentRepository) UpdateComment(ctx context.Context, para
 	}
 	return nil
 }
+
+// archivedBeforeFilter matches appointments archived before the given time.
+// Appointments archived before archivedAt was recorded fall back to
+// updatedAt.
+func archivedBeforeFilter(before time.Time) bson.D {
+	return bson.D{
+		{Key: "isArchived", Value: true},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "archivedAt", Value: bson.D{{Key: "$lt", Value: before}}}},
+			bson.D{{Key: "archivedAt", Value: bson.D{{Key: "$exists", Value: false}}}, {Key: "updatedAt", Value: bson.D{{Key: "$lt", Value: before}}}},
+		}},
+	}
+}
+
+// findIDs returns the ids of the appointments matching filter.
+func (r *interviewAppointmentRepository) findIDs(ctx context.Context, filter bson.D) ([]primitive.ObjectID, error) {
+	opts := options.Find().SetProjection(bson.D{{Key: "_id", Value: 1}})
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	docs := []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}{}
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	ids := make([]primitive.ObjectID, len(docs))
+	for i, doc := range docs {
+		ids[i] = doc.ID
+	}
+	return ids, nil
+}
+
+// GetArchivedIDsBefore returns the appointments archived before the given
+// time.
+func (r *interviewAppointmentRepository) GetArchivedIDsBefore(ctx context.Context, before time.Time) ([]primitive.ObjectID, error) {
+	return r.findIDs(ctx, archivedBeforeFilter(before))
+}
+
+// DeleteArchived permanently deletes those of the given appointments that are
+// still archived before the given time and returns the ids it deleted. An
+// appointment restored since the lookup no longer matches and is kept.
+func (r *interviewAppointmentRepository) DeleteArchived(ctx context.Context, ids []primitive.ObjectID, before time.Time) ([]primitive.ObjectID, error) {
+	filter := append(bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}}, archivedBeforeFilter(before)...)
+	res, err := r.col.DeleteMany(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if res.DeletedCount == int64(len(ids)) {
+		return ids, nil
+	}
+	// Whatever is left of ids was kept; everything else was deleted.
+	kept, err := r.findIDs(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}})
+	if err != nil {
+		return nil, err
+	}
+	keep := make(map[primitive.ObjectID]bool, len(kept))
+	for _, id := range kept {
+		keep[id] = true
+	}
+	deleted := []primitive.ObjectID{}
+	for _, id := range ids {
+		if !keep[id] {
+			deleted = append(deleted, id)
+		}
+	}
+	return deleted, nil
+}
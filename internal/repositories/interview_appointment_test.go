@@ -7,6 +7,7 @@ import (
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/repositories"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
@@ -32,7 +33,6 @@ var (
 		ID:          primitive.NewObjectID(),
 		Title:       "Title 1",
 		Description: "Description 1",
-		Comments:    []domains.InterviewComment{},
 		Status:      "TODO",
 		IsArchived:  false,
 		CreateUser: domains.User{
@@ -49,7 +49,6 @@ var (
 		ID:          primitive.NewObjectID(),
 		Title:       "Title 2",
 		Description: "Description 2",
-		Comments:    []domains.InterviewComment{},
 		Status:      "TODO",
 		IsArchived:  false,
 		CreateUser: domains.User{
@@ -73,7 +72,6 @@ func TestGetAll(t *testing.T) {
 			{Key: "_id", Value: mockInterviewAppointment1.ID},
 			{Key: "title", Value: mockInterviewAppointment1.Title},
 			{Key: "description", Value: mockInterviewAppointment1.Description},
-			{Key: "comments", Value: bson.A{}},
 			{Key: "status", Value: mockInterviewAppointment1.Status},
 			{Key: "isArchived", Value: mockInterviewAppointment1.IsArchived},
 			{Key: "createUser", Value: mockInterviewAppointment1.CreateUser},
@@ -82,20 +80,152 @@ func TestGetAll(t *testing.T) {
 			{Key: "_id", Value: mockInterviewAppointment2.ID},
 			{Key: "title", Value: mockInterviewAppointment2.Title},
 			{Key: "description", Value: mockInterviewAppointment2.Description},
-			{Key: "comments", Value: bson.A{}},
 			{Key: "status", Value: mockInterviewAppointment2.Status},
 			{Key: "isArchived", Value: mockInterviewAppointment2.IsArchived},
 			{Key: "createUser", Value: mockInterviewAppointment2.CreateUser},
 		})
 		killCursors := mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.NextBatch)
 		mt.AddMockResponses(first, second, killCursors)
-		data, err := trepo.interviewRepo.GetAll(ctx, 0, 20)
+		data, total, err := trepo.interviewRepo.GetAll(ctx, &domains.GetInterviewAppointmentsParams{SortBy: "createdAt", Offset: 0, Limit: 20})
 		assert.Nil(t, err)
+		assert.Nil(t, total)
 		assert.Equal(t, []domains.InterviewAppointment{
 			mockInterviewAppointment1,
 			mockInterviewAppointment2,
 		}, data)
 	})
+	mt.Run("get all in date range", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		startAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		endAt := startAt.Add(time.Hour)
+		expected := mockInterviewAppointment1
+		expected.StartAt = startAt
+		expected.EndAt = endAt
+		expected.DurationMinutes = 60
+		expected.Timezone = "Asia/Bangkok"
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockInterviewAppointment1.ID},
+			{Key: "title", Value: mockInterviewAppointment1.Title},
+			{Key: "description", Value: mockInterviewAppointment1.Description},
+			{Key: "status", Value: mockInterviewAppointment1.Status},
+			{Key: "isArchived", Value: mockInterviewAppointment1.IsArchived},
+			{Key: "startAt", Value: startAt},
+			{Key: "endAt", Value: endAt},
+			{Key: "durationMinutes", Value: 60},
+			{Key: "timezone", Value: "Asia/Bangkok"},
+			{Key: "createUser", Value: mockInterviewAppointment1.CreateUser},
+		}))
+		from := time.Date(2023, 7, 10, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 1)
+		data, _, err := trepo.interviewRepo.GetAll(ctx, &domains.GetInterviewAppointmentsParams{StartFrom: &from, StartTo: &to, SortBy: "createdAt", Offset: 0, Limit: 20})
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.InterviewAppointment{expected}, data)
+	})
+	mt.Run("get all with filters and search", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		expected := mockInterviewAppointment1
+		expected.Tags = []string{"backend"}
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockInterviewAppointment1.ID},
+			{Key: "title", Value: mockInterviewAppointment1.Title},
+			{Key: "description", Value: mockInterviewAppointment1.Description},
+			{Key: "status", Value: mockInterviewAppointment1.Status},
+			{Key: "isArchived", Value: mockInterviewAppointment1.IsArchived},
+			{Key: "tags", Value: bson.A{"backend"}},
+			{Key: "createUser", Value: mockInterviewAppointment1.CreateUser},
+		}))
+		params := &domains.GetInterviewAppointmentsParams{
+			Status:    mockInterviewAppointment1.Status,
+			CreatedBy: mockInterviewAppointment1.CreateUser.ID,
+			Tag:       "backend",
+			Search:    "golang",
+			SearchIDs: []primitive.ObjectID{mockInterviewAppointment2.ID},
+			SortBy:    "title",
+			Offset:    0,
+			Limit:     20,
+		}
+		data, _, err := trepo.interviewRepo.GetAll(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.InterviewAppointment{expected}, data)
+		pipeline := mt.GetStartedEvent().Command.Lookup("pipeline").Array()
+		match := pipeline.Index(0).Value().Document().Lookup("$match").Document()
+		or := match.Lookup("$or").Array()
+		assert.Equal(t, "golang", or.Index(0).Value().Document().Lookup("$text", "$search").StringValue())
+		assert.Equal(t, "backend", match.Lookup("tags").StringValue())
+		sort := pipeline.Index(1).Value().Document().Lookup("$sort").Document()
+		assert.Equal(t, int32(1), sort.Lookup("title").Int32())
+	})
+	mt.Run("get all before cursor", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch))
+		createdAt := time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC)
+		params := &domains.GetInterviewAppointmentsParams{
+			SortBy:   "createdAt",
+			SortDesc: true,
+			Cursor:   &domains.InterviewAppointmentCursor{SortValue: createdAt, ID: mockInterviewAppointment1.ID, Before: true},
+			Limit:    21,
+		}
+		data, _, err := trepo.interviewRepo.GetAll(ctx, params)
+		assert.Nil(t, err)
+		assert.Empty(t, data)
+		pipeline := mt.GetStartedEvent().Command.Lookup("pipeline").Array()
+		or := pipeline.Index(1).Value().Document().Lookup("$match", "$or").Array()
+		assert.Equal(t, createdAt, or.Index(0).Value().Document().Lookup("createdAt", "$gt").Time().UTC())
+		assert.Equal(t, mockInterviewAppointment1.ID, or.Index(1).Value().Document().Lookup("_id", "$gt").ObjectID())
+		sort := pipeline.Index(2).Value().Document().Lookup("$sort").Document()
+		assert.Equal(t, int32(1), sort.Lookup("createdAt").Int32())
+	})
+	mt.Run("get all archived falls back to updatedAt", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch))
+		archivedAt := time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC)
+		params := &domains.GetInterviewAppointmentsParams{
+			Archived: true,
+			SortBy:   "archivedAt",
+			SortDesc: true,
+			Cursor:   &domains.InterviewAppointmentCursor{SortValue: archivedAt, ID: mockInterviewAppointment1.ID},
+			Limit:    21,
+		}
+		_, _, err := trepo.interviewRepo.GetAll(ctx, params)
+		assert.Nil(t, err)
+		pipeline := mt.GetStartedEvent().Command.Lookup("pipeline").Array()
+		ifNull := pipeline.Index(1).Value().Document().Lookup("$set", "archivedAt", "$ifNull").Array()
+		assert.Equal(t, "$archivedAt", ifNull.Index(0).Value().StringValue())
+		assert.Equal(t, "$updatedAt", ifNull.Index(1).Value().StringValue())
+		or := pipeline.Index(2).Value().Document().Lookup("$match", "$or").Array()
+		assert.Equal(t, archivedAt, or.Index(0).Value().Document().Lookup("archivedAt", "$lt").Time().UTC())
+		sort := pipeline.Index(3).Value().Document().Lookup("$sort").Document()
+		assert.Equal(t, int32(-1), sort.Lookup("archivedAt").Int32())
+	})
+	mt.Run("get all with total count", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "items", Value: bson.A{bson.D{
+				{Key: "_id", Value: mockInterviewAppointment1.ID},
+				{Key: "title", Value: mockInterviewAppointment1.Title},
+				{Key: "description", Value: mockInterviewAppointment1.Description},
+				{Key: "status", Value: mockInterviewAppointment1.Status},
+				{Key: "isArchived", Value: mockInterviewAppointment1.IsArchived},
+				{Key: "createUser", Value: mockInterviewAppointment1.CreateUser},
+			}}},
+			{Key: "total", Value: bson.A{bson.D{{Key: "count", Value: int64(42)}}}},
+		}))
+		data, total, err := trepo.interviewRepo.GetAll(ctx, &domains.GetInterviewAppointmentsParams{SortBy: "createdAt", Limit: 1, WithTotal: true})
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.InterviewAppointment{mockInterviewAppointment1}, data)
+		assert.Equal(t, int64(42), *total)
+	})
+	mt.Run("get all with total count when nothing matches", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "items", Value: bson.A{}},
+			{Key: "total", Value: bson.A{}},
+		}))
+		data, total, err := trepo.interviewRepo.GetAll(ctx, &domains.GetInterviewAppointmentsParams{SortBy: "createdAt", Limit: 21, WithTotal: true})
+		assert.Nil(t, err)
+		assert.Empty(t, data)
+		assert.Equal(t, int64(0), *total)
+	})
 	mt.Run("get all error", func(mt *mtest.T) {
 		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
 		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
@@ -103,7 +233,7 @@ func TestGetAll(t *testing.T) {
 			Code:    11000,
 			Message: "duplicate key error",
 		}))
-		data, err := trepo.interviewRepo.GetAll(ctx, 0, 20)
+		data, _, err := trepo.interviewRepo.GetAll(ctx, &domains.GetInterviewAppointmentsParams{SortBy: "createdAt", Offset: 0, Limit: 20})
 		assert.Error(t, err)
 		assert.Equal(t, []domains.InterviewAppointment{}, data)
 	})
@@ -118,7 +248,6 @@ func TestGet(t *testing.T) {
 			{Key: "_id", Value: mockInterviewAppointment1.ID},
 			{Key: "title", Value: mockInterviewAppointment1.Title},
 			{Key: "description", Value: mockInterviewAppointment1.Description},
-			{Key: "comments", Value: bson.A{}},
 			{Key: "status", Value: mockInterviewAppointment1.Status},
 			{Key: "isArchived", Value: mockInterviewAppointment1.IsArchived},
 			{Key: "createUser", Value: mockInterviewAppointment1.CreateUser},
@@ -186,7 +315,12 @@ func TestUpdate(t *testing.T) {
 			ID:          mockInterviewAppointment1.ID,
 			Title:       mockInterviewAppointment1.Title,
 			Description: mockInterviewAppointment1.Description,
-			Status:      mockInterviewAppointment1.Status,
+			StatusChange: &domains.InterviewStatusChange{
+				From:      "TODO",
+				To:        "IN_PROGRESS",
+				UserID:    userId,
+				ChangedAt: time.Now(),
+			},
 		}
 		mt.AddMockResponses(bson.D{
 			{Key: "ok", Value: 1},
@@ -194,14 +328,54 @@ func TestUpdate(t *testing.T) {
 				{Key: "_id", Value: params.ID},
 				{Key: "title", Value: params.Title},
 				{Key: "description", Value: params.Description},
-				{Key: "status", Value: params.Status},
+				{Key: "status", Value: params.StatusChange.To},
 			}},
 		})
 		data, err := trepo.interviewRepo.Update(ctx, params)
 		assert.Nil(t, err)
 		assert.Equal(t, params.Title, data.Title)
 		assert.Equal(t, params.Description, data.Description)
-		assert.Equal(t, params.Status, data.Status)
+		assert.Equal(t, params.StatusChange.To, data.Status)
+	})
+	mt.Run("update with version", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		version := int64(2)
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID:      mockInterviewAppointment1.ID,
+			Title:   mockInterviewAppointment1.Title,
+			Version: &version,
+		}
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: bson.D{
+				{Key: "_id", Value: params.ID},
+				{Key: "title", Value: params.Title},
+				{Key: "version", Value: int64(3)},
+			}},
+		})
+		data, err := trepo.interviewRepo.Update(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), data.Version)
+		command := mt.GetStartedEvent().Command
+		assert.Equal(t, int64(2), command.Lookup("query", "version").Int64())
+		assert.Equal(t, int32(1), command.Lookup("update", "$inc", "version").Int32())
+	})
+	mt.Run("update with version of an appointment without one", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		version := int64(0)
+		params := &domains.UpdateInterviewAppointmentParams{
+			ID:      mockInterviewAppointment1.ID,
+			Title:   mockInterviewAppointment1.Title,
+			Version: &version,
+		}
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+		data, err := trepo.interviewRepo.Update(ctx, params)
+		assert.Nil(t, err)
+		assert.Nil(t, data)
+		in := mt.GetStartedEvent().Command.Lookup("query", "version", "$in").Array()
+		values, _ := in.Values()
+		assert.Len(t, values, 2)
+		assert.Equal(t, bson.TypeNull, values[1].Type)
 	})
 	mt.Run("update error", func(mt *mtest.T) {
 		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
@@ -209,7 +383,6 @@ func TestUpdate(t *testing.T) {
 			ID:          mockInterviewAppointment1.ID,
 			Title:       mockInterviewAppointment1.Title,
 			Description: mockInterviewAppointment1.Description,
-			Status:      mockInterviewAppointment1.Status,
 		}
 		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
 			Index:   1,
@@ -237,7 +410,7 @@ func TestArchiveInterviewAppointment(t *testing.T) {
 				{Key: "isArchived", Value: true},
 			}},
 		})
-		err := trepo.interviewRepo.ArchiveInterviewAppointment(ctx, mockInterviewAppointment1.ID)
+		err := trepo.interviewRepo.ArchiveInterviewAppointment(ctx, &domains.ArchiveInterviewAppointmentParams{ID: mockInterviewAppointment1.ID, UserID: mockInterviewAppointment1.CreateUser.ID})
 		assert.NoError(t, err)
 	})
 	mt.Run("archive interview appointment success", func(mt *mtest.T) {
@@ -247,101 +420,256 @@ func TestArchiveInterviewAppointment(t *testing.T) {
 			Code:    11000,
 			Message: "update fail",
 		}))
-		err := trepo.interviewRepo.ArchiveInterviewAppointment(ctx, mockInterviewAppointment1.ID)
+		err := trepo.interviewRepo.ArchiveInterviewAppointment(ctx, &domains.ArchiveInterviewAppointmentParams{ID: mockInterviewAppointment1.ID, UserID: mockInterviewAppointment1.CreateUser.ID})
 		assert.Error(t, err)
 	})
 }
 
-func TestAddComment(t *testing.T) {
+func TestUnarchiveInterviewAppointment(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mt.Close()
-	mt.Run("add comment success", func(mt *mtest.T) {
+	mt.Run("unarchive interview appointment success", func(mt *mtest.T) {
 		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
-		params := &domains.AddInterviewCommentParams{
-			ID:      mockInterviewAppointment1.ID,
-			Comment: "add comment",
-			UserID:  mockInterviewAppointment1.CreateUser.ID,
-		}
-		commentId := primitive.NewObjectID()
 		mt.AddMockResponses(bson.D{
 			{Key: "ok", Value: 1},
 			{Key: "value", Value: bson.D{
 				{Key: "_id", Value: mockInterviewAppointment1.ID},
-				{Key: "title", Value: mockInterviewAppointment1.Title},
-				{Key: "description", Value: mockInterviewAppointment1.Description},
-				{Key: "comments", Value: bson.A{
-					bson.D{
-						{Key: "_id", Value: commentId},
-						{Key: "comment", Value: params.Comment},
-						{Key: "userId", Value: params.UserID},
-					},
-				}},
-				{Key: "status", Value: mockInterviewAppointment1.Status},
 				{Key: "isArchived", Value: false},
 			}},
 		})
-		err := trepo.interviewRepo.AddComment(ctx, params)
+		err := trepo.interviewRepo.UnarchiveInterviewAppointment(ctx, mockInterviewAppointment1.ID)
 		assert.NoError(t, err)
 	})
-	mt.Run("add comment error", func(mt *mtest.T) {
+	mt.Run("unarchive interview appointment error when not archived", func(mt *mtest.T) {
 		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
-		params := &domains.AddInterviewCommentParams{
-			ID:      mockInterviewAppointment1.ID,
-			Comment: "add comment",
-			UserID:  mockInterviewAppointment1.CreateUser.ID,
-		}
-		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
-			Index:   1,
-			Code:    11000,
-			Message: "update fail",
-		}))
-		err := trepo.interviewRepo.AddComment(ctx, params)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		err := trepo.interviewRepo.UnarchiveInterviewAppointment(ctx, mockInterviewAppointment1.ID)
+		assert.Equal(t, mongo.ErrNoDocuments, err)
+	})
+}
+
+func TestPurgeArchived(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get archived ids before success", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		first := mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockInterviewAppointment1.ID},
+		})
+		second := mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.NextBatch, bson.D{
+			{Key: "_id", Value: mockInterviewAppointment2.ID},
+		})
+		killCursors := mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.NextBatch)
+		mt.AddMockResponses(first, second, killCursors)
+		got, err := trepo.interviewRepo.GetArchivedIDsBefore(ctx, time.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, []primitive.ObjectID{mockInterviewAppointment1.ID, mockInterviewAppointment2.ID}, got)
+	})
+	mt.Run("delete archived success", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		before := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 2}})
+		got, err := trepo.interviewRepo.DeleteArchived(ctx, []primitive.ObjectID{mockInterviewAppointment1.ID, mockInterviewAppointment2.ID}, before)
+		assert.NoError(t, err)
+		assert.Equal(t, []primitive.ObjectID{mockInterviewAppointment1.ID, mockInterviewAppointment2.ID}, got)
+		q := mt.GetStartedEvent().Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q").Document()
+		assert.True(t, q.Lookup("isArchived").Boolean())
+		assert.Equal(t, before, q.Lookup("$or").Array().Index(0).Value().Document().Lookup("archivedAt", "$lt").Time().UTC())
+	})
+	mt.Run("delete archived skips restored appointment", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(
+			bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}},
+			mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+				{Key: "_id", Value: mockInterviewAppointment1.ID},
+			}),
+		)
+		got, err := trepo.interviewRepo.DeleteArchived(ctx, []primitive.ObjectID{mockInterviewAppointment1.ID, mockInterviewAppointment2.ID}, time.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, []primitive.ObjectID{mockInterviewAppointment2.ID}, got)
+	})
+	mt.Run("delete archived error", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+		got, err := trepo.interviewRepo.DeleteArchived(ctx, []primitive.ObjectID{mockInterviewAppointment1.ID}, time.Now())
 		assert.Error(t, err)
+		assert.Empty(t, got)
 	})
 }
 
-func TestUpdateComment(t *testing.T) {
+func TestUpdateInterviewers(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mt.Close()
-	mt.Run("update comment success", func(mt *mtest.T) {
+	interviewerId := primitive.NewObjectID()
+	mt.Run("update interviewers success", func(mt *mtest.T) {
 		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
-		params := &domains.UpdateInterviewCommentParams{
-			ID:        mockInterviewAppointment1.ID,
-			Comment:   "update comment",
-			CommentID: primitive.NewObjectID(),
-		}
 		mt.AddMockResponses(bson.D{
 			{Key: "ok", Value: 1},
 			{Key: "value", Value: bson.D{
 				{Key: "_id", Value: mockInterviewAppointment1.ID},
-				{Key: "title", Value: mockInterviewAppointment1.Title},
-				{Key: "description", Value: mockInterviewAppointment1.Description},
-				{Key: "comments", Value: bson.A{
-					bson.D{
-						{Key: "_id", Value: params.CommentID},
-						{Key: "comment", Value: params.Comment},
-					},
-				}},
-				{Key: "status", Value: mockInterviewAppointment1.Status},
-				{Key: "isArchived", Value: false},
+				{Key: "interviewerIds", Value: bson.A{interviewerId}},
 			}},
 		})
-		err := trepo.interviewRepo.UpdateComment(ctx, params)
+		err := trepo.interviewRepo.UpdateInterviewers(ctx, &domains.UpdateInterviewersParams{
+			ID:             mockInterviewAppointment1.ID,
+			InterviewerIDs: []primitive.ObjectID{interviewerId},
+		})
 		assert.NoError(t, err)
 	})
-	mt.Run("update comment error", func(mt *mtest.T) {
+	mt.Run("update interviewers error when not found", func(mt *mtest.T) {
 		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
-		params := &domains.UpdateInterviewCommentParams{
-			ID:        mockInterviewAppointment1.ID,
-			Comment:   "update comment",
-			CommentID: primitive.NewObjectID(),
-		}
-		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
-			Index:   1,
-			Code:    11000,
-			Message: "update fail",
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		err := trepo.interviewRepo.UpdateInterviewers(ctx, &domains.UpdateInterviewersParams{
+			ID:             mockInterviewAppointment1.ID,
+			InterviewerIDs: []primitive.ObjectID{interviewerId},
+		})
+		assert.Equal(t, mongo.ErrNoDocuments, err)
+	})
+}
+
+func TestFindConflicts(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	interviewerId := primitive.NewObjectID()
+	startAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+	endAt := startAt.Add(time.Hour)
+	params := &domains.FindInterviewConflictsParams{
+		InterviewerIDs: []primitive.ObjectID{interviewerId},
+		StartAt:        startAt,
+		EndAt:          endAt,
+		ExcludeID:      mockInterviewAppointment1.ID,
+	}
+	mt.Run("find conflicts success", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockInterviewAppointment2.ID},
+			{Key: "title", Value: mockInterviewAppointment2.Title},
+			{Key: "startAt", Value: startAt.Add(30 * time.Minute)},
+			{Key: "endAt", Value: endAt.Add(30 * time.Minute)},
+			{Key: "interviewerIds", Value: bson.A{interviewerId}},
 		}))
-		err := trepo.interviewRepo.UpdateComment(ctx, params)
+		data, err := trepo.interviewRepo.FindConflicts(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.InterviewAppointment{
+			{
+				ID:             mockInterviewAppointment2.ID,
+				Title:          mockInterviewAppointment2.Title,
+				StartAt:        startAt.Add(30 * time.Minute),
+				EndAt:          endAt.Add(30 * time.Minute),
+				InterviewerIDs: []primitive.ObjectID{interviewerId},
+			},
+		}, data)
+	})
+	mt.Run("find conflicts error", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "bad query",
+		}))
+		data, err := trepo.interviewRepo.FindConflicts(ctx, params)
 		assert.Error(t, err)
+		assert.Equal(t, []domains.InterviewAppointment{}, data)
+	})
+}
+
+func TestGetByInterviewer(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	interviewer := domains.User{ID: primitive.NewObjectID(), Name: "Interviewer"}
+	params := &domains.GetInterviewerAppointmentsParams{
+		InterviewerID: interviewer.ID,
+		EndAfter:      time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		Offset:        0,
+		Limit:         20,
+	}
+	mt.Run("get by interviewer success", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		startAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		expected := mockInterviewAppointment1
+		expected.StartAt = startAt
+		expected.EndAt = startAt.Add(time.Hour)
+		expected.InterviewerIDs = []primitive.ObjectID{interviewer.ID}
+		expected.Interviewers = []domains.User{interviewer}
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockInterviewAppointment1.ID},
+			{Key: "title", Value: mockInterviewAppointment1.Title},
+			{Key: "description", Value: mockInterviewAppointment1.Description},
+			{Key: "status", Value: mockInterviewAppointment1.Status},
+			{Key: "isArchived", Value: false},
+			{Key: "startAt", Value: expected.StartAt},
+			{Key: "endAt", Value: expected.EndAt},
+			{Key: "interviewerIds", Value: bson.A{interviewer.ID}},
+			{Key: "interviewers", Value: bson.A{interviewer}},
+			{Key: "createUser", Value: mockInterviewAppointment1.CreateUser},
+		}))
+		data, err := trepo.interviewRepo.GetByInterviewer(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.InterviewAppointment{expected}, data)
+	})
+	mt.Run("get by interviewer error", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "bad query",
+		}))
+		data, err := trepo.interviewRepo.GetByInterviewer(ctx, params)
+		assert.Error(t, err)
+		assert.Equal(t, []domains.InterviewAppointment{}, data)
+	})
+}
+
+func TestGetBlindByIDs(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	ids := []primitive.ObjectID{mockInterviewAppointment1.ID}
+	mt.Run("get blind by ids success", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		expected := domains.InterviewAppointment{ID: mockInterviewAppointment1.ID, BlindFeedback: true, Scorecards: []domains.Scorecard{}}
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: expected.ID},
+			{Key: "blindFeedback", Value: true},
+			{Key: "scorecards", Value: bson.A{}},
+		}))
+		data, err := trepo.interviewRepo.GetBlindByIDs(ctx, ids)
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.InterviewAppointment{expected}, data)
+	})
+	mt.Run("get blind by ids error", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+		data, err := trepo.interviewRepo.GetBlindByIDs(ctx, ids)
+		assert.Error(t, err)
+		assert.Equal(t, []domains.InterviewAppointment{}, data)
+	})
+}
+
+func TestGetStatusesInUse(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get statuses in use success", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "values", Value: bson.A{"TODO", "DONE"}},
+		})
+		data, err := trepo.interviewRepo.GetStatusesInUse(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"TODO", "DONE"}, data)
+	})
+	mt.Run("get statuses in use error", func(mt *mtest.T) {
+		trepo := newTestInterviewAppointmentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "bad query",
+		}))
+		data, err := trepo.interviewRepo.GetStatusesInUse(ctx)
+		assert.NotNil(t, err)
+		assert.Nil(t, data)
 	})
 }
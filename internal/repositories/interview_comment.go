@@ -0,0 +1,282 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type interviewCommentRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+// commentUserLookup joins the author of a comment into "user".
+var commentUserLookup = []bson.D{
+	{{
+		Key: "$lookup",
+		Value: bson.D{
+			{Key: "from", Value: "user"},
+			{Key: "localField", Value: "userId"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "user"},
+		},
+	}},
+	{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$user"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
+}
+
+func NewInterviewCommentRepository(mc *mongo.Client, db string) ports.InterviewCommentRepository {
+	cn := "interviewComment"
+	return &interviewCommentRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *interviewCommentRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "appointmentId", Value: 1}, {Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "appointmentId", Value: 1}, {Key: "userId", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "comment", Value: "text"}},
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetByAppointment returns a page of the comments of an appointment with
+// their author, oldest first.
+func (r *interviewCommentRepository) GetByAppointment(ctx context.Context, params *domains.GetInterviewCommentsParams) ([]domains.InterviewComment, error) {
+	match := bson.D{{Key: "appointmentId", Value: params.AppointmentID}}
+	if !params.UserID.IsZero() {
+		match = append(match, bson.E{Key: "userId", Value: params.UserID})
+	}
+	if !params.AfterID.IsZero() {
+		match = append(match, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "createdAt", Value: bson.D{{Key: "$gt", Value: params.AfterCreatedAt}}}},
+			bson.D{{Key: "createdAt", Value: params.AfterCreatedAt}, {Key: "_id", Value: bson.D{{Key: "$gt", Value: params.AfterID}}}},
+		}})
+	}
+	pipeline := []bson.D{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: params.Limit}},
+	}
+	pipeline = append(pipeline, commentUserLookup...)
+
+	res := []domains.InterviewComment{}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// SearchAppointmentIDs returns the appointments with a comment that matches
+// the text search. Deleted comments are left out.
+func (r *interviewCommentRepository) SearchAppointmentIDs(ctx context.Context, params *domains.SearchInterviewCommentsParams) ([]primitive.ObjectID, error) {
+	filter := bson.D{
+		{Key: "$text", Value: bson.D{{Key: "$search", Value: params.Query}}},
+		{Key: "deletedAt", Value: bson.D{{Key: "$exists", Value: false}}},
+	}
+	if !params.UserID.IsZero() {
+		filter = append(filter, bson.E{Key: "userId", Value: params.UserID})
+	}
+	values, err := r.col.Distinct(ctx, "appointmentId", filter)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]primitive.ObjectID, 0, len(values))
+	for _, value := range values {
+		if id, ok := value.(primitive.ObjectID); ok {
+			res = append(res, id)
+		}
+	}
+	return res, nil
+}
+
+func (r *interviewCommentRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.InterviewComment, error) {
+	pipeline := []bson.D{
+		{{Key: "$match", Value: bson.D{{Key: "_id", Value: id}}}},
+	}
+	pipeline = append(pipeline, commentUserLookup...)
+	res := []domains.InterviewComment{}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return &res[0], nil
+}
+
+// ExistsByAuthor reports whether userID has a comment on the appointment that
+// was not deleted.
+func (r *interviewCommentRepository) ExistsByAuthor(ctx context.Context, appointmentID primitive.ObjectID, userID primitive.ObjectID) (bool, error) {
+	filter := bson.D{
+		{Key: "appointmentId", Value: appointmentID},
+		{Key: "userId", Value: userID},
+		{Key: "deletedAt", Value: bson.D{{Key: "$exists", Value: false}}},
+	}
+	count, err := r.col.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *interviewCommentRepository) Create(ctx context.Context, params *domains.AddInterviewCommentParams) (*domains.AddInterviewComment, error) {
+	now := time.Now()
+	comment := domains.AddInterviewComment{
+		ID:            primitive.NewObjectID(),
+		AppointmentID: params.AppointmentID,
+		Comment:       params.Comment,
+		UserID:        params.UserID,
+		Version:       1,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if _, err := r.col.InsertOne(ctx, comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// Update replaces the text of a comment and appends the previous text to its
+// revisions. It returns mongo.ErrNoDocuments when the comment is deleted or
+// was updated after params.PreviousUpdatedAt.
+func (r *interviewCommentRepository) Update(ctx context.Context, params *domains.UpdateInterviewCommentParams) error {
+	filter := bson.D{
+		{Key: "_id", Value: params.CommentID},
+		{Key: "updatedAt", Value: params.PreviousUpdatedAt},
+		{Key: "deletedAt", Value: bson.D{{Key: "$exists", Value: false}}},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "comment", Value: params.Comment},
+			{Key: "updatedAt", Value: time.Now()},
+			{Key: "updatedBy", Value: params.UserID},
+		}},
+		{Key: "$push", Value: bson.D{{Key: "revisions", Value: params.Revision}}},
+		{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+	}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetUpsert(false)
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Delete turns a comment into a tombstone. It returns mongo.ErrNoDocuments
+// when the comment does not exist or is already deleted.
+func (r *interviewCommentRepository) Delete(ctx context.Context, params *domains.DeleteInterviewCommentParams) error {
+	filter := bson.D{
+		{Key: "_id", Value: params.CommentID},
+		{Key: "deletedAt", Value: bson.D{{Key: "$exists", Value: false}}},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "deletedAt", Value: time.Now()},
+			{Key: "deletedBy", Value: params.UserID},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+	}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetUpsert(false)
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Purge removes a comment of the appointment completely. It returns
+// mongo.ErrNoDocuments when there is no such comment.
+func (r *interviewCommentRepository) Purge(ctx context.Context, appointmentID primitive.ObjectID, id primitive.ObjectID) error {
+	filter := bson.D{{Key: "_id", Value: id}, {Key: "appointmentId", Value: appointmentID}}
+	if err := r.col.FindOneAndDelete(ctx, filter).Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *interviewCommentRepository) DeleteByAppointments(ctx context.Context, appointmentIDs []primitive.ObjectID) error {
+	filter := bson.D{{Key: "appointmentId", Value: bson.D{{Key: "$in", Value: appointmentIDs}}}}
+	if _, err := r.col.DeleteMany(ctx, filter); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MigrateEmbeddedComments moves the comments that used to be embedded in the
+// "comments" array of interview appointments into this collection and
+// returns how many were moved. It is safe to run again after a failure:
+// comments that were already copied are left as they are.
+func (r *interviewCommentRepository) MigrateEmbeddedComments(ctx context.Context) (int64, error) {
+	appointments := r.mc.Database(r.db).Collection("interviewAppointment")
+	filter := bson.D{{Key: "comments", Value: bson.D{{Key: "$exists", Value: true}}}}
+	opts := options.Find().SetProjection(bson.D{{Key: "comments", Value: 1}})
+	cur, err := appointments.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer cur.Close(ctx)
+	var moved int64
+	for cur.Next(ctx) {
+		doc := struct {
+			ID       primitive.ObjectID `bson:"_id"`
+			Comments []bson.M           `bson:"comments"`
+		}{}
+		if err := cur.Decode(&doc); err != nil {
+			return moved, err
+		}
+		if len(doc.Comments) > 0 {
+			models := make([]mongo.WriteModel, len(doc.Comments))
+			for i, comment := range doc.Comments {
+				id := comment["_id"]
+				delete(comment, "_id")
+				comment["appointmentId"] = doc.ID
+				models[i] = mongo.NewUpdateOneModel().
+					SetFilter(bson.D{{Key: "_id", Value: id}}).
+					SetUpdate(bson.D{{Key: "$setOnInsert", Value: comment}}).
+					SetUpsert(true)
+			}
+			if _, err := r.col.BulkWrite(ctx, models); err != nil {
+				return moved, err
+			}
+		}
+		update := bson.D{{Key: "$unset", Value: bson.D{{Key: "comments", Value: ""}}}}
+		if _, err := appointments.UpdateOne(ctx, bson.D{{Key: "_id", Value: doc.ID}}, update); err != nil {
+			return moved, err
+		}
+		moved += int64(len(doc.Comments))
+	}
+	if err := cur.Err(); err != nil {
+		return moved, err
+	}
+	return moved, nil
+}
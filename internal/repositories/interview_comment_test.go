@@ -0,0 +1,271 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testInterviewCommentRepository struct {
+	commentRepo ports.InterviewCommentRepository
+}
+
+func newTestInterviewCommentRepository(mc *mongo.Client, db string) testInterviewCommentRepository {
+	commentRepo := repositories.NewInterviewCommentRepository(mc, db)
+	return testInterviewCommentRepository{commentRepo}
+}
+
+var (
+	commentCollectionName = "interviewComment"
+	mockInterviewComment  = domains.InterviewComment{
+		ID:            primitive.NewObjectID(),
+		AppointmentID: primitive.NewObjectID(),
+		Comment:       "comment",
+		UserID:        userId,
+		User:          domains.User{ID: userId, Name: "User name 1"},
+		CreatedAt:     time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC),
+		UpdatedAt:     time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC),
+	}
+)
+
+func interviewCommentDocument(comment domains.InterviewComment) bson.D {
+	return bson.D{
+		{Key: "_id", Value: comment.ID},
+		{Key: "appointmentId", Value: comment.AppointmentID},
+		{Key: "comment", Value: comment.Comment},
+		{Key: "userId", Value: comment.UserID},
+		{Key: "user", Value: bson.D{{Key: "_id", Value: comment.User.ID}, {Key: "name", Value: comment.User.Name}}},
+		{Key: "createdAt", Value: comment.CreatedAt},
+		{Key: "updatedAt", Value: comment.UpdatedAt},
+	}
+}
+
+func TestGetInterviewCommentsByAppointment(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	ns := fmt.Sprintf("%s.%s", dbName, commentCollectionName)
+	params := &domains.GetInterviewCommentsParams{
+		AppointmentID:  mockInterviewComment.AppointmentID,
+		AfterCreatedAt: mockInterviewComment.CreatedAt.Add(-time.Hour),
+		AfterID:        primitive.NewObjectID(),
+		Limit:          21,
+	}
+	mt.Run("get comments by appointment success", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, interviewCommentDocument(mockInterviewComment)))
+		got, err := trepo.commentRepo.GetByAppointment(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, []domains.InterviewComment{mockInterviewComment}, got)
+	})
+	mt.Run("get comments by appointment error", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+		_, err := trepo.commentRepo.GetByAppointment(ctx, params)
+		assert.Error(t, err)
+	})
+}
+
+func TestSearchInterviewCommentAppointmentIDs(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.SearchInterviewCommentsParams{Query: "golang"}
+	mt.Run("search comment appointment ids success", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "values", Value: bson.A{mockInterviewComment.AppointmentID}}))
+		got, err := trepo.commentRepo.SearchAppointmentIDs(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, []primitive.ObjectID{mockInterviewComment.AppointmentID}, got)
+	})
+	mt.Run("search own comment appointment ids success", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "values", Value: bson.A{mockInterviewComment.AppointmentID}}))
+		got, err := trepo.commentRepo.SearchAppointmentIDs(ctx, &domains.SearchInterviewCommentsParams{Query: "golang", UserID: userId})
+		assert.NoError(t, err)
+		assert.Equal(t, []primitive.ObjectID{mockInterviewComment.AppointmentID}, got)
+	})
+	mt.Run("search comment appointment ids error", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 0}})
+		got, err := trepo.commentRepo.SearchAppointmentIDs(ctx, params)
+		assert.Error(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestGetInterviewComment(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	ns := fmt.Sprintf("%s.%s", dbName, commentCollectionName)
+	mt.Run("get comment success", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, interviewCommentDocument(mockInterviewComment)))
+		got, err := trepo.commentRepo.Get(ctx, mockInterviewComment.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &mockInterviewComment, got)
+	})
+	mt.Run("get comment not found", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch))
+		got, err := trepo.commentRepo.Get(ctx, mockInterviewComment.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestCreateInterviewComment(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.AddInterviewCommentParams{
+		AppointmentID: mockInterviewComment.AppointmentID,
+		Comment:       "add comment",
+		UserID:        userId,
+	}
+	mt.Run("create comment success", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		data, err := trepo.commentRepo.Create(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, params.Comment, data.Comment)
+		assert.Equal(t, int64(1), data.Version)
+	})
+	mt.Run("create comment error", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "insert fail",
+		}))
+		data, err := trepo.commentRepo.Create(ctx, params)
+		assert.Error(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestUpdateInterviewComment(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.UpdateInterviewCommentParams{
+		CommentID:         mockInterviewComment.ID,
+		Comment:           "update comment",
+		UserID:            userId,
+		Revision:          domains.InterviewCommentRevision{Comment: "comment", UserID: userId, CreatedAt: mockInterviewComment.CreatedAt},
+		PreviousUpdatedAt: mockInterviewComment.UpdatedAt,
+	}
+	mt.Run("update comment success", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: interviewCommentDocument(mockInterviewComment)},
+		})
+		err := trepo.commentRepo.Update(ctx, params)
+		assert.NoError(t, err)
+	})
+	mt.Run("update comment error when comment changed", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		err := trepo.commentRepo.Update(ctx, params)
+		assert.Equal(t, mongo.ErrNoDocuments, err)
+	})
+}
+
+func TestDeleteInterviewComment(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.DeleteInterviewCommentParams{
+		CommentID: mockInterviewComment.ID,
+		UserID:    userId,
+	}
+	mt.Run("delete comment success", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: interviewCommentDocument(mockInterviewComment)},
+		})
+		err := trepo.commentRepo.Delete(ctx, params)
+		assert.NoError(t, err)
+	})
+	mt.Run("delete comment error when already deleted", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		err := trepo.commentRepo.Delete(ctx, params)
+		assert.Equal(t, mongo.ErrNoDocuments, err)
+	})
+	mt.Run("purge comment success", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: interviewCommentDocument(mockInterviewComment)},
+		})
+		err := trepo.commentRepo.Purge(ctx, mockInterviewComment.AppointmentID, mockInterviewComment.ID)
+		assert.NoError(t, err)
+	})
+	mt.Run("purge comment error when not found", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		err := trepo.commentRepo.Purge(ctx, mockInterviewComment.AppointmentID, mockInterviewComment.ID)
+		assert.Equal(t, mongo.ErrNoDocuments, err)
+	})
+}
+
+func TestMigrateEmbeddedComments(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	ns := fmt.Sprintf("%s.%s", dbName, "interviewAppointment")
+	mt.Run("migrate embedded comments success", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		appointment := bson.D{
+			{Key: "_id", Value: mockInterviewComment.AppointmentID},
+			{Key: "comments", Value: bson.A{
+				bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "comment", Value: "comment 1"}, {Key: "userId", Value: userId}},
+				bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "comment", Value: "comment 2"}, {Key: "userId", Value: userId}},
+			}},
+		}
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, appointment),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}, bson.E{Key: "nModified", Value: 0}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		)
+		moved, err := trepo.commentRepo.MigrateEmbeddedComments(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), moved)
+	})
+	mt.Run("migrate embedded comments nothing to move", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, ns, mtest.FirstBatch))
+		moved, err := trepo.commentRepo.MigrateEmbeddedComments(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), moved)
+	})
+	mt.Run("migrate embedded comments error when copy fail", func(mt *mtest.T) {
+		trepo := newTestInterviewCommentRepository(mt.Client, dbName)
+		appointment := bson.D{
+			{Key: "_id", Value: mockInterviewComment.AppointmentID},
+			{Key: "comments", Value: bson.A{bson.D{{Key: "_id", Value: primitive.NewObjectID()}, {Key: "comment", Value: "comment 1"}}}},
+		}
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, ns, mtest.FirstBatch, appointment),
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "write fail"}),
+		)
+		moved, err := trepo.commentRepo.MigrateEmbeddedComments(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), moved)
+	})
+}
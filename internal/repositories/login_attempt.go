@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type loginAttemptRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewLoginAttemptRepository(mc *mongo.Client, db string) ports.LoginAttemptRepository {
+	cn := "loginAttempt"
+	return &loginAttemptRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *loginAttemptRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *loginAttemptRepository) Get(ctx context.Context, key string) (*domains.LoginAttempt, error) {
+	filter := bson.D{{Key: "key", Value: key}, {Key: "expiresAt", Value: bson.D{{Key: "$gt", Value: time.Now()}}}}
+	res := domains.LoginAttempt{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+// RecordFailure increments the failure counter in a single update pipeline.
+// A document whose window has already passed, but which the TTL monitor has
+// not removed yet, starts counting from one again. A non-empty clientIP is
+// added to the client IPs of the window.
+func (r *loginAttemptRepository) RecordFailure(ctx context.Context, key string, clientIP string, expiresAt time.Time) (*domains.LoginAttempt, error) {
+	now := time.Now()
+	filter := bson.D{{Key: "key", Value: key}}
+	set := bson.D{
+		{Key: "failures", Value: bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$gt", Value: bson.A{"$expiresAt", now}}},
+			bson.D{{Key: "$add", Value: bson.A{bson.D{{Key: "$ifNull", Value: bson.A{"$failures", 0}}}, 1}}},
+			1,
+		}}}},
+		{Key: "blockedUntil", Value: bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$gt", Value: bson.A{"$expiresAt", now}}},
+			bson.D{{Key: "$ifNull", Value: bson.A{"$blockedUntil", nil}}},
+			nil,
+		}}}},
+		{Key: "expiresAt", Value: expiresAt},
+	}
+	if clientIP != "" {
+		set = append(set, bson.E{Key: "clientIps", Value: bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$gt", Value: bson.A{"$expiresAt", now}}},
+			bson.D{{Key: "$setUnion", Value: bson.A{bson.D{{Key: "$ifNull", Value: bson.A{"$clientIps", bson.A{}}}}, bson.A{clientIP}}}},
+			bson.A{clientIP},
+		}}}})
+	}
+	update := mongo.Pipeline{{{Key: "$set", Value: set}}}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetReturnDocument(options.After).SetUpsert(true)
+	res := domains.LoginAttempt{}
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *loginAttemptRepository) Block(ctx context.Context, key string, blockedUntil time.Time, expiresAt time.Time) error {
+	filter := bson.D{{Key: "key", Value: key}}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "blockedUntil", Value: blockedUntil},
+		{Key: "expiresAt", Value: expiresAt},
+	}}}
+	if _, err := r.col.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *loginAttemptRepository) Reset(ctx context.Context, key string) error {
+	filter := bson.D{{Key: "key", Value: key}}
+	if _, err := r.col.DeleteOne(ctx, filter); err != nil {
+		return err
+	}
+	return nil
+}
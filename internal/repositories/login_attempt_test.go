@@ -0,0 +1,127 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testLoginAttemptRepository struct {
+	loginAttemptRepo ports.LoginAttemptRepository
+}
+
+func newTestLoginAttemptRepository(mc *mongo.Client, db string) testLoginAttemptRepository {
+	loginAttemptRepo := repositories.NewLoginAttemptRepository(mc, db)
+	return testLoginAttemptRepository{loginAttemptRepo}
+}
+
+var (
+	loginAttemptCollectionName = "loginAttempt"
+	mockBlockedUntil           = time.Now().Add(time.Minute).Truncate(time.Millisecond).UTC()
+	mockLoginAttempt           = domains.LoginAttempt{
+		ID:           primitive.NewObjectID(),
+		Key:          "username:samart",
+		Failures:     2,
+		BlockedUntil: &mockBlockedUntil,
+		ExpiresAt:    time.Now().Add(15 * time.Minute).Truncate(time.Millisecond).UTC(),
+	}
+)
+
+func TestGetLoginAttempt(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get login attempt success", func(mt *mtest.T) {
+		trepo := newTestLoginAttemptRepository(mt.Client, dbName)
+		expected := mockLoginAttempt
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, loginAttemptCollectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockLoginAttempt.ID},
+			{Key: "key", Value: mockLoginAttempt.Key},
+			{Key: "failures", Value: mockLoginAttempt.Failures},
+			{Key: "blockedUntil", Value: mockBlockedUntil},
+			{Key: "expiresAt", Value: mockLoginAttempt.ExpiresAt},
+		}))
+		data, err := trepo.loginAttemptRepo.Get(ctx, mockLoginAttempt.Key)
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, data)
+	})
+	mt.Run("get login attempt not found", func(mt *mtest.T) {
+		trepo := newTestLoginAttemptRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, loginAttemptCollectionName), mtest.FirstBatch))
+		data, err := trepo.loginAttemptRepo.Get(ctx, mockLoginAttempt.Key)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestRecordLoginFailure(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("record failure success", func(mt *mtest.T) {
+		trepo := newTestLoginAttemptRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: bson.D{
+				{Key: "_id", Value: mockLoginAttempt.ID},
+				{Key: "key", Value: mockLoginAttempt.Key},
+				{Key: "failures", Value: 3},
+				{Key: "blockedUntil", Value: nil},
+				{Key: "expiresAt", Value: mockLoginAttempt.ExpiresAt},
+			}},
+		})
+		data, err := trepo.loginAttemptRepo.RecordFailure(ctx, mockLoginAttempt.Key, "10.0.0.1", mockLoginAttempt.ExpiresAt)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, data.Failures)
+		assert.Equal(t, mockLoginAttempt.ExpiresAt, data.ExpiresAt)
+	})
+	mt.Run("record failure error", func(mt *mtest.T) {
+		trepo := newTestLoginAttemptRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "update fail",
+		}))
+		data, err := trepo.loginAttemptRepo.RecordFailure(ctx, mockLoginAttempt.Key, "10.0.0.1", mockLoginAttempt.ExpiresAt)
+		assert.Error(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestBlockLoginAttempt(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("block success", func(mt *mtest.T) {
+		trepo := newTestLoginAttemptRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}})
+		err := trepo.loginAttemptRepo.Block(ctx, mockLoginAttempt.Key, mockBlockedUntil, mockLoginAttempt.ExpiresAt)
+		assert.NoError(t, err)
+	})
+}
+
+func TestResetLoginAttempt(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("reset success", func(mt *mtest.T) {
+		trepo := newTestLoginAttemptRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}})
+		err := trepo.loginAttemptRepo.Reset(ctx, mockLoginAttempt.Key)
+		assert.NoError(t, err)
+	})
+	mt.Run("reset error", func(mt *mtest.T) {
+		trepo := newTestLoginAttemptRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    1,
+			Message: "delete fail",
+		}))
+		err := trepo.loginAttemptRepo.Reset(ctx, mockLoginAttempt.Key)
+		assert.Error(t, err)
+	})
+}
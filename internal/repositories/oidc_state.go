@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type oidcStateRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewOIDCStateRepository(mc *mongo.Client, db string) ports.OIDCStateRepository {
+	cn := "oidcState"
+	return &oidcStateRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *oidcStateRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "stateHash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *oidcStateRepository) Create(ctx context.Context, params *domains.CreateOIDCStateParams) (*domains.OIDCState, error) {
+	state := domains.OIDCState{
+		ID:           primitive.NewObjectID(),
+		StateHash:    params.StateHash,
+		Nonce:        params.Nonce,
+		CodeVerifier: params.CodeVerifier,
+		ExpiresAt:    params.ExpiresAt,
+		CreatedAt:    time.Now(),
+	}
+	if _, err := r.col.InsertOne(ctx, state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// Consume deletes the state while reading it so a callback can only be
+// completed once.
+func (r *oidcStateRepository) Consume(ctx context.Context, stateHash string) (*domains.OIDCState, error) {
+	filter := bson.D{{Key: "stateHash", Value: stateHash}}
+	res := domains.OIDCState{}
+	if err := r.col.FindOneAndDelete(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,99 @@
+package repositories_test
+
+import (
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testOIDCStateRepository struct {
+	oidcStateRepo ports.OIDCStateRepository
+}
+
+func newTestOIDCStateRepository(mc *mongo.Client, db string) testOIDCStateRepository {
+	oidcStateRepo := repositories.NewOIDCStateRepository(mc, db)
+	return testOIDCStateRepository{oidcStateRepo}
+}
+
+var mockOIDCState = domains.OIDCState{
+	ID:           primitive.NewObjectID(),
+	StateHash:    "state-hash",
+	Nonce:        "nonce",
+	CodeVerifier: "code-verifier",
+	ExpiresAt:    time.Now().Add(10 * time.Minute).Truncate(time.Millisecond).UTC(),
+	CreatedAt:    time.Now().Truncate(time.Millisecond).UTC(),
+}
+
+func TestCreateOIDCState(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("create oidc state success", func(mt *mtest.T) {
+		trepo := newTestOIDCStateRepository(mt.Client, dbName)
+		params := &domains.CreateOIDCStateParams{
+			StateHash:    mockOIDCState.StateHash,
+			Nonce:        mockOIDCState.Nonce,
+			CodeVerifier: mockOIDCState.CodeVerifier,
+			ExpiresAt:    mockOIDCState.ExpiresAt,
+		}
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		data, err := trepo.oidcStateRepo.Create(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, params.StateHash, data.StateHash)
+		assert.Equal(t, params.Nonce, data.Nonce)
+		assert.Equal(t, params.CodeVerifier, data.CodeVerifier)
+		assert.Equal(t, params.ExpiresAt, data.ExpiresAt)
+	})
+	mt.Run("create oidc state error", func(mt *mtest.T) {
+		trepo := newTestOIDCStateRepository(mt.Client, dbName)
+		params := &domains.CreateOIDCStateParams{StateHash: mockOIDCState.StateHash}
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "duplicate key error",
+		}))
+		data, err := trepo.oidcStateRepo.Create(ctx, params)
+		assert.Nil(t, data)
+		assert.True(t, mongo.IsDuplicateKeyError(err))
+	})
+}
+
+func TestConsumeOIDCState(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("consume oidc state success", func(mt *mtest.T) {
+		trepo := newTestOIDCStateRepository(mt.Client, dbName)
+		expected := mockOIDCState
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: bson.D{
+				{Key: "_id", Value: mockOIDCState.ID},
+				{Key: "stateHash", Value: mockOIDCState.StateHash},
+				{Key: "nonce", Value: mockOIDCState.Nonce},
+				{Key: "codeVerifier", Value: mockOIDCState.CodeVerifier},
+				{Key: "expiresAt", Value: mockOIDCState.ExpiresAt},
+				{Key: "createdAt", Value: mockOIDCState.CreatedAt},
+			}},
+		})
+		data, err := trepo.oidcStateRepo.Consume(ctx, mockOIDCState.StateHash)
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, data)
+	})
+	mt.Run("consume oidc state not found", func(mt *mtest.T) {
+		trepo := newTestOIDCStateRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		data, err := trepo.oidcStateRepo.Consume(ctx, mockOIDCState.StateHash)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+	})
+}
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type passwordResetTokenRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewPasswordResetTokenRepository(mc *mongo.Client, db string) ports.PasswordResetTokenRepository {
+	cn := "passwordResetToken"
+	return &passwordResetTokenRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *passwordResetTokenRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "tokenHash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "userId", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *passwordResetTokenRepository) Create(ctx context.Context, params *domains.CreatePasswordResetTokenParams) (*domains.PasswordResetToken, error) {
+	token := domains.PasswordResetToken{
+		ID:        primitive.NewObjectID(),
+		UserID:    params.UserID,
+		TokenHash: params.TokenHash,
+		ExpiresAt: params.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+	if _, err := r.col.InsertOne(ctx, token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *passwordResetTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domains.PasswordResetToken, error) {
+	filter := bson.D{{Key: "tokenHash", Value: tokenHash}}
+	res := domains.PasswordResetToken{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *passwordResetTokenRepository) MarkUsed(ctx context.Context, id primitive.ObjectID) error {
+	filter := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "usedAt", Value: nil},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "usedAt", Value: time.Now()}}}}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetUpsert(false)
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *passwordResetTokenRepository) InvalidateUserTokens(ctx context.Context, userID primitive.ObjectID) error {
+	filter := bson.D{{Key: "userId", Value: userID}, {Key: "usedAt", Value: nil}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "usedAt", Value: time.Now()}}}}
+	if _, err := r.col.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
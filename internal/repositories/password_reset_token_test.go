@@ -0,0 +1,133 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testPasswordResetTokenRepository struct {
+	passwordResetTokenRepo ports.PasswordResetTokenRepository
+}
+
+func newTestPasswordResetTokenRepository(mc *mongo.Client, db string) testPasswordResetTokenRepository {
+	passwordResetTokenRepo := repositories.NewPasswordResetTokenRepository(mc, db)
+	return testPasswordResetTokenRepository{passwordResetTokenRepo}
+}
+
+var (
+	passwordResetTokenCollectionName = "passwordResetToken"
+	mockPasswordResetToken           = domains.PasswordResetToken{
+		ID:        primitive.NewObjectID(),
+		UserID:    primitive.NewObjectID(),
+		TokenHash: "reset-token-hash",
+		ExpiresAt: time.Now().Add(30 * time.Minute).Truncate(time.Millisecond).UTC(),
+		CreatedAt: time.Now().Truncate(time.Millisecond).UTC(),
+	}
+)
+
+func TestCreatePasswordResetToken(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("create password reset token success", func(mt *mtest.T) {
+		trepo := newTestPasswordResetTokenRepository(mt.Client, dbName)
+		params := &domains.CreatePasswordResetTokenParams{
+			UserID:    mockPasswordResetToken.UserID,
+			TokenHash: mockPasswordResetToken.TokenHash,
+			ExpiresAt: mockPasswordResetToken.ExpiresAt,
+		}
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		data, err := trepo.passwordResetTokenRepo.Create(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, params.UserID, data.UserID)
+		assert.Equal(t, params.TokenHash, data.TokenHash)
+		assert.Equal(t, params.ExpiresAt, data.ExpiresAt)
+		assert.Nil(t, data.UsedAt)
+	})
+	mt.Run("create password reset token error", func(mt *mtest.T) {
+		trepo := newTestPasswordResetTokenRepository(mt.Client, dbName)
+		params := &domains.CreatePasswordResetTokenParams{
+			UserID:    mockPasswordResetToken.UserID,
+			TokenHash: mockPasswordResetToken.TokenHash,
+			ExpiresAt: mockPasswordResetToken.ExpiresAt,
+		}
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "duplicate key error",
+		}))
+		data, err := trepo.passwordResetTokenRepo.Create(ctx, params)
+		assert.Nil(t, data)
+		assert.True(t, mongo.IsDuplicateKeyError(err))
+	})
+}
+
+func TestGetPasswordResetTokenByTokenHash(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get password reset token success", func(mt *mtest.T) {
+		trepo := newTestPasswordResetTokenRepository(mt.Client, dbName)
+		expected := mockPasswordResetToken
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, passwordResetTokenCollectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockPasswordResetToken.ID},
+			{Key: "userId", Value: mockPasswordResetToken.UserID},
+			{Key: "tokenHash", Value: mockPasswordResetToken.TokenHash},
+			{Key: "expiresAt", Value: mockPasswordResetToken.ExpiresAt},
+			{Key: "usedAt", Value: nil},
+			{Key: "createdAt", Value: mockPasswordResetToken.CreatedAt},
+		}))
+		data, err := trepo.passwordResetTokenRepo.GetByTokenHash(ctx, mockPasswordResetToken.TokenHash)
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, data)
+	})
+	mt.Run("get password reset token not found", func(mt *mtest.T) {
+		trepo := newTestPasswordResetTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, passwordResetTokenCollectionName), mtest.FirstBatch))
+		data, err := trepo.passwordResetTokenRepo.GetByTokenHash(ctx, mockPasswordResetToken.TokenHash)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestMarkPasswordResetTokenUsed(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("mark used success", func(mt *mtest.T) {
+		trepo := newTestPasswordResetTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: bson.D{{Key: "_id", Value: mockPasswordResetToken.ID}}},
+		})
+		err := trepo.passwordResetTokenRepo.MarkUsed(ctx, mockPasswordResetToken.ID)
+		assert.NoError(t, err)
+	})
+	mt.Run("mark used error when already used", func(mt *mtest.T) {
+		trepo := newTestPasswordResetTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		err := trepo.passwordResetTokenRepo.MarkUsed(ctx, mockPasswordResetToken.ID)
+		assert.Equal(t, mongo.ErrNoDocuments, err)
+	})
+}
+
+func TestInvalidateUserPasswordResetTokens(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("invalidate user tokens success", func(mt *mtest.T) {
+		trepo := newTestPasswordResetTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 2}, {Key: "nModified", Value: 2}})
+		err := trepo.passwordResetTokenRepo.InvalidateUserTokens(ctx, mockPasswordResetToken.UserID)
+		assert.NoError(t, err)
+	})
+}
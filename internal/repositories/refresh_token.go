@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type refreshTokenRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewRefreshTokenRepository(mc *mongo.Client, db string) ports.RefreshTokenRepository {
+	cn := "refreshToken"
+	return &refreshTokenRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *refreshTokenRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "tokenHash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "sessionId", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "userId", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *refreshTokenRepository) Create(ctx context.Context, params *domains.CreateRefreshTokenParams) (*domains.RefreshToken, error) {
+	refreshToken := domains.RefreshToken{
+		ID:        primitive.NewObjectID(),
+		UserID:    params.UserID,
+		SessionID: params.SessionID,
+		TokenHash: params.TokenHash,
+		ExpiresAt: params.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+	if _, err := r.col.InsertOne(ctx, refreshToken); err != nil {
+		return nil, err
+	}
+	return &refreshToken, nil
+}
+
+func (r *refreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domains.RefreshToken, error) {
+	filter := bson.D{{Key: "tokenHash", Value: tokenHash}}
+	res := domains.RefreshToken{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *refreshTokenRepository) MarkUsed(ctx context.Context, id primitive.ObjectID) error {
+	filter := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "usedAt", Value: nil},
+		{Key: "revokedAt", Value: nil},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "usedAt", Value: time.Now()}}}}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetUpsert(false)
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Err(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeSession(ctx context.Context, sessionID primitive.ObjectID) error {
+	filter := bson.D{{Key: "sessionId", Value: sessionID}, {Key: "revokedAt", Value: nil}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "revokedAt", Value: time.Now()}}}}
+	if _, err := r.col.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeUserSessions(ctx context.Context, userID primitive.ObjectID, exceptSessionID primitive.ObjectID) error {
+	filter := bson.D{{Key: "userId", Value: userID}, {Key: "revokedAt", Value: nil}}
+	if !exceptSessionID.IsZero() {
+		filter = append(filter, bson.E{Key: "sessionId", Value: bson.D{{Key: "$ne", Value: exceptSessionID}}})
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "revokedAt", Value: time.Now()}}}}
+	if _, err := r.col.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *refreshTokenRepository) IsSessionRevoked(ctx context.Context, sessionID primitive.ObjectID) (bool, error) {
+	filter := bson.D{{Key: "sessionId", Value: sessionID}, {Key: "revokedAt", Value: bson.D{{Key: "$ne", Value: nil}}}}
+	opts := options.Count().SetLimit(1)
+	count, err := r.col.CountDocuments(ctx, filter, opts)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,190 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testRefreshTokenRepository struct {
+	refreshTokenRepo ports.RefreshTokenRepository
+}
+
+func newTestRefreshTokenRepository(mc *mongo.Client, db string) testRefreshTokenRepository {
+	refreshTokenRepo := repositories.NewRefreshTokenRepository(mc, db)
+	return testRefreshTokenRepository{refreshTokenRepo}
+}
+
+var (
+	refreshTokenCollectionName = "refreshToken"
+	mockRefreshToken           = domains.RefreshToken{
+		ID:        primitive.NewObjectID(),
+		UserID:    primitive.NewObjectID(),
+		SessionID: primitive.NewObjectID(),
+		TokenHash: "token-hash",
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Millisecond).UTC(),
+		CreatedAt: time.Now().Truncate(time.Millisecond).UTC(),
+	}
+)
+
+func TestCreateRefreshToken(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("create refresh token success", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		params := &domains.CreateRefreshTokenParams{
+			UserID:    mockRefreshToken.UserID,
+			SessionID: mockRefreshToken.SessionID,
+			TokenHash: mockRefreshToken.TokenHash,
+			ExpiresAt: mockRefreshToken.ExpiresAt,
+		}
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		data, err := trepo.refreshTokenRepo.Create(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, params.UserID, data.UserID)
+		assert.Equal(t, params.SessionID, data.SessionID)
+		assert.Equal(t, params.TokenHash, data.TokenHash)
+		assert.Equal(t, params.ExpiresAt, data.ExpiresAt)
+		assert.Nil(t, data.UsedAt)
+		assert.Nil(t, data.RevokedAt)
+	})
+	mt.Run("create refresh token error", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		params := &domains.CreateRefreshTokenParams{
+			UserID:    mockRefreshToken.UserID,
+			SessionID: mockRefreshToken.SessionID,
+			TokenHash: mockRefreshToken.TokenHash,
+			ExpiresAt: mockRefreshToken.ExpiresAt,
+		}
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "duplicate key error",
+		}))
+		data, err := trepo.refreshTokenRepo.Create(ctx, params)
+		assert.Nil(t, data)
+		assert.True(t, mongo.IsDuplicateKeyError(err))
+	})
+}
+
+func TestGetRefreshTokenByTokenHash(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get refresh token success", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		expected := mockRefreshToken
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, refreshTokenCollectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: mockRefreshToken.ID},
+			{Key: "userId", Value: mockRefreshToken.UserID},
+			{Key: "sessionId", Value: mockRefreshToken.SessionID},
+			{Key: "tokenHash", Value: mockRefreshToken.TokenHash},
+			{Key: "expiresAt", Value: mockRefreshToken.ExpiresAt},
+			{Key: "usedAt", Value: nil},
+			{Key: "revokedAt", Value: nil},
+			{Key: "createdAt", Value: mockRefreshToken.CreatedAt},
+		}))
+		data, err := trepo.refreshTokenRepo.GetByTokenHash(ctx, mockRefreshToken.TokenHash)
+		assert.NoError(t, err)
+		assert.Equal(t, &expected, data)
+	})
+	mt.Run("get refresh token not found", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, refreshTokenCollectionName), mtest.FirstBatch))
+		data, err := trepo.refreshTokenRepo.GetByTokenHash(ctx, mockRefreshToken.TokenHash)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestMarkRefreshTokenUsed(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("mark used success", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: bson.D{{Key: "_id", Value: mockRefreshToken.ID}}},
+		})
+		err := trepo.refreshTokenRepo.MarkUsed(ctx, mockRefreshToken.ID)
+		assert.NoError(t, err)
+	})
+	mt.Run("mark used error when already used", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		err := trepo.refreshTokenRepo.MarkUsed(ctx, mockRefreshToken.ID)
+		assert.Equal(t, mongo.ErrNoDocuments, err)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("revoke session success", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 2}, {Key: "nModified", Value: 2}})
+		err := trepo.refreshTokenRepo.RevokeSession(ctx, mockRefreshToken.SessionID)
+		assert.NoError(t, err)
+	})
+	mt.Run("revoke session error", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "update fail",
+		}))
+		err := trepo.refreshTokenRepo.RevokeSession(ctx, mockRefreshToken.SessionID)
+		assert.Error(t, err)
+	})
+}
+
+func TestRevokeUserSessions(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("revoke user sessions success", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 3}, {Key: "nModified", Value: 3}})
+		err := trepo.refreshTokenRepo.RevokeUserSessions(ctx, mockRefreshToken.UserID, mockRefreshToken.SessionID)
+		assert.NoError(t, err)
+	})
+	mt.Run("revoke user sessions error", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "update fail",
+		}))
+		err := trepo.refreshTokenRepo.RevokeUserSessions(ctx, mockRefreshToken.UserID, primitive.NilObjectID)
+		assert.Error(t, err)
+	})
+}
+
+func TestIsSessionRevoked(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("session revoked", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, refreshTokenCollectionName), mtest.FirstBatch, bson.D{{Key: "n", Value: 1}}))
+		revoked, err := trepo.refreshTokenRepo.IsSessionRevoked(ctx, mockRefreshToken.SessionID)
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+	})
+	mt.Run("session active", func(mt *mtest.T) {
+		trepo := newTestRefreshTokenRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, refreshTokenCollectionName), mtest.FirstBatch))
+		revoked, err := trepo.refreshTokenRepo.IsSessionRevoked(ctx, mockRefreshToken.SessionID)
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+}
@@ -0,0 +1,144 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type scorecardRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewScorecardRepository(mc *mongo.Client, db string) ports.ScorecardRepository {
+	cn := "scorecard"
+	return &scorecardRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+// EnsureIndexes creates the unique index that allows one scorecard per
+// interviewer and appointment.
+func (r *scorecardRepository) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "appointmentId", Value: 1}, {Key: "interviewerId", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+	if _, err := r.col.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetByAppointment returns the scorecards of an appointment with their
+// interviewer, in the order they were submitted.
+func (r *scorecardRepository) GetByAppointment(ctx context.Context, appointmentID primitive.ObjectID) ([]domains.Scorecard, error) {
+	pipeline := []bson.D{
+		{{Key: "$match", Value: bson.D{{Key: "appointmentId", Value: appointmentID}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "submittedAt", Value: 1}, {Key: "_id", Value: 1}}}},
+		{{
+			Key: "$lookup",
+			Value: bson.D{
+				{Key: "from", Value: "user"},
+				{Key: "localField", Value: "interviewerId"},
+				{Key: "foreignField", Value: "_id"},
+				{Key: "as", Value: "interviewer"},
+			},
+		}},
+		{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$interviewer"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
+	}
+	res := []domains.Scorecard{}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (r *scorecardRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.Scorecard, error) {
+	filter := bson.D{{Key: "_id", Value: id}}
+	res := domains.Scorecard{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Create inserts a scorecard. It returns nil when the interviewer already has
+// a scorecard for the appointment.
+func (r *scorecardRepository) Create(ctx context.Context, params *domains.CreateScorecardParams) (*domains.Scorecard, error) {
+	now := time.Now()
+	scorecard := domains.Scorecard{
+		ID:             primitive.NewObjectID(),
+		AppointmentID:  params.AppointmentID,
+		TemplateID:     params.TemplateID,
+		InterviewerID:  params.InterviewerID,
+		Ratings:        params.Ratings,
+		Answers:        params.Answers,
+		Recommendation: params.Recommendation,
+		SubmittedAt:    now,
+		UpdatedAt:      now,
+		LockedAt:       params.LockedAt,
+	}
+	if _, err := r.col.InsertOne(ctx, scorecard); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &scorecard, nil
+}
+
+// Update replaces the feedback of a scorecard. It returns nil when the
+// scorecard does not exist or is locked.
+func (r *scorecardRepository) Update(ctx context.Context, params *domains.UpdateScorecardParams) (*domains.Scorecard, error) {
+	now := time.Now()
+	filter := bson.D{
+		{Key: "_id", Value: params.ID},
+		{Key: "lockedAt", Value: bson.D{{Key: "$gt", Value: now}}},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "ratings", Value: params.Ratings},
+		{Key: "answers", Value: params.Answers},
+		{Key: "recommendation", Value: params.Recommendation},
+		{Key: "updatedAt", Value: now},
+	}}}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetReturnDocument(options.After).SetUpsert(false)
+	res := domains.Scorecard{}
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *scorecardRepository) DeleteByAppointments(ctx context.Context, appointmentIDs []primitive.ObjectID) error {
+	filter := bson.D{{Key: "appointmentId", Value: bson.D{{Key: "$in", Value: appointmentIDs}}}}
+	if _, err := r.col.DeleteMany(ctx, filter); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type scorecardTemplateRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewScorecardTemplateRepository(mc *mongo.Client, db string) ports.ScorecardTemplateRepository {
+	cn := "scorecardTemplate"
+	return &scorecardTemplateRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+func (r *scorecardTemplateRepository) GetAll(ctx context.Context, params *domains.GetScorecardTemplatesParams) ([]domains.ScorecardTemplate, error) {
+	filter := bson.D{}
+	if !params.IncludeArchived {
+		filter = append(filter, bson.E{Key: "isArchived", Value: false})
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(params.Offset)).
+		SetLimit(int64(params.Limit))
+
+	res := []domains.ScorecardTemplate{}
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (r *scorecardTemplateRepository) Get(ctx context.Context, id primitive.ObjectID) (*domains.ScorecardTemplate, error) {
+	filter := bson.D{{Key: "_id", Value: id}}
+	res := domains.ScorecardTemplate{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *scorecardTemplateRepository) Create(ctx context.Context, params *domains.CreateScorecardTemplateParams) (*domains.ScorecardTemplate, error) {
+	now := time.Now()
+	questions := make([]domains.ScorecardQuestion, len(params.Questions))
+	for i, question := range params.Questions {
+		questions[i] = domains.ScorecardQuestion{
+			ID:       primitive.NewObjectID(),
+			Text:     question.Text,
+			Required: question.Required,
+		}
+	}
+	template := domains.ScorecardTemplate{
+		ID:           primitive.NewObjectID(),
+		Name:         params.Name,
+		Competencies: params.Competencies,
+		RatingScale:  params.RatingScale,
+		Questions:    questions,
+		CreatedBy:    params.CreatedBy,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if _, err := r.col.InsertOne(ctx, template); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *scorecardTemplateRepository) Archive(ctx context.Context, id primitive.ObjectID) (*domains.ScorecardTemplate, error) {
+	filter := bson.D{{Key: "_id", Value: id}}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "isArchived", Value: true},
+		{Key: "updatedAt", Value: time.Now()},
+	}}}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetReturnDocument(options.After).SetUpsert(false)
+	res := domains.ScorecardTemplate{}
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
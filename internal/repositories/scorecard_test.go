@@ -0,0 +1,260 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testScorecardRepository struct {
+	scorecardRepo         ports.ScorecardRepository
+	scorecardTemplateRepo ports.ScorecardTemplateRepository
+}
+
+func newTestScorecardRepository(mc *mongo.Client, db string) testScorecardRepository {
+	scorecardRepo := repositories.NewScorecardRepository(mc, db)
+	scorecardTemplateRepo := repositories.NewScorecardTemplateRepository(mc, db)
+	return testScorecardRepository{scorecardRepo, scorecardTemplateRepo}
+}
+
+var (
+	scorecardCollectionName         = "scorecard"
+	scorecardTemplateCollectionName = "scorecardTemplate"
+	mockScorecardTemplate           = domains.ScorecardTemplate{
+		ID:           primitive.NewObjectID(),
+		Name:         "Backend onsite",
+		Competencies: []domains.ScorecardCompetency{{Name: "Coding", Description: "Writes working code"}},
+		RatingScale:  domains.ScorecardRatingScale{Min: 1, Max: 4},
+		Questions:    []domains.ScorecardQuestion{{ID: primitive.NewObjectID(), Text: "What stood out?", Required: true}},
+		CreatedBy:    userId,
+		CreatedAt:    time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC),
+		UpdatedAt:    time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC),
+	}
+	mockScorecard = domains.Scorecard{
+		ID:             primitive.NewObjectID(),
+		AppointmentID:  primitive.NewObjectID(),
+		TemplateID:     mockScorecardTemplate.ID,
+		InterviewerID:  userId,
+		Ratings:        []domains.ScorecardRating{{Competency: "Coding", Score: 3, Note: "Solid"}},
+		Answers:        []domains.ScorecardAnswer{{QuestionID: mockScorecardTemplate.Questions[0].ID, Answer: "Clear thinking"}},
+		Recommendation: constants.RECOMMENDATION_YES,
+		SubmittedAt:    time.Date(2023, 7, 1, 5, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2023, 7, 1, 5, 0, 0, 0, time.UTC),
+		LockedAt:       time.Date(2023, 7, 2, 5, 0, 0, 0, time.UTC),
+	}
+)
+
+func scorecardTemplateDocument(template domains.ScorecardTemplate) bson.D {
+	return bson.D{
+		{Key: "_id", Value: template.ID},
+		{Key: "name", Value: template.Name},
+		{Key: "competencies", Value: bson.A{
+			bson.D{{Key: "name", Value: template.Competencies[0].Name}, {Key: "description", Value: template.Competencies[0].Description}},
+		}},
+		{Key: "ratingScale", Value: bson.D{{Key: "min", Value: template.RatingScale.Min}, {Key: "max", Value: template.RatingScale.Max}}},
+		{Key: "questions", Value: bson.A{
+			bson.D{{Key: "_id", Value: template.Questions[0].ID}, {Key: "text", Value: template.Questions[0].Text}, {Key: "required", Value: template.Questions[0].Required}},
+		}},
+		{Key: "isArchived", Value: template.IsArchived},
+		{Key: "createdBy", Value: template.CreatedBy},
+		{Key: "createdAt", Value: template.CreatedAt},
+		{Key: "updatedAt", Value: template.UpdatedAt},
+	}
+}
+
+func scorecardDocument(scorecard domains.Scorecard) bson.D {
+	return bson.D{
+		{Key: "_id", Value: scorecard.ID},
+		{Key: "appointmentId", Value: scorecard.AppointmentID},
+		{Key: "templateId", Value: scorecard.TemplateID},
+		{Key: "interviewerId", Value: scorecard.InterviewerID},
+		{Key: "ratings", Value: bson.A{
+			bson.D{{Key: "competency", Value: scorecard.Ratings[0].Competency}, {Key: "score", Value: scorecard.Ratings[0].Score}, {Key: "note", Value: scorecard.Ratings[0].Note}},
+		}},
+		{Key: "answers", Value: bson.A{
+			bson.D{{Key: "questionId", Value: scorecard.Answers[0].QuestionID}, {Key: "answer", Value: scorecard.Answers[0].Answer}},
+		}},
+		{Key: "recommendation", Value: scorecard.Recommendation},
+		{Key: "submittedAt", Value: scorecard.SubmittedAt},
+		{Key: "updatedAt", Value: scorecard.UpdatedAt},
+		{Key: "lockedAt", Value: scorecard.LockedAt},
+	}
+}
+
+func TestGetAllScorecardTemplates(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.GetScorecardTemplatesParams{Offset: 0, Limit: 21}
+	mt.Run("get all scorecard templates success", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, scorecardTemplateCollectionName), mtest.FirstBatch, scorecardTemplateDocument(mockScorecardTemplate)))
+		data, err := trepo.scorecardTemplateRepo.GetAll(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.ScorecardTemplate{mockScorecardTemplate}, data)
+	})
+	mt.Run("get all scorecard templates error", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 2, Message: "bad query"}))
+		_, err := trepo.scorecardTemplateRepo.GetAll(ctx, params)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestGetScorecardTemplate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get scorecard template success", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, scorecardTemplateCollectionName), mtest.FirstBatch, scorecardTemplateDocument(mockScorecardTemplate)))
+		data, err := trepo.scorecardTemplateRepo.Get(ctx, mockScorecardTemplate.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, &mockScorecardTemplate, data)
+	})
+	mt.Run("get scorecard template not found", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, scorecardTemplateCollectionName), mtest.FirstBatch))
+		data, err := trepo.scorecardTemplateRepo.Get(ctx, mockScorecardTemplate.ID)
+		assert.Nil(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestCreateScorecardTemplate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.CreateScorecardTemplateParams{
+		Name:         "Backend onsite",
+		Competencies: []domains.ScorecardCompetency{{Name: "Coding"}},
+		RatingScale:  domains.ScorecardRatingScale{Min: 1, Max: 4},
+		Questions:    []domains.ScorecardQuestion{{Text: "What stood out?", Required: true}},
+		CreatedBy:    userId,
+	}
+	mt.Run("create scorecard template success", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		data, err := trepo.scorecardTemplateRepo.Create(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, params.Name, data.Name)
+		assert.Equal(t, params.RatingScale, data.RatingScale)
+		assert.Len(t, data.Questions, 1)
+		assert.False(t, data.Questions[0].ID.IsZero())
+		assert.Equal(t, "What stood out?", data.Questions[0].Text)
+	})
+	mt.Run("create scorecard template error", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "some error"}))
+		data, err := trepo.scorecardTemplateRepo.Create(ctx, params)
+		assert.Nil(t, data)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestArchiveScorecardTemplate(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("archive scorecard template success", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		archived := mockScorecardTemplate
+		archived.IsArchived = true
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: scorecardTemplateDocument(archived)}})
+		data, err := trepo.scorecardTemplateRepo.Archive(ctx, mockScorecardTemplate.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, &archived, data)
+	})
+	mt.Run("archive scorecard template not found", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+		data, err := trepo.scorecardTemplateRepo.Archive(ctx, mockScorecardTemplate.ID)
+		assert.Nil(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestGetScorecardsByAppointment(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get scorecards by appointment success", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, scorecardCollectionName), mtest.FirstBatch, scorecardDocument(mockScorecard)))
+		data, err := trepo.scorecardRepo.GetByAppointment(ctx, mockScorecard.AppointmentID)
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.Scorecard{mockScorecard}, data)
+	})
+	mt.Run("get scorecards by appointment error", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 2, Message: "bad query"}))
+		_, err := trepo.scorecardRepo.GetByAppointment(ctx, mockScorecard.AppointmentID)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestCreateScorecard(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.CreateScorecardParams{
+		AppointmentID:  mockScorecard.AppointmentID,
+		TemplateID:     mockScorecard.TemplateID,
+		InterviewerID:  mockScorecard.InterviewerID,
+		Ratings:        mockScorecard.Ratings,
+		Answers:        mockScorecard.Answers,
+		Recommendation: mockScorecard.Recommendation,
+		LockedAt:       mockScorecard.LockedAt,
+	}
+	mt.Run("create scorecard success", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+		data, err := trepo.scorecardRepo.Create(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, params.Ratings, data.Ratings)
+		assert.Equal(t, params.LockedAt, data.LockedAt)
+	})
+	mt.Run("create scorecard returns nil when already submitted", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}))
+		data, err := trepo.scorecardRepo.Create(ctx, params)
+		assert.Nil(t, err)
+		assert.Nil(t, data)
+	})
+	mt.Run("create scorecard error", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{Code: 1, Message: "some error"}))
+		data, err := trepo.scorecardRepo.Create(ctx, params)
+		assert.Nil(t, data)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestUpdateScorecard(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	params := &domains.UpdateScorecardParams{
+		ID:             mockScorecard.ID,
+		Ratings:        mockScorecard.Ratings,
+		Answers:        mockScorecard.Answers,
+		Recommendation: mockScorecard.Recommendation,
+	}
+	mt.Run("update scorecard success", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: scorecardDocument(mockScorecard)}})
+		data, err := trepo.scorecardRepo.Update(ctx, params)
+		assert.Nil(t, err)
+		assert.Equal(t, &mockScorecard, data)
+	})
+	mt.Run("update scorecard returns nil when locked", func(mt *mtest.T) {
+		trepo := newTestScorecardRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "value", Value: nil}})
+		data, err := trepo.scorecardRepo.Update(ctx, params)
+		assert.Nil(t, err)
+		assert.Nil(t, data)
+	})
+}
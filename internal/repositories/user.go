@@ -2,12 +2,14 @@ package repositories
 
 import (
 	"context"
+	"regexp"
 	"robinhood-assignment/internal/core/domains"
 	"robinhood-assignment/internal/core/ports"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type user struct {
@@ -51,18 +53,203 @@ func (u *user) GetByUsername(ctx context.Context, username string) (*domains.Use
 	return &res, nil
 }
 
+func (u *user) GetByEmail(ctx context.Context, email string) (*domains.User, error) {
+	filter := bson.D{{Key: "email", Value: email}}
+	res := domains.User{}
+	if err := u.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (u *user) GetByOIDCSubject(ctx context.Context, subject string) (*domains.User, error) {
+	filter := bson.D{{Key: "oidcSubject", Value: subject}}
+	res := domains.User{}
+	if err := u.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
 func (u *user) Create(ctx context.Context, params *domains.CreateUserParams) (*domains.User, error) {
 	user := domains.User{
-		ID:       primitive.NewObjectID(),
-		Name:     params.Name,
-		Email:    params.Email,
-		Username: params.Username,
-		Password: params.Password,
-		ImageUrl: params.ImageUrl,
-		Role:     params.Role,
+		ID:               primitive.NewObjectID(),
+		Name:             params.Name,
+		Email:            params.Email,
+		Username:         params.Username,
+		Password:         params.Password,
+		ImageUrl:         params.ImageUrl,
+		Role:             params.Role,
+		IsServiceAccount: params.IsServiceAccount,
+		OIDCSubject:      params.OIDCSubject,
 	}
 	if _, err := u.col.InsertOne(ctx, user); err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
+
+func (u *user) GetAll(ctx context.Context, params *domains.GetUsersParams) ([]domains.User, error) {
+	filter := bson.D{}
+	if params.Search != "" {
+		pattern := primitive.Regex{Pattern: regexp.QuoteMeta(params.Search), Options: "i"}
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "name", Value: pattern}},
+			bson.D{{Key: "username", Value: pattern}},
+			bson.D{{Key: "email", Value: pattern}},
+		}})
+	}
+	if params.Role != "" {
+		filter = append(filter, bson.E{Key: "role", Value: params.Role})
+	}
+	if params.IsDeactivated != nil {
+		if *params.IsDeactivated {
+			filter = append(filter, bson.E{Key: "isDeactivated", Value: true})
+		} else {
+			filter = append(filter, bson.E{Key: "isDeactivated", Value: bson.D{{Key: "$ne", Value: true}}})
+		}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "username", Value: 1}}).
+		SetSkip(int64(params.Offset)).
+		SetLimit(int64(params.Limit))
+
+	res := []domains.User{}
+	cur, err := u.col.Find(ctx, filter, opts)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (u *user) GetByIDs(ctx context.Context, ids []primitive.ObjectID) ([]domains.User, error) {
+	filter := bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}}
+	res := []domains.User{}
+	cur, err := u.col.Find(ctx, filter)
+	if err != nil {
+		return res, err
+	}
+	if err := cur.All(ctx, &res); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (u *user) Update(ctx context.Context, params *domains.UpdateUserParams) (*domains.User, error) {
+	filter := bson.D{{Key: "_id", Value: params.ID}}
+	updateValue := bson.D{}
+	if params.Name != "" {
+		updateValue = append(updateValue, bson.E{Key: "name", Value: params.Name})
+	}
+	if params.Email != "" {
+		updateValue = append(updateValue, bson.E{Key: "email", Value: params.Email})
+	}
+	if params.ImageUrl != "" {
+		updateValue = append(updateValue, bson.E{Key: "imageUrl", Value: params.ImageUrl})
+	}
+	if params.Password != "" {
+		updateValue = append(updateValue, bson.E{Key: "password", Value: params.Password})
+	}
+	if params.Role != "" {
+		updateValue = append(updateValue, bson.E{Key: "role", Value: params.Role})
+	}
+	if params.IsDeactivated != nil {
+		updateValue = append(updateValue, bson.E{Key: "isDeactivated", Value: *params.IsDeactivated})
+	}
+	if params.OIDCSubject != "" {
+		updateValue = append(updateValue, bson.E{Key: "oidcSubject", Value: params.OIDCSubject})
+	}
+	update := bson.D{{Key: "$set", Value: updateValue}}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetReturnDocument(options.After).SetUpsert(false)
+	res := domains.User{}
+	if err := u.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (u *user) SetPendingTOTPSecret(ctx context.Context, id primitive.ObjectID, secret string) error {
+	filter := bson.D{{Key: "_id", Value: id}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "pendingTotpSecret", Value: secret}}}}
+	if _, err := u.col.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *user) EnableTOTP(ctx context.Context, id primitive.ObjectID, secret string, recoveryCodeHashes []string) error {
+	filter := bson.D{{Key: "_id", Value: id}}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "mfaEnabled", Value: true},
+			{Key: "totpSecret", Value: secret},
+			{Key: "recoveryCodes", Value: recoveryCodeHashes},
+		}},
+		{Key: "$unset", Value: bson.D{
+			{Key: "pendingTotpSecret", Value: ""},
+			{Key: "totpLastStep", Value: ""},
+		}},
+	}
+	if _, err := u.col.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *user) DisableTOTP(ctx context.Context, id primitive.ObjectID) error {
+	filter := bson.D{{Key: "_id", Value: id}}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{{Key: "mfaEnabled", Value: false}}},
+		{Key: "$unset", Value: bson.D{
+			{Key: "totpSecret", Value: ""},
+			{Key: "pendingTotpSecret", Value: ""},
+			{Key: "totpLastStep", Value: ""},
+			{Key: "recoveryCodes", Value: ""},
+		}},
+	}
+	if _, err := u.col.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UseTOTPStep records the time step of an accepted code. It only succeeds for
+// a step newer than the last one, so a code cannot be replayed.
+func (u *user) UseTOTPStep(ctx context.Context, id primitive.ObjectID, step int64) (bool, error) {
+	filter := bson.D{
+		{Key: "_id", Value: id},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "totpLastStep", Value: bson.D{{Key: "$exists", Value: false}}}},
+			bson.D{{Key: "totpLastStep", Value: bson.D{{Key: "$lt", Value: step}}}},
+		}},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "totpLastStep", Value: step}}}}
+	res, err := u.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (u *user) UseRecoveryCode(ctx context.Context, id primitive.ObjectID, codeHash string) (bool, error) {
+	filter := bson.D{{Key: "_id", Value: id}, {Key: "recoveryCodes", Value: codeHash}}
+	update := bson.D{{Key: "$pull", Value: bson.D{{Key: "recoveryCodes", Value: codeHash}}}}
+	res, err := u.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
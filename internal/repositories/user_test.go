@@ -107,6 +107,66 @@ func TestGetByUserUsername(t *testing.T) {
 	})
 }
 
+func TestGetUserByOIDCSubject(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get user by oidc subject success", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		expectedUser := user
+		expectedUser.OIDCSubject = "oidc-subject"
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: user.ID},
+			{Key: "name", Value: user.Name},
+			{Key: "email", Value: user.Email},
+			{Key: "username", Value: user.Username},
+			{Key: "password", Value: user.Password},
+			{Key: "imageUrl", Value: user.ImageUrl},
+			{Key: "role", Value: user.Role},
+			{Key: "oidcSubject", Value: "oidc-subject"},
+		}))
+		data, err := trepo.userRepo.GetByOIDCSubject(ctx, "oidc-subject")
+		assert.Nil(t, err)
+		assert.Equal(t, &expectedUser, data)
+	})
+	mt.Run("get user by oidc subject not found", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch))
+		data, err := trepo.userRepo.GetByOIDCSubject(ctx, "oidc-subject")
+		assert.Nil(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestGetUsersByIDs(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get users by ids success", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, collectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: user.ID},
+			{Key: "name", Value: user.Name},
+			{Key: "email", Value: user.Email},
+			{Key: "username", Value: user.Username},
+			{Key: "password", Value: user.Password},
+			{Key: "imageUrl", Value: user.ImageUrl},
+			{Key: "role", Value: user.Role},
+		}))
+		data, err := trepo.userRepo.GetByIDs(ctx, []primitive.ObjectID{user.ID, primitive.NewObjectID()})
+		assert.Nil(t, err)
+		assert.Equal(t, []domains.User{user}, data)
+	})
+	mt.Run("get users by ids error", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    2,
+			Message: "bad query",
+		}))
+		data, err := trepo.userRepo.GetByIDs(ctx, []primitive.ObjectID{user.ID})
+		assert.Error(t, err)
+		assert.Equal(t, []domains.User{}, data)
+	})
+}
+
 func TestCreateUser(t *testing.T) {
 	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 	defer mt.Close()
@@ -151,3 +211,164 @@ func TestCreateUser(t *testing.T) {
 		assert.True(t, mongo.IsDuplicateKeyError(err))
 	})
 }
+
+func TestGetAllUsers(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get all users success", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		isDeactivated := false
+		params := &domains.GetUsersParams{
+			Search:        "sam",
+			Role:          constants.STAFF_ROLE,
+			IsDeactivated: &isDeactivated,
+			Offset:        0,
+			Limit:         10,
+		}
+		ns := fmt.Sprintf("%s.%s", dbName, collectionName)
+		first := mtest.CreateCursorResponse(1, ns, mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: user.ID},
+			{Key: "name", Value: user.Name},
+			{Key: "email", Value: user.Email},
+			{Key: "username", Value: user.Username},
+			{Key: "password", Value: user.Password},
+			{Key: "imageUrl", Value: user.ImageUrl},
+			{Key: "role", Value: user.Role},
+		})
+		killCursors := mtest.CreateCursorResponse(0, ns, mtest.NextBatch)
+		mt.AddMockResponses(first, killCursors)
+		data, err := trepo.userRepo.GetAll(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, []domains.User{user}, data)
+	})
+	mt.Run("get all users error", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		params := &domains.GetUsersParams{
+			Offset: 0,
+			Limit:  10,
+		}
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    1,
+			Message: "find fail",
+		}))
+		data, err := trepo.userRepo.GetAll(ctx, params)
+		assert.Error(t, err)
+		assert.Equal(t, []domains.User{}, data)
+	})
+}
+
+func TestUpdateUser(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("update user success", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		isDeactivated := true
+		params := &domains.UpdateUserParams{
+			ID:            user.ID,
+			Name:          "Samart P.",
+			IsDeactivated: &isDeactivated,
+		}
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: bson.D{
+				{Key: "_id", Value: user.ID},
+				{Key: "name", Value: params.Name},
+				{Key: "username", Value: user.Username},
+				{Key: "role", Value: user.Role},
+				{Key: "isDeactivated", Value: true},
+			}},
+		})
+		data, err := trepo.userRepo.Update(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, params.Name, data.Name)
+		assert.True(t, data.IsDeactivated)
+	})
+	mt.Run("update user not found", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		params := &domains.UpdateUserParams{
+			ID:   user.ID,
+			Role: constants.ADMIN_ROLE,
+		}
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: nil},
+		})
+		data, err := trepo.userRepo.Update(ctx, params)
+		assert.NoError(t, err)
+		assert.Nil(t, data)
+	})
+	mt.Run("update user error", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		params := &domains.UpdateUserParams{
+			ID:   user.ID,
+			Role: constants.ADMIN_ROLE,
+		}
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "update fail",
+		}))
+		data, err := trepo.userRepo.Update(ctx, params)
+		assert.Error(t, err)
+		assert.Nil(t, data)
+	})
+}
+
+func TestEnableTOTP(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("enable totp success", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}})
+		err := trepo.userRepo.EnableTOTP(ctx, user.ID, "JBSWY3DPEHPK3PXP", []string{"hash-1", "hash-2"})
+		assert.NoError(t, err)
+	})
+	mt.Run("enable totp error", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateWriteErrorsResponse(mtest.WriteError{
+			Index:   1,
+			Code:    11000,
+			Message: "update fail",
+		}))
+		err := trepo.userRepo.EnableTOTP(ctx, user.ID, "JBSWY3DPEHPK3PXP", []string{"hash-1", "hash-2"})
+		assert.Error(t, err)
+	})
+}
+
+func TestUseTOTPStep(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("use totp step success", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}})
+		used, err := trepo.userRepo.UseTOTPStep(ctx, user.ID, 56666666)
+		assert.NoError(t, err)
+		assert.True(t, used)
+	})
+	mt.Run("use totp step rejected when step already used", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 0}, {Key: "nModified", Value: 0}})
+		used, err := trepo.userRepo.UseTOTPStep(ctx, user.ID, 56666666)
+		assert.NoError(t, err)
+		assert.False(t, used)
+	})
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("use recovery code success", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 1}})
+		used, err := trepo.userRepo.UseRecoveryCode(ctx, user.ID, "hash-1")
+		assert.NoError(t, err)
+		assert.True(t, used)
+	})
+	mt.Run("use recovery code rejected when code unknown", func(mt *mtest.T) {
+		trepo := newTestUserRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{{Key: "ok", Value: 1}, {Key: "n", Value: 1}, {Key: "nModified", Value: 0}})
+		used, err := trepo.userRepo.UseRecoveryCode(ctx, user.ID, "hash-unknown")
+		assert.NoError(t, err)
+		assert.False(t, used)
+	})
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// interviewWorkflowID is the id of the document holding the interview
+// appointment workflow.
+const interviewWorkflowID = "interviewAppointment"
+
+// defaultInterviewWorkflow is used until an admin saves a workflow. A finished
+// interview cannot be reopened.
+var defaultInterviewWorkflow = domains.Workflow{
+	Statuses: []domains.WorkflowStatus{
+		{Name: constants.INTERVIEW_STATUS_TODO, Transitions: []string{constants.INTERVIEW_STATUS_IN_PROGRESS}},
+		{Name: constants.INTERVIEW_STATUS_IN_PROGRESS, Transitions: []string{constants.INTERVIEW_STATUS_TODO, constants.INTERVIEW_STATUS_DONE}},
+		{Name: constants.INTERVIEW_STATUS_DONE, Transitions: []string{}},
+	},
+	InitialStatus: constants.INTERVIEW_STATUS_TODO,
+}
+
+type workflowRepository struct {
+	mc  *mongo.Client
+	db  string
+	cn  string
+	col *mongo.Collection
+}
+
+func NewWorkflowRepository(mc *mongo.Client, db string) ports.WorkflowRepository {
+	cn := "workflow"
+	return &workflowRepository{
+		mc:  mc,
+		db:  db,
+		cn:  cn,
+		col: mc.Database(db).Collection(cn),
+	}
+}
+
+// Get returns the default workflow until an admin saves one.
+func (r *workflowRepository) Get(ctx context.Context) (*domains.Workflow, error) {
+	filter := bson.D{{Key: "_id", Value: interviewWorkflowID}}
+	res := domains.Workflow{}
+	if err := r.col.FindOne(ctx, filter).Decode(&res); err != nil {
+		if err == mongo.ErrNoDocuments {
+			res = defaultInterviewWorkflow
+			return &res, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *workflowRepository) Update(ctx context.Context, params *domains.UpdateWorkflowParams) (*domains.Workflow, error) {
+	filter := bson.D{{Key: "_id", Value: interviewWorkflowID}}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "statuses", Value: params.Statuses},
+		{Key: "initialStatus", Value: params.InitialStatus},
+		{Key: "updatedBy", Value: params.UpdatedBy},
+		{Key: "updatedAt", Value: time.Now()},
+	}}}
+	opts := &options.FindOneAndUpdateOptions{}
+	opts.SetReturnDocument(options.After).SetUpsert(true)
+	res := domains.Workflow{}
+	if err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
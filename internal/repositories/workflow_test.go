@@ -0,0 +1,104 @@
+package repositories_test
+
+import (
+	"fmt"
+	"robinhood-assignment/internal/core/domains"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/repositories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+type testWorkflowRepository struct {
+	workflowRepo ports.WorkflowRepository
+}
+
+func newTestWorkflowRepository(mc *mongo.Client, db string) testWorkflowRepository {
+	workflowRepo := repositories.NewWorkflowRepository(mc, db)
+	return testWorkflowRepository{workflowRepo}
+}
+
+var workflowCollectionName = "workflow"
+
+func TestGetWorkflow(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	mt.Run("get workflow success", func(mt *mtest.T) {
+		trepo := newTestWorkflowRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(1, fmt.Sprintf("%s.%s", dbName, workflowCollectionName), mtest.FirstBatch, bson.D{
+			{Key: "_id", Value: "interviewAppointment"},
+			{Key: "statuses", Value: bson.A{
+				bson.D{{Key: "name", Value: "OPEN"}, {Key: "transitions", Value: bson.A{"CLOSED"}}},
+				bson.D{{Key: "name", Value: "CLOSED"}, {Key: "transitions", Value: bson.A{}}},
+			}},
+			{Key: "initialStatus", Value: "OPEN"},
+		}))
+		data, err := trepo.workflowRepo.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domains.Workflow{
+			Statuses: []domains.WorkflowStatus{
+				{Name: "OPEN", Transitions: []string{"CLOSED"}},
+				{Name: "CLOSED", Transitions: []string{}},
+			},
+			InitialStatus: "OPEN",
+		}, data)
+	})
+	mt.Run("get workflow default when not saved", func(mt *mtest.T) {
+		trepo := newTestWorkflowRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, fmt.Sprintf("%s.%s", dbName, workflowCollectionName), mtest.FirstBatch))
+		data, err := trepo.workflowRepo.Get(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &domains.Workflow{
+			Statuses: []domains.WorkflowStatus{
+				{Name: "TODO", Transitions: []string{"IN_PROGRESS"}},
+				{Name: "IN_PROGRESS", Transitions: []string{"TODO", "DONE"}},
+				{Name: "DONE", Transitions: []string{}},
+			},
+			InitialStatus: "TODO",
+		}, data)
+	})
+}
+
+func TestUpdateWorkflow(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+	adminId := primitive.NewObjectID()
+	now := time.Now().Truncate(time.Millisecond).UTC()
+	params := &domains.UpdateWorkflowParams{
+		Statuses:      []domains.WorkflowStatus{{Name: "OPEN", Transitions: []string{}}},
+		InitialStatus: "OPEN",
+		UpdatedBy:     adminId,
+	}
+	mt.Run("update workflow success", func(mt *mtest.T) {
+		trepo := newTestWorkflowRepository(mt.Client, dbName)
+		mt.AddMockResponses(bson.D{
+			{Key: "ok", Value: 1},
+			{Key: "value", Value: bson.D{
+				{Key: "_id", Value: "interviewAppointment"},
+				{Key: "statuses", Value: bson.A{bson.D{{Key: "name", Value: "OPEN"}, {Key: "transitions", Value: bson.A{}}}}},
+				{Key: "initialStatus", Value: "OPEN"},
+				{Key: "updatedBy", Value: adminId},
+				{Key: "updatedAt", Value: now},
+			}},
+		})
+		data, err := trepo.workflowRepo.Update(ctx, params)
+		assert.NoError(t, err)
+		assert.Equal(t, &domains.Workflow{Statuses: params.Statuses, InitialStatus: "OPEN", UpdatedBy: adminId, UpdatedAt: now}, data)
+	})
+	mt.Run("update workflow error", func(mt *mtest.T) {
+		trepo := newTestWorkflowRepository(mt.Client, dbName)
+		mt.AddMockResponses(mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    1,
+			Message: "some error",
+		}))
+		data, err := trepo.workflowRepo.Update(ctx, params)
+		assert.Nil(t, data)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,107 @@
+package validate
+
+import (
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+type apiKeyValidate struct {
+}
+
+func NewAPIKeyValidate() ports.APIKeyValidate {
+	return &apiKeyValidate{}
+}
+
+func (v apiKeyValidate) ValidateGetAPIKeys(ctx *gin.Context) (string, error) {
+	return targetUserID(ctx)
+}
+
+func (v apiKeyValidate) ValidateCreateAPIKey(ctx *gin.Context) (*dto.CreateAPIKeyRequest, error) {
+	req := dto.CreateAPIKeyRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	ownerID, err := targetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = ownerID
+	req.CreatedBy = value.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	if len(req.Scopes) == 0 {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "scopes: Missing required field")
+	}
+	for _, scope := range req.Scopes {
+		if !govalidator.IsIn(scope, constants.API_KEY_SCOPES...) {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "scopes: "+scope+" is not a valid scope")
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "expiresAt: must be in the future")
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v apiKeyValidate) ValidateRevokeAPIKey(ctx *gin.Context) (*dto.RevokeAPIKeyRequest, error) {
+	id := ctx.Param("keyId")
+	if id == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "keyId: Missing required field")
+	}
+	ownerID, err := targetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req := dto.RevokeAPIKeyRequest{
+		ID:        id,
+		UserID:    ownerID,
+		RevokedBy: value.(string),
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("keyId", "param", "bsonobjectid", id, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+// targetUserID resolves which user a request is about: the user in the :id
+// param on admin routes, otherwise the authenticated user.
+func targetUserID(ctx *gin.Context) (string, error) {
+	if id := ctx.Param("id"); id != "" {
+		formats := strfmt.Default
+		if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
+			return "", helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+		return id, nil
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return "", helpers.InternalError
+	}
+	return value.(string), nil
+}
@@ -0,0 +1,146 @@
+package validate_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/validate"
+	"testing"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type testAPIKeyValidate struct {
+	apiKeyValidate ports.APIKeyValidate
+}
+
+func newTestAPIKeyValidate(t *testing.T) testAPIKeyValidate {
+	apiKeyValidate := validate.NewAPIKeyValidate()
+	return testAPIKeyValidate{apiKeyValidate}
+}
+
+func TestValidateGetAPIKeys(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("validate get api keys of current user", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		tvalid := newTestAPIKeyValidate(t)
+		got, err := tvalid.apiKeyValidate.ValidateGetAPIKeys(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "6476f457e64589e868aac97b", got)
+	})
+	t.Run("validate get api keys of user in param", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Params = []gin.Param{{Key: "id", Value: "6476f457e64589e868aac97c"}}
+		tvalid := newTestAPIKeyValidate(t)
+		got, err := tvalid.apiKeyValidate.ValidateGetAPIKeys(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "6476f457e64589e868aac97c", got)
+	})
+	t.Run("validate get api keys error when id is invalid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{{Key: "id", Value: "invalid-id"}}
+		tvalid := newTestAPIKeyValidate(t)
+		got, err := tvalid.apiKeyValidate.ValidateGetAPIKeys(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "id in param must be of type bsonobjectid: \"invalid-id\"")
+		assert.Equal(t, "", got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateCreateAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		Name      string     `json:"name,omitempty"`
+		Scopes    []string   `json:"scopes,omitempty"`
+		ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	}
+	newContext := func(body requestBody) *gin.Context {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		return ctx
+	}
+	t.Run("validate create api key success", func(t *testing.T) {
+		expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+		ctx := newContext(requestBody{Name: "sync", Scopes: []string{"interviews:read"}, ExpiresAt: &expiresAt})
+		tvalid := newTestAPIKeyValidate(t)
+		got, err := tvalid.apiKeyValidate.ValidateCreateAPIKey(ctx)
+		expected := &dto.CreateAPIKeyRequest{
+			UserID:    "6476f457e64589e868aac97b",
+			Name:      "sync",
+			Scopes:    []string{"interviews:read"},
+			ExpiresAt: &expiresAt,
+			CreatedBy: "6476f457e64589e868aac97b",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate create api key error when scopes are missing", func(t *testing.T) {
+		ctx := newContext(requestBody{Name: "sync"})
+		tvalid := newTestAPIKeyValidate(t)
+		got, err := tvalid.apiKeyValidate.ValidateCreateAPIKey(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "scopes: Missing required field"), err)
+	})
+	t.Run("validate create api key error when scope is unknown", func(t *testing.T) {
+		ctx := newContext(requestBody{Name: "sync", Scopes: []string{"interviews:delete"}})
+		tvalid := newTestAPIKeyValidate(t)
+		got, err := tvalid.apiKeyValidate.ValidateCreateAPIKey(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "scopes: interviews:delete is not a valid scope"), err)
+	})
+	t.Run("validate create api key error when expiry is in the past", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Hour)
+		ctx := newContext(requestBody{Name: "sync", Scopes: []string{"interviews:read"}, ExpiresAt: &expiresAt})
+		tvalid := newTestAPIKeyValidate(t)
+		got, err := tvalid.apiKeyValidate.ValidateCreateAPIKey(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "expiresAt: must be in the future"), err)
+	})
+}
+
+func TestValidateRevokeAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate revoke api key success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest("DELETE", "http://example.com", nil)
+		ctx.Request.RemoteAddr = "10.0.0.1:51234"
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Set("requestId", "req-1")
+		ctx.Params = []gin.Param{{Key: "keyId", Value: "6476f457e64589e868aac97d"}}
+		tvalid := newTestAPIKeyValidate(t)
+		got, err := tvalid.apiKeyValidate.ValidateRevokeAPIKey(ctx)
+		expected := &dto.RevokeAPIKeyRequest{
+			ID:        "6476f457e64589e868aac97d",
+			UserID:    "6476f457e64589e868aac97b",
+			RevokedBy: "6476f457e64589e868aac97b",
+			RequestID: "req-1",
+			ClientIP:  "10.0.0.1",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate revoke api key error when key id is invalid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Params = []gin.Param{{Key: "keyId", Value: "invalid-id"}}
+		tvalid := newTestAPIKeyValidate(t)
+		got, err := tvalid.apiKeyValidate.ValidateRevokeAPIKey(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "keyId in param must be of type bsonobjectid: \"invalid-id\"")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"strconv"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+const maxAuditLimit = 100
+
+type auditValidate struct {
+}
+
+func NewAuditValidate() ports.AuditValidate {
+	return &auditValidate{}
+}
+
+func (v auditValidate) ValidateGetAuditEvents(ctx *gin.Context) (*dto.GetAuditEventsRequest, error) {
+	req := dto.GetAuditEventsRequest{
+		ActorID:       ctx.Query("actorId"),
+		Action:        ctx.Query("action"),
+		TargetType:    ctx.Query("targetType"),
+		TargetID:      ctx.Query("targetId"),
+		AppointmentID: ctx.Query("appointmentId"),
+		RequestID:     ctx.Query("requestId"),
+	}
+	limit, cursor, err := validateAuditPage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Limit, req.Cursor = limit, cursor
+	if from, ok := ctx.GetQuery("from"); ok {
+		t, err := parseDateQuery(from, false)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid from query parameter")
+		}
+		req.From = t
+	}
+	if to, ok := ctx.GetQuery("to"); ok {
+		t, err := parseDateQuery(to, true)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid to query parameter")
+		}
+		req.To = t
+	}
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "from: must be before to")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	formats := strfmt.Default
+	ids := []struct{ name, value string }{
+		{"actorId", req.ActorID},
+		{"targetId", req.TargetID},
+		{"appointmentId", req.AppointmentID},
+	}
+	for _, id := range ids {
+		if id.value == "" {
+			continue
+		}
+		if err := validate.FormatOf(id.name, "query", "bsonobjectid", id.value, formats); err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+	}
+	if req.TargetType != "" && !constants.IsAuditTargetType(req.TargetType) {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "targetType: Unknown target type "+req.TargetType)
+	}
+	return &req, nil
+}
+
+// validateAuditPage reads the limit and cursor query parameters of a page of
+// audit events.
+func validateAuditPage(ctx *gin.Context) (uint32, string, error) {
+	var limit uint32
+	if value, ok := ctx.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(value)
+		if err != nil || v < 0 || v > maxAuditLimit {
+			return 0, "", helpers.NewCustomError(http.StatusBadRequest, "Invalid limit query parameter")
+		}
+		limit = uint32(v)
+	}
+	cursor, ok := ctx.GetQuery("cursor")
+	if !ok {
+		return limit, "", nil
+	}
+	_, eventId, err := helpers.DecodeCreatedAtCursor(cursor, true)
+	if err != nil || validate.FormatOf("cursor", "query", "bsonobjectid", eventId, strfmt.Default) != nil {
+		return 0, "", helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter")
+	}
+	return limit, cursor, nil
+}
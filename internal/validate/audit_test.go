@@ -0,0 +1,103 @@
+package validate_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/validate"
+	"testing"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type testAuditValidate struct {
+	auditValidate ports.AuditValidate
+}
+
+func newTestAuditValidate(t *testing.T) testAuditValidate {
+	auditValidate := validate.NewAuditValidate()
+	return testAuditValidate{auditValidate}
+}
+
+func TestValidateGetAuditEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	newContext := func(query string) *gin.Context {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest("GET", "http://example.com/api/audit?"+query, nil)
+		return ctx
+	}
+	t.Run("validate get audit events success", func(t *testing.T) {
+		cursor := helpers.NewCreatedAtCursor(time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC), "64ac6cb9b0a3e8792efc438e", true)
+		ctx := newContext("actorId=6476f457e64589e868aac97b&action=interview.update&targetType=interview&appointmentId=64ac6cb9b0a3e8792efc438f&requestId=req-1&from=2023-07-01&to=2023-07-31&limit=50&cursor=" + cursor)
+		tvalid := newTestAuditValidate(t)
+		got, err := tvalid.auditValidate.ValidateGetAuditEvents(ctx)
+		from := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+		expected := &dto.GetAuditEventsRequest{
+			ActorID:       "6476f457e64589e868aac97b",
+			Action:        "interview.update",
+			TargetType:    "interview",
+			AppointmentID: "64ac6cb9b0a3e8792efc438f",
+			RequestID:     "req-1",
+			From:          &from,
+			To:            &to,
+			Cursor:        cursor,
+			Limit:         50,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get audit events without filters", func(t *testing.T) {
+		ctx := newContext("")
+		tvalid := newTestAuditValidate(t)
+		got, err := tvalid.auditValidate.ValidateGetAuditEvents(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.GetAuditEventsRequest{}, got)
+	})
+	t.Run("validate get audit events error when actorId is invalid", func(t *testing.T) {
+		ctx := newContext("actorId=xxxxxxx")
+		tvalid := newTestAuditValidate(t)
+		got, err := tvalid.auditValidate.ValidateGetAuditEvents(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "actorId in query must be of type bsonobjectid: \"xxxxxxx\"")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get audit events error when targetType is unknown", func(t *testing.T) {
+		ctx := newContext("targetType=template")
+		tvalid := newTestAuditValidate(t)
+		got, err := tvalid.auditValidate.ValidateGetAuditEvents(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "targetType: Unknown target type template")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get audit events error when from is after to", func(t *testing.T) {
+		ctx := newContext("from=2023-08-01&to=2023-07-01")
+		tvalid := newTestAuditValidate(t)
+		got, err := tvalid.auditValidate.ValidateGetAuditEvents(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "from: must be before to")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get audit events error when limit is too large", func(t *testing.T) {
+		ctx := newContext("limit=101")
+		tvalid := newTestAuditValidate(t)
+		got, err := tvalid.auditValidate.ValidateGetAuditEvents(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid limit query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get audit events error when cursor is invalid", func(t *testing.T) {
+		ctx := newContext("cursor=xxxxxxx")
+		tvalid := newTestAuditValidate(t)
+		got, err := tvalid.auditValidate.ValidateGetAuditEvents(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
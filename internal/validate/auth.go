@@ -25,6 +25,8 @@ func (v authValidate) ValidateLogin(ctx *gin.Context) (*dto.LoginRequest, error)
 	if _, err := govalidator.ValidateStruct(req); err != nil {
 		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
 	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
 	return req, nil
 }
 
@@ -36,5 +38,120 @@ func (v authValidate) ValidateCreateStaff(ctx *gin.Context) (*dto.CreateStaffReq
 	if _, err := govalidator.ValidateStruct(req); err != nil {
 		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
 	}
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.CreatedBy = userId.(string)
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return req, nil
+}
+
+func (v authValidate) ValidateRefreshToken(ctx *gin.Context) (*dto.RefreshTokenRequest, error) {
+	req := &dto.RefreshTokenRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return req, nil
+}
+
+func (v authValidate) ValidateLogout(ctx *gin.Context) (*dto.LogoutRequest, error) {
+	value, exists := ctx.Get("sessionId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req := &dto.LogoutRequest{
+		SessionID: value.(string),
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return req, nil
+}
+
+func (v authValidate) ValidateMFAEnroll(ctx *gin.Context) (*dto.MFAEnrollRequest, error) {
+	req := &dto.MFAEnrollRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return req, nil
+}
+
+func (v authValidate) ValidateMFAVerify(ctx *gin.Context) (*dto.MFAVerifyRequest, error) {
+	req := &dto.MFAVerifyRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return req, nil
+}
+
+func (v authValidate) ValidateForgotPassword(ctx *gin.Context) (*dto.ForgotPasswordRequest, error) {
+	req := &dto.ForgotPasswordRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return req, nil
+}
+
+func (v authValidate) ValidateResetPassword(ctx *gin.Context) (*dto.ResetPasswordRequest, error) {
+	req := &dto.ResetPasswordRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return req, nil
+}
+
+// ValidateOIDCCallback reads the redirect from the identity provider, which
+// carries an error instead of a code when the user cancels or is denied.
+func (v authValidate) ValidateOIDCCallback(ctx *gin.Context) (*dto.OIDCCallbackRequest, error) {
+	if ctx.Query("error") != "" {
+		return nil, helpers.NewCustomError(http.StatusUnauthorized, "OIDC login was cancelled or denied")
+	}
+	req := &dto.OIDCCallbackRequest{
+		Code:  ctx.Query("code"),
+		State: ctx.Query("state"),
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return req, nil
+}
+
+func (v authValidate) ValidateUpdateAuthSetting(ctx *gin.Context) (*dto.UpdateAuthSettingRequest, error) {
+	req := &dto.UpdateAuthSettingRequest{}
+	if err := ctx.BindJSON(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	if req.PasswordLoginEnabled == nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "passwordLoginEnabled: Missing required field")
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = value.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
 	return req, nil
 }
@@ -43,11 +43,15 @@ func TestValidateLogin(t *testing.T) {
 		json.NewEncoder(&buf).Encode(body)
 		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
 		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		ctx.Request.RemoteAddr = "10.0.0.1:51234"
+		ctx.Set("requestId", "req-1")
 		tvalid := newTestAuthValidate(t)
 		got, err := tvalid.authValidate.ValidateLogin(ctx)
 		expected := &dto.LoginRequest{
-			Username: username,
-			Password: password,
+			Username:  username,
+			Password:  password,
+			ClientIP:  "10.0.0.1",
+			RequestID: "req-1",
 		}
 		assert.NoError(t, err)
 		assert.Equal(t, expected, got)
@@ -112,15 +116,21 @@ func TestValidateCreateStaff(t *testing.T) {
 		json.NewEncoder(&buf).Encode(body)
 		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
 		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		ctx.Request.RemoteAddr = "10.0.0.1:51234"
+		ctx.Set("userId", "64ac6cb9b0a3e8792efc438e")
+		ctx.Set("requestId", "req-1")
 		tvalid := newTestAuthValidate(t)
 		got, err := tvalid.authValidate.ValidateCreateStaff(ctx)
 		expected := &dto.CreateStaffRequest{
-			Name:     name,
-			Email:    email,
-			Username: username,
-			Password: password,
-			ImageUrl: imageUrl,
-			Role:     role,
+			Name:      name,
+			Email:     email,
+			Username:  username,
+			Password:  password,
+			ImageUrl:  imageUrl,
+			Role:      role,
+			CreatedBy: "64ac6cb9b0a3e8792efc438e",
+			RequestID: "req-1",
+			ClientIP:  "10.0.0.1",
 		}
 		assert.NoError(t, err)
 		assert.Equal(t, expected, got)
@@ -286,7 +296,252 @@ func TestValidateCreateStaff(t *testing.T) {
 		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
 		tvalid := newTestAuthValidate(t)
 		got, err := tvalid.authValidate.ValidateCreateStaff(ctx)
-		expected := helpers.NewCustomError(http.StatusBadRequest, "role: user does not validate as in(STAFF|ADMIN)")
+		expected := helpers.NewCustomError(http.StatusBadRequest, "role: user does not validate as in(VIEWER|INTERVIEWER|STAFF|ADMIN)")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateRefreshToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		RefreshToken string `json:"refreshToken,omitempty"`
+	}
+	t.Run("validate refresh token success", func(t *testing.T) {
+		body := requestBody{
+			RefreshToken: "refresh-token",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateRefreshToken(ctx)
+		expected := &dto.RefreshTokenRequest{
+			RefreshToken: "refresh-token",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate refresh token error when refresh token is missing", func(t *testing.T) {
+		body := requestBody{}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateRefreshToken(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "refreshToken: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateLogout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate logout success", func(t *testing.T) {
+		sessionId := "64aaf0156999249a602ff55f"
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("sessionId", sessionId)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateLogout(ctx)
+		expected := &dto.LogoutRequest{
+			SessionID: sessionId,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate logout error when session is missing", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateLogout(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestValidateForgotPassword(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		Email string `json:"email,omitempty"`
+	}
+	t.Run("validate forgot password success", func(t *testing.T) {
+		body := requestBody{
+			Email: "samart.ph.work@gmail.com",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateForgotPassword(ctx)
+		expected := &dto.ForgotPasswordRequest{
+			Email: "samart.ph.work@gmail.com",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate forgot password error when email is invalid format", func(t *testing.T) {
+		body := requestBody{
+			Email: "samart",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateForgotPassword(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "email: samart does not validate as email")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateResetPassword(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		Token    string `json:"token,omitempty"`
+		Password string `json:"password,omitempty"`
+	}
+	t.Run("validate reset password success", func(t *testing.T) {
+		body := requestBody{
+			Token:    "reset-token",
+			Password: "0987654321",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateResetPassword(ctx)
+		expected := &dto.ResetPasswordRequest{
+			Token:    "reset-token",
+			Password: "0987654321",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate reset password error when token is missing", func(t *testing.T) {
+		body := requestBody{
+			Password: "0987654321",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateResetPassword(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "token: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateMFAVerify(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		MFAToken string `json:"mfaToken,omitempty"`
+		Code     string `json:"code,omitempty"`
+	}
+	t.Run("validate mfa verify success", func(t *testing.T) {
+		body := requestBody{
+			MFAToken: "mfa-token",
+			Code:     "123456",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateMFAVerify(ctx)
+		expected := &dto.MFAVerifyRequest{
+			MFAToken: "mfa-token",
+			Code:     "123456",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate mfa verify error when code is missing", func(t *testing.T) {
+		body := requestBody{
+			MFAToken: "mfa-token",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateMFAVerify(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "code: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateOIDCCallback(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate oidc callback success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/api/auth/oidc/callback?code=auth-code&state=state", nil)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateOIDCCallback(ctx)
+		expected := &dto.OIDCCallbackRequest{
+			Code:  "auth-code",
+			State: "state",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate oidc callback error when state is missing", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/api/auth/oidc/callback?code=auth-code", nil)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateOIDCCallback(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "state: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate oidc callback error when provider returns error", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/api/auth/oidc/callback?error=access_denied&state=state", nil)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateOIDCCallback(ctx)
+		expected := helpers.NewCustomError(http.StatusUnauthorized, "OIDC login was cancelled or denied")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateUpdateAuthSetting(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	userId := "64ac6cb9b0a3e8792efc438e"
+	t.Run("validate update auth setting success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", bytes.NewBufferString(`{"passwordLoginEnabled":false}`))
+		ctx.Set("userId", userId)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateUpdateAuthSetting(ctx)
+		enabled := false
+		expected := &dto.UpdateAuthSettingRequest{
+			PasswordLoginEnabled: &enabled,
+			UserID:               userId,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update auth setting error when password login enabled is missing", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", bytes.NewBufferString(`{}`))
+		ctx.Set("userId", userId)
+		tvalid := newTestAuthValidate(t)
+		got, err := tvalid.authValidate.ValidateUpdateAuthSetting(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "passwordLoginEnabled: Missing required field")
 		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
@@ -0,0 +1,131 @@
+package validate
+
+import (
+	"net/http"
+	"regexp"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"strconv"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// phonePattern accepts digits with an optional leading "+" and the usual
+// separators, which is as far as we can check without knowing the country.
+var phonePattern = regexp.MustCompile(`^\+?[0-9][0-9 ()-]{5,19}$`)
+
+type candidateValidate struct {
+}
+
+func NewCandidateValidate() ports.CandidateValidate {
+	return &candidateValidate{}
+}
+
+func (v candidateValidate) ValidateGetCandidates(ctx *gin.Context) (*dto.GetCandidatesRequest, error) {
+	req := dto.GetCandidatesRequest{}
+	if page, ok := ctx.GetQuery("page"); ok {
+		v, err := strconv.Atoi(page)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid page query parameter")
+		}
+		req.Page = uint32(v)
+	}
+	if limit, ok := ctx.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid limit query parameter")
+		}
+		req.Limit = uint32(v)
+	}
+	req.Search = ctx.Query("search")
+	req.Position = ctx.Query("position")
+	req.Stage = ctx.Query("stage")
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return &req, nil
+}
+
+func (v candidateValidate) ValidateGetCandidate(ctx *gin.Context) (string, error) {
+	id := ctx.Param("id")
+	if id == "" {
+		return "", helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
+		return "", helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return id, nil
+}
+
+func (v candidateValidate) ValidateCreateCandidate(ctx *gin.Context) (*dto.CreateCandidateRequest, error) {
+	req := dto.CreateCandidateRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.CreatedBy = value.(string)
+	req.Name = strings.TrimSpace(req.Name)
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	req.Position = strings.TrimSpace(req.Position)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	if err := validatePhone(req.Phone); err != nil {
+		return nil, err
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v candidateValidate) ValidateUpdateCandidate(ctx *gin.Context) (*dto.UpdateCandidateRequest, error) {
+	req := dto.UpdateCandidateRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	id := ctx.Param("id")
+	if id == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	req.ID = id
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = value.(string)
+	req.Name = strings.TrimSpace(req.Name)
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	req.Position = strings.TrimSpace(req.Position)
+	if req.Name == "" && req.Email == "" && req.Phone == "" && req.Position == "" && req.Stage == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "at least one field required")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	if err := validatePhone(req.Phone); err != nil {
+		return nil, err
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func validatePhone(phone string) error {
+	if phone != "" && !phonePattern.MatchString(phone) {
+		return helpers.NewCustomError(http.StatusBadRequest, "phone: Invalid phone number")
+	}
+	return nil
+}
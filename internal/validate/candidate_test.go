@@ -0,0 +1,163 @@
+package validate_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/validate"
+	"testing"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCandidateValidate struct {
+	candidateValidate ports.CandidateValidate
+}
+
+func newTestCandidateValidate(t *testing.T) testCandidateValidate {
+	candidateValidate := validate.NewCandidateValidate()
+	return testCandidateValidate{candidateValidate}
+}
+
+func TestValidateGetCandidates(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate get candidates success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?page=2&limit=10&search=jane&position=Designer&stage=ONSITE", nil)
+		tvalid := newTestCandidateValidate(t)
+		got, err := tvalid.candidateValidate.ValidateGetCandidates(ctx)
+		expected := &dto.GetCandidatesRequest{
+			Page:     2,
+			Limit:    10,
+			Search:   "jane",
+			Position: "Designer",
+			Stage:    "ONSITE",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get candidates error when stage is invalid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?stage=INTERVIEWING", nil)
+		tvalid := newTestCandidateValidate(t)
+		got, err := tvalid.candidateValidate.ValidateGetCandidates(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, helpers.ErrorHandler(err).StatusCode)
+	})
+}
+
+func TestValidateCreateCandidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	newContext := func(body map[string]interface{}) *gin.Context {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		return ctx
+	}
+	t.Run("validate create candidate success", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{
+			"name":     " Jane Doe ",
+			"email":    "Jane@Example.com",
+			"phone":    "+66 81-234-5678",
+			"position": "Designer",
+		})
+		tvalid := newTestCandidateValidate(t)
+		got, err := tvalid.candidateValidate.ValidateCreateCandidate(ctx)
+		expected := &dto.CreateCandidateRequest{
+			Name:      "Jane Doe",
+			Email:     "jane@example.com",
+			Phone:     "+66 81-234-5678",
+			Position:  "Designer",
+			CreatedBy: "6476f457e64589e868aac97b",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate create candidate error when position is missing", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{
+			"name":  "Jane Doe",
+			"email": "jane@example.com",
+		})
+		tvalid := newTestCandidateValidate(t)
+		got, err := tvalid.candidateValidate.ValidateCreateCandidate(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "position: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate create candidate error when phone is invalid", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{
+			"name":     "Jane Doe",
+			"email":    "jane@example.com",
+			"phone":    "call me",
+			"position": "Designer",
+		})
+		tvalid := newTestCandidateValidate(t)
+		got, err := tvalid.candidateValidate.ValidateCreateCandidate(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "phone: Invalid phone number")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate create candidate error when stage is invalid", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{
+			"name":     "Jane Doe",
+			"email":    "jane@example.com",
+			"position": "Designer",
+			"stage":    "INTERVIEWING",
+		})
+		tvalid := newTestCandidateValidate(t)
+		got, err := tvalid.candidateValidate.ValidateCreateCandidate(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, helpers.ErrorHandler(err).StatusCode)
+	})
+}
+
+func TestValidateUpdateCandidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	newContext := func(id string, body map[string]interface{}) *gin.Context {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		return ctx
+	}
+	t.Run("validate update candidate success", func(t *testing.T) {
+		ctx := newContext("64b0c4f2e64589e868aac900", map[string]interface{}{"stage": "OFFER"})
+		tvalid := newTestCandidateValidate(t)
+		got, err := tvalid.candidateValidate.ValidateUpdateCandidate(ctx)
+		expected := &dto.UpdateCandidateRequest{
+			ID:     "64b0c4f2e64589e868aac900",
+			Stage:  "OFFER",
+			UserID: "6476f457e64589e868aac97b",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update candidate error when body is empty", func(t *testing.T) {
+		ctx := newContext("64b0c4f2e64589e868aac900", map[string]interface{}{})
+		tvalid := newTestCandidateValidate(t)
+		got, err := tvalid.candidateValidate.ValidateUpdateCandidate(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "at least one field required")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate update candidate error when id is invalid", func(t *testing.T) {
+		ctx := newContext("xxxxx", map[string]interface{}{"stage": "OFFER"})
+		tvalid := newTestCandidateValidate(t)
+		got, err := tvalid.candidateValidate.ValidateUpdateCandidate(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, helpers.ErrorHandler(err).StatusCode)
+	})
+}
@@ -2,10 +2,14 @@ package validate
 
 import (
 	"net/http"
+	"net/url"
+	"regexp"
 	"robinhood-assignment/helpers"
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/dto"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/gin-gonic/gin"
@@ -13,6 +17,18 @@ import (
 	"github.com/go-openapi/validate"
 )
 
+const maxWorkflowStatuses = 20
+
+var statusNamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,31}$`)
+
+const (
+	maxTags         = 20
+	maxSearchLength = 200
+)
+
+// tagPattern matches a tag after normalizeTag.
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
 type interviewValidate struct {
 }
 
@@ -38,22 +54,124 @@ func (v interviewValidate) ValidateGetInterviewAppointments(ctx *gin.Context) (*
 		i := uint32(v)
 		req.Limit = i
 	}
+	if archived, ok := ctx.GetQuery("archived"); ok {
+		v, err := strconv.ParseBool(archived)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid archived query parameter")
+		}
+		req.Archived = v
+	}
+	if from, ok := ctx.GetQuery("from"); ok {
+		t, err := parseDateQuery(from, false)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid from query parameter")
+		}
+		req.From = t
+	}
+	if to, ok := ctx.GetQuery("to"); ok {
+		t, err := parseDateQuery(to, true)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid to query parameter")
+		}
+		req.To = t
+	}
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "from: must be before to")
+	}
+	if status, ok := ctx.GetQuery("status"); ok {
+		if !statusNamePattern.MatchString(status) {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid status query parameter")
+		}
+		req.Status = status
+	}
+	if createdBy, ok := ctx.GetQuery("createdBy"); ok {
+		if err := validate.FormatOf("createdBy", "query", "bsonobjectid", createdBy, strfmt.Default); err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+		req.CreatedBy = createdBy
+	}
+	if tag, ok := ctx.GetQuery("tag"); ok {
+		tag = normalizeTag(tag)
+		if !tagPattern.MatchString(tag) {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid tag query parameter")
+		}
+		req.Tag = tag
+	}
+	if q, ok := ctx.GetQuery("q"); ok {
+		q = strings.TrimSpace(q)
+		if q == "" || len(q) > maxSearchLength {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid q query parameter")
+		}
+		req.Q = q
+		userId, exists := ctx.Get("userId")
+		if !exists {
+			return nil, helpers.InternalError
+		}
+		role, exists := ctx.Get("role")
+		if !exists {
+			return nil, helpers.InternalError
+		}
+		req.UserID = userId.(string)
+		req.Role = role.(string)
+	}
+	if sort, ok := ctx.GetQuery("sort"); ok {
+		if !govalidator.IsIn(sort, "createdAt", "updatedAt", "title") {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid sort query parameter")
+		}
+		req.Sort = sort
+	}
+	if order, ok := ctx.GetQuery("order"); ok {
+		if !govalidator.IsIn(order, "asc", "desc") {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid order query parameter")
+		}
+		req.Order = order
+	}
+	if cursor, ok := ctx.GetQuery("cursor"); ok {
+		if req.Page > 0 {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "page and cursor cannot be used together")
+		}
+		c, err := helpers.DecodeListCursor(cursor)
+		if err != nil || validate.FormatOf("cursor", "query", "bsonobjectid", c.ID, strfmt.Default) != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter")
+		}
+		req.Cursor = cursor
+	}
+	if totalCount, ok := ctx.GetQuery("totalCount"); ok {
+		v, err := strconv.ParseBool(totalCount)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid totalCount query parameter")
+		}
+		req.TotalCount = v
+	}
 	if _, err := govalidator.ValidateStruct(req); err != nil {
 		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
 	}
 	return &req, nil
 }
 
-func (v interviewValidate) ValidateGetInterviewAppointment(ctx *gin.Context) (string, error) {
+func (v interviewValidate) ValidateGetInterviewAppointment(ctx *gin.Context) (*dto.GetInterviewAppointmentRequest, error) {
 	id := ctx.Param("id")
 	if id == "" {
-		return "", helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
 	}
 	formats := strfmt.Default
 	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
-		return "", helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
 	}
-	return id, nil
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	return &dto.GetInterviewAppointmentRequest{
+		ID:          id,
+		UserID:      userId.(string),
+		Role:        role.(string),
+		IfNoneMatch: ctx.GetHeader("If-None-Match"),
+	}, nil
 }
 
 func (v interviewValidate) ValidateCreateInterviewAppointment(ctx *gin.Context) (*dto.CreateInterviewAppointmentRequest, error) {
@@ -70,6 +188,35 @@ func (v interviewValidate) ValidateCreateInterviewAppointment(ctx *gin.Context)
 	if _, err := govalidator.ValidateStruct(req); err != nil {
 		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
 	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("candidateId", "body", "bsonobjectid", req.CandidateID, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	if req.ScorecardTemplateID != "" {
+		if err := validate.FormatOf("scorecardTemplateId", "body", "bsonobjectid", req.ScorecardTemplateID, formats); err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+	}
+	if req.HiringManagerID != "" {
+		if err := validate.FormatOf("hiringManagerId", "body", "bsonobjectid", req.HiringManagerID, formats); err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+	}
+	startAt, endAt, durationMinutes, err := validateSchedule(req.StartAt, req.EndAt, req.DurationMinutes, req.Timezone, true)
+	if err != nil {
+		return nil, err
+	}
+	req.StartAt, req.EndAt, req.DurationMinutes = startAt, endAt, durationMinutes
+	if err := validateMeetingURL(req.MeetingURL); err != nil {
+		return nil, err
+	}
+	tags, err := validateTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	req.Tags = tags
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
 	return &req, nil
 }
 
@@ -83,7 +230,19 @@ func (v interviewValidate) ValidateUpdateInterviewAppointment(ctx *gin.Context)
 		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
 	}
 	req.ID = id
-	if req.Title == "" && req.Description == "" && req.Status == "" {
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = userId.(string)
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.Role = role.(string)
+	if req.Title == "" && req.Description == "" && req.Status == "" && req.StartAt == nil && req.EndAt == nil &&
+		req.DurationMinutes == 0 && req.Timezone == "" && req.Location == "" && req.MeetingURL == "" && req.CandidateID == "" &&
+		req.ScorecardTemplateID == "" && req.BlindFeedback == nil && req.HiringManagerID == "" && req.Tags == nil {
 		return nil, helpers.NewCustomError(http.StatusBadRequest, "at least one field required")
 	}
 	if _, err := govalidator.ValidateStruct(req); err != nil {
@@ -93,19 +252,211 @@ func (v interviewValidate) ValidateUpdateInterviewAppointment(ctx *gin.Context)
 	if err := validate.FormatOf("id", "body", "bsonobjectid", req.ID, formats); err != nil {
 		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
 	}
+	if req.CandidateID != "" {
+		if err := validate.FormatOf("candidateId", "body", "bsonobjectid", req.CandidateID, formats); err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+	}
+	if req.ScorecardTemplateID != "" {
+		if err := validate.FormatOf("scorecardTemplateId", "body", "bsonobjectid", req.ScorecardTemplateID, formats); err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+	}
+	if req.HiringManagerID != "" {
+		if err := validate.FormatOf("hiringManagerId", "body", "bsonobjectid", req.HiringManagerID, formats); err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+	}
+	startAt, endAt, durationMinutes, err := validateSchedule(req.StartAt, req.EndAt, req.DurationMinutes, req.Timezone, false)
+	if err != nil {
+		return nil, err
+	}
+	req.StartAt, req.EndAt, req.DurationMinutes = startAt, endAt, durationMinutes
+	if err := validateMeetingURL(req.MeetingURL); err != nil {
+		return nil, err
+	}
+	if req.Tags != nil {
+		tags, err := validateTags(*req.Tags)
+		if err != nil {
+			return nil, err
+		}
+		req.Tags = &tags
+	}
+	ifMatch, err := parseIfMatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.IfMatch = ifMatch
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v interviewValidate) ValidateArchiveInterviewAppointment(ctx *gin.Context) (*dto.ArchiveInterviewAppointmentRequest, error) {
+	id := ctx.Param("id")
+	if id == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req := dto.ArchiveInterviewAppointmentRequest{
+		ID:     id,
+		UserID: userId.(string),
+		Role:   role.(string),
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
 	return &req, nil
 }
 
-func (v interviewValidate) ValidateArchiveInterviewAppointment(ctx *gin.Context) (string, error) {
+func (v interviewValidate) ValidateUnarchiveInterviewAppointment(ctx *gin.Context) (*dto.UnarchiveInterviewAppointmentRequest, error) {
 	id := ctx.Param("id")
 	if id == "" {
-		return "", helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req := dto.UnarchiveInterviewAppointmentRequest{
+		ID:     id,
+		UserID: userId.(string),
+		Role:   role.(string),
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
 	}
 	formats := strfmt.Default
 	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
-		return "", helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
 	}
-	return id, nil
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+const maxInterviewers = 20
+
+func (v interviewValidate) ValidateAssignInterviewers(ctx *gin.Context) (*dto.AssignInterviewersRequest, error) {
+	req := dto.AssignInterviewersRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	id := ctx.Param("id")
+	if id == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	req.ID = id
+	if req.InterviewerIDs == nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "interviewerIds: Missing required field")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	interviewerIds := []string{}
+	seen := map[string]bool{}
+	for _, interviewerId := range req.InterviewerIDs {
+		if err := validate.FormatOf("interviewerIds", "body", "bsonobjectid", interviewerId, formats); err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+		if !seen[interviewerId] {
+			seen[interviewerId] = true
+			interviewerIds = append(interviewerIds, interviewerId)
+		}
+	}
+	if len(interviewerIds) > maxInterviewers {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "interviewerIds: at most 20 interviewers are allowed")
+	}
+	req.InterviewerIDs = interviewerIds
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = userId.(string)
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v interviewValidate) ValidateGetInterviewerAppointments(ctx *gin.Context) (*dto.GetInterviewerAppointmentsRequest, error) {
+	req := dto.GetInterviewerAppointmentsRequest{}
+	if page, ok := ctx.GetQuery("page"); ok {
+		v, err := strconv.Atoi(page)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid page query parameter")
+		}
+		req.Page = uint32(v)
+	}
+	if limit, ok := ctx.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid limit query parameter")
+		}
+		req.Limit = uint32(v)
+	}
+	interviewerId, err := targetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.InterviewerID = interviewerId
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return &req, nil
+}
+
+func (v interviewValidate) ValidateGetInterviewComments(ctx *gin.Context) (*dto.GetInterviewCommentsRequest, error) {
+	id, err := validateObjectIDParam(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+	req := dto.GetInterviewCommentsRequest{ID: id}
+	if limit, ok := ctx.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(limit)
+		if err != nil || v < 0 {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid limit query parameter")
+		}
+		req.Limit = uint32(v)
+	}
+	if cursor, ok := ctx.GetQuery("cursor"); ok {
+		_, commentId, err := helpers.DecodeCreatedAtCursor(cursor, false)
+		if err != nil || validate.FormatOf("cursor", "query", "bsonobjectid", commentId, strfmt.Default) != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter")
+		}
+		req.Cursor = cursor
+	}
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = userId.(string)
+	req.Role = role.(string)
+	return &req, nil
 }
 
 func (v interviewValidate) ValidateAddInterviewComment(ctx *gin.Context) (*dto.AddInterviewCommentRequest, error) {
@@ -127,6 +478,8 @@ func (v interviewValidate) ValidateAddInterviewComment(ctx *gin.Context) (*dto.A
 	if _, err := govalidator.ValidateStruct(req); err != nil {
 		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
 	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
 	return &req, nil
 }
 
@@ -151,6 +504,11 @@ func (v interviewValidate) ValidateUpdateInterviewComment(ctx *gin.Context) (*dt
 	}
 	userId := value.(string)
 	req.UserID = userId
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.Role = role.(string)
 
 	if _, err := govalidator.ValidateStruct(req); err != nil {
 		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
@@ -162,5 +520,281 @@ func (v interviewValidate) ValidateUpdateInterviewComment(ctx *gin.Context) (*dt
 	if err := validate.FormatOf("commentId", "param", "bsonobjectid", commentId, formats); err != nil {
 		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
 	}
+	ifMatch, err := parseIfMatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.IfMatch = ifMatch
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v interviewValidate) ValidateGetInterviewCommentRevisions(ctx *gin.Context) (*dto.GetInterviewCommentRevisionsRequest, error) {
+	id, err := validateObjectIDParam(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+	commentId, err := validateObjectIDParam(ctx, "commentId")
+	if err != nil {
+		return nil, err
+	}
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	return &dto.GetInterviewCommentRevisionsRequest{
+		ID:        id,
+		CommentID: commentId,
+		UserID:    userId.(string),
+		Role:      role.(string),
+	}, nil
+}
+
+func (v interviewValidate) ValidateDeleteInterviewComment(ctx *gin.Context) (*dto.DeleteInterviewCommentRequest, error) {
+	req := dto.DeleteInterviewCommentRequest{
+		ID:        ctx.Param("id"),
+		CommentID: ctx.Param("commentId"),
+	}
+	if req.ID == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	if req.CommentID == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "commentId: Missing required field")
+	}
+	if purge, ok := ctx.GetQuery("purge"); ok {
+		v, err := strconv.ParseBool(purge)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid purge query parameter")
+		}
+		req.Purge = v
+	}
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = userId.(string)
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.Role = role.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("id", "param", "bsonobjectid", req.ID, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	if err := validate.FormatOf("commentId", "param", "bsonobjectid", req.CommentID, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+const maxInterviewDurationMinutes = 24 * 60
+
+// validateSchedule checks the scheduling fields of an appointment request.
+// A schedule is optional, but when startAt is given it needs either endAt or
+// durationMinutes; the missing one is derived from the other. It also needs a
+// timezone when requireTimezone is set; a reschedule can leave it out to keep
+// the appointment's own. The returned times are in UTC.
+func validateSchedule(startAt *time.Time, endAt *time.Time, durationMinutes int, timezone string, requireTimezone bool) (*time.Time, *time.Time, int, error) {
+	if timezone != "" {
+		if _, err := time.LoadLocation(timezone); err != nil || timezone == "Local" {
+			return nil, nil, 0, helpers.NewCustomError(http.StatusBadRequest, "timezone: Invalid IANA time zone")
+		}
+	}
+	if startAt == nil {
+		if endAt != nil || durationMinutes != 0 {
+			return nil, nil, 0, helpers.NewCustomError(http.StatusBadRequest, "startAt: Missing required field")
+		}
+		return nil, nil, 0, nil
+	}
+	if timezone == "" && requireTimezone {
+		return nil, nil, 0, helpers.NewCustomError(http.StatusBadRequest, "timezone: Missing required field")
+	}
+	if endAt == nil && durationMinutes == 0 {
+		return nil, nil, 0, helpers.NewCustomError(http.StatusBadRequest, "endAt: Missing required field")
+	}
+	if durationMinutes < 0 || durationMinutes > maxInterviewDurationMinutes {
+		return nil, nil, 0, helpers.NewCustomError(http.StatusBadRequest, "durationMinutes: must be between 1 and 1440")
+	}
+	start := startAt.UTC()
+	end := start.Add(time.Duration(durationMinutes) * time.Minute)
+	if endAt != nil {
+		end = endAt.UTC()
+	}
+	if !end.After(start) {
+		return nil, nil, 0, helpers.NewCustomError(http.StatusBadRequest, "endAt: must be after startAt")
+	}
+	minutes := int(end.Sub(start) / time.Minute)
+	if minutes > maxInterviewDurationMinutes {
+		return nil, nil, 0, helpers.NewCustomError(http.StatusBadRequest, "durationMinutes: must be between 1 and 1440")
+	}
+	if durationMinutes != 0 && durationMinutes != minutes {
+		return nil, nil, 0, helpers.NewCustomError(http.StatusBadRequest, "durationMinutes: does not match startAt and endAt")
+	}
+	return &start, &end, minutes, nil
+}
+
+func validateMeetingURL(meetingURL string) error {
+	if meetingURL == "" {
+		return nil
+	}
+	u, err := url.Parse(meetingURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return helpers.NewCustomError(http.StatusBadRequest, "meetingUrl: must be an http or https URL")
+	}
+	return nil
+}
+
+// normalizeTag trims and lowercases a tag so that "Backend " and "backend"
+// are the same tag.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// validateTags normalizes tags and drops duplicates, keeping the first
+// occurrence.
+func validateTags(tags []string) ([]string, error) {
+	if len(tags) > maxTags {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "tags: must contain at most "+strconv.Itoa(maxTags)+" tags")
+	}
+	res := make([]string, 0, len(tags))
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+		if !tagPattern.MatchString(tag) {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "tags: "+strconv.Quote(tag)+" is not a valid tag")
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+	return res, nil
+}
+
+// parseIfMatch returns the version in the If-Match header, or nil when there
+// is no header or it is "*". A tag that is not a version never matches.
+func parseIfMatch(ctx *gin.Context) (*int64, error) {
+	ifMatch := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+	version, ok := helpers.ETagVersion(ifMatch)
+	if !ok {
+		return nil, helpers.NewCustomError(http.StatusPreconditionFailed, "If-Match does not match the current version")
+	}
+	return &version, nil
+}
+
+// parseDateQuery accepts an RFC 3339 timestamp or a plain date. A plain date
+// is the start of that day in UTC, or the start of the next day when it is
+// the end of a range, so that the whole day is included.
+func parseDateQuery(value string, endOfRange bool) (*time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		t = t.UTC()
+		return &t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	if endOfRange {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+// ValidateUpdateWorkflow checks that the workflow is self-contained: status
+// names are unique and every transition and the initial status refer to one
+// of them. The initial status defaults to the first status.
+func (v interviewValidate) ValidateUpdateWorkflow(ctx *gin.Context) (*dto.UpdateWorkflowRequest, error) {
+	req := dto.UpdateWorkflowRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = value.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	if len(req.Statuses) == 0 {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "statuses: Missing required field")
+	}
+	if len(req.Statuses) > maxWorkflowStatuses {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "statuses: must contain at most "+strconv.Itoa(maxWorkflowStatuses)+" statuses")
+	}
+	names := map[string]bool{}
+	for _, status := range req.Statuses {
+		if !statusNamePattern.MatchString(status.Name) {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "statuses: "+strconv.Quote(status.Name)+" is not a valid status name")
+		}
+		if names[status.Name] {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "statuses: "+status.Name+" is listed more than once")
+		}
+		names[status.Name] = true
+	}
+	for i, status := range req.Statuses {
+		transitions := []string{}
+		seen := map[string]bool{}
+		for _, to := range status.Transitions {
+			if to == status.Name {
+				return nil, helpers.NewCustomError(http.StatusBadRequest, "statuses: "+status.Name+" cannot move to itself")
+			}
+			if !names[to] {
+				return nil, helpers.NewCustomError(http.StatusBadRequest, "statuses: "+status.Name+" moves to unknown status "+strconv.Quote(to))
+			}
+			if !seen[to] {
+				seen[to] = true
+				transitions = append(transitions, to)
+			}
+		}
+		req.Statuses[i].Transitions = transitions
+	}
+	if req.InitialStatus == "" {
+		req.InitialStatus = req.Statuses[0].Name
+	}
+	if !names[req.InitialStatus] {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "initialStatus: must be one of the statuses")
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v interviewValidate) ValidateGetInterviewActivity(ctx *gin.Context) (*dto.GetInterviewActivityRequest, error) {
+	id, err := validateObjectIDParam(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+	req := dto.GetInterviewActivityRequest{ID: id}
+	limit, cursor, err := validateAuditPage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Limit, req.Cursor = limit, cursor
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = userId.(string)
+	req.Role = role.(string)
 	return &req, nil
 }
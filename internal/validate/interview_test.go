@@ -7,10 +7,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/constants"
 	"robinhood-assignment/internal/core/ports"
 	"robinhood-assignment/internal/dto"
 	"robinhood-assignment/internal/validate"
 	"testing"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/gin-gonic/gin"
@@ -66,6 +68,165 @@ func TestValidateGetInterviewAppointments(t *testing.T) {
 		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
+	t.Run("validate get interview appointments with cursor and total count", func(t *testing.T) {
+		cursor := helpers.ListCursor{Sort: "createdAt", Desc: true, Value: "2023-07-01T03:00:00Z", ID: "6476f457e64589e868aac982"}.Encode()
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?limit=10&cursor="+cursor+"&totalCount=true", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := &dto.GetInterviewAppointmentsRequest{
+			Limit:      limit,
+			Cursor:     cursor,
+			TotalCount: true,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interview appointments error when page and cursor", func(t *testing.T) {
+		cursor := helpers.ListCursor{Sort: "createdAt", Desc: true, Value: "2023-07-01T03:00:00Z", ID: "6476f457e64589e868aac982"}.Encode()
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?page=2&cursor="+cursor, nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "page and cursor cannot be used together")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview appointments error when invalid cursor params", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?cursor=not-a-cursor", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview appointments error when invalid totalCount params", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?totalCount=maybe", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid totalCount query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview appointments archived", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?archived=true", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := &dto.GetInterviewAppointmentsRequest{
+			Archived: true,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interview appointments error when invalid archived params", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?archived=maybe", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid archived query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview appointments with date range", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?from=2023-07-01T09:00:00%2B07:00&to=2023-07-07", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		from := time.Date(2023, 7, 1, 2, 0, 0, 0, time.UTC)
+		to := time.Date(2023, 7, 8, 0, 0, 0, 0, time.UTC)
+		expected := &dto.GetInterviewAppointmentsRequest{
+			From: &from,
+			To:   &to,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interview appointments error when invalid from params", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?from=yesterday", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid from query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview appointments error when from is after to", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?from=2023-07-08&to=2023-07-01", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "from: must be before to")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview appointments with filters, search and sort", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		url := "http://example.com/?status=IN_PROGRESS&createdBy=6476f457e64589e868aac97b&tag=%20Backend&q=%20golang%20&sort=title&order=asc"
+		ctx.Request, _ = http.NewRequest("GET", url, nil)
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+		ctx.Set("role", constants.INTERVIEWER_ROLE)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := &dto.GetInterviewAppointmentsRequest{
+			Status:    "IN_PROGRESS",
+			CreatedBy: "6476f457e64589e868aac97b",
+			Tag:       "backend",
+			Q:         "golang",
+			Sort:      "title",
+			Order:     "asc",
+			UserID:    "6476f457e64589e868aac97c",
+			Role:      constants.INTERVIEWER_ROLE,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interview appointments error when invalid status params", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?status=in%20progress", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid status query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview appointments error when invalid createdBy params", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?createdBy=xxxxxxx", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "createdBy in query must be of type bsonobjectid: \"xxxxxxx\"")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview appointments error when q is empty", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?q=%20", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid q query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview appointments error when search has no user", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?q=golang", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+	t.Run("validate get interview appointments error when invalid sort params", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?sort=status", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid sort query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
 }
 
 func TestValidateGetInterviewAppointment(t *testing.T) {
@@ -77,9 +238,28 @@ func TestValidateGetInterviewAppointment(t *testing.T) {
 		ctx.Params = []gin.Param{
 			{Key: "id", Value: id},
 		}
+		ctx.Request, _ = http.NewRequest("GET", "/", nil)
+		ctx.Set("userId", "6476f457e64589e868aac977")
+		ctx.Set("role", "INTERVIEWER")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointment(ctx)
-		expected := id
+		expected := &dto.GetInterviewAppointmentRequest{ID: id, UserID: "6476f457e64589e868aac977", Role: "INTERVIEWER"}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interview appointment with If-None-Match", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		ctx.Request, _ = http.NewRequest("GET", "/", nil)
+		ctx.Request.Header.Set("If-None-Match", `"3"`)
+		ctx.Set("userId", "6476f457e64589e868aac977")
+		ctx.Set("role", "INTERVIEWER")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointment(ctx)
+		expected := &dto.GetInterviewAppointmentRequest{ID: id, UserID: "6476f457e64589e868aac977", Role: "INTERVIEWER", IfNoneMatch: `"3"`}
 		assert.NoError(t, err)
 		assert.Equal(t, expected, got)
 	})
@@ -88,7 +268,7 @@ func TestValidateGetInterviewAppointment(t *testing.T) {
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointment(ctx)
 		expected := helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
-		assert.Equal(t, "", got)
+		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
 	t.Run("validate get interview appointment error when id is invalid format", func(t *testing.T) {
@@ -99,7 +279,7 @@ func TestValidateGetInterviewAppointment(t *testing.T) {
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateGetInterviewAppointment(ctx)
 		expected := helpers.NewCustomError(http.StatusBadRequest, "id in param must be of type bsonobjectid: \"xxxxxxx\"")
-		assert.Equal(t, "", got)
+		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
 }
@@ -110,11 +290,14 @@ func TestValidateCreateInterviewAppointment(t *testing.T) {
 	type requestBody struct {
 		Title       string
 		Description string
+		CandidateID string
 	}
+	candidateId := "64b0c4f2e64589e868aac900"
 	t.Run("validate create interview appointment success", func(t *testing.T) {
 		body := requestBody{
 			Title:       "title",
 			Description: "description",
+			CandidateID: candidateId,
 		}
 		var buf bytes.Buffer
 		json.NewEncoder(&buf).Encode(body)
@@ -127,6 +310,8 @@ func TestValidateCreateInterviewAppointment(t *testing.T) {
 		expected := &dto.CreateInterviewAppointmentRequest{
 			Title:       "title",
 			Description: "description",
+			CandidateID: candidateId,
+			Tags:        []string{},
 			CreatedBy:   "6476f457e64589e868aac97b",
 		}
 		assert.NoError(t, err)
@@ -135,6 +320,7 @@ func TestValidateCreateInterviewAppointment(t *testing.T) {
 	t.Run("validate create interview appointments error when title is missing", func(t *testing.T) {
 		body := requestBody{
 			Description: "description",
+			CandidateID: candidateId,
 		}
 		var buf bytes.Buffer
 		json.NewEncoder(&buf).Encode(body)
@@ -150,7 +336,8 @@ func TestValidateCreateInterviewAppointment(t *testing.T) {
 	})
 	t.Run("validate create interview appointments error when description is missing", func(t *testing.T) {
 		body := requestBody{
-			Title: "title",
+			Title:       "title",
+			CandidateID: candidateId,
 		}
 		var buf bytes.Buffer
 		json.NewEncoder(&buf).Encode(body)
@@ -164,6 +351,140 @@ func TestValidateCreateInterviewAppointment(t *testing.T) {
 		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
+	t.Run("validate create interview appointment with schedule", func(t *testing.T) {
+		body := map[string]interface{}{
+			"title":           "title",
+			"description":     "description",
+			"candidateId":     candidateId,
+			"startAt":         "2023-07-10T09:00:00+07:00",
+			"durationMinutes": 45,
+			"timezone":        "Asia/Bangkok",
+			"meetingUrl":      "https://meet.example.com/abc",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateCreateInterviewAppointment(ctx)
+		startAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		endAt := time.Date(2023, 7, 10, 2, 45, 0, 0, time.UTC)
+		expected := &dto.CreateInterviewAppointmentRequest{
+			Title:           "title",
+			Description:     "description",
+			StartAt:         &startAt,
+			EndAt:           &endAt,
+			DurationMinutes: 45,
+			Timezone:        "Asia/Bangkok",
+			MeetingURL:      "https://meet.example.com/abc",
+			CandidateID:     candidateId,
+			Tags:            []string{},
+			CreatedBy:       "6476f457e64589e868aac97b",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate create interview appointments error when candidate id is missing", func(t *testing.T) {
+		body := requestBody{
+			Title:       "title",
+			Description: "description",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateCreateInterviewAppointment(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "candidateId: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate create interview appointments error when candidate id is invalid", func(t *testing.T) {
+		body := requestBody{
+			Title:       "title",
+			Description: "description",
+			CandidateID: "xxxxx",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateCreateInterviewAppointment(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, helpers.ErrorHandler(err).StatusCode)
+	})
+	scheduleErrors := []struct {
+		name     string
+		schedule map[string]interface{}
+		errMsg   string
+	}{
+		{
+			name:     "timezone is missing",
+			schedule: map[string]interface{}{"startAt": "2023-07-10T02:00:00Z", "durationMinutes": 30},
+			errMsg:   "timezone: Missing required field",
+		},
+		{
+			name:     "timezone is invalid",
+			schedule: map[string]interface{}{"startAt": "2023-07-10T02:00:00Z", "durationMinutes": 30, "timezone": "Mars/Olympus"},
+			errMsg:   "timezone: Invalid IANA time zone",
+		},
+		{
+			name:     "end is missing",
+			schedule: map[string]interface{}{"startAt": "2023-07-10T02:00:00Z", "timezone": "UTC"},
+			errMsg:   "endAt: Missing required field",
+		},
+		{
+			name:     "start is missing",
+			schedule: map[string]interface{}{"endAt": "2023-07-10T02:00:00Z", "timezone": "UTC"},
+			errMsg:   "startAt: Missing required field",
+		},
+		{
+			name:     "end is before start",
+			schedule: map[string]interface{}{"startAt": "2023-07-10T02:00:00Z", "endAt": "2023-07-10T01:00:00Z", "timezone": "UTC"},
+			errMsg:   "endAt: must be after startAt",
+		},
+		{
+			name:     "duration does not match end",
+			schedule: map[string]interface{}{"startAt": "2023-07-10T02:00:00Z", "endAt": "2023-07-10T03:00:00Z", "durationMinutes": 30, "timezone": "UTC"},
+			errMsg:   "durationMinutes: does not match startAt and endAt",
+		},
+		{
+			name:     "duration is too long",
+			schedule: map[string]interface{}{"startAt": "2023-07-10T02:00:00Z", "durationMinutes": 1441, "timezone": "UTC"},
+			errMsg:   "durationMinutes: must be between 1 and 1440",
+		},
+		{
+			name:     "meeting url is invalid",
+			schedule: map[string]interface{}{"meetingUrl": "meet.example.com/abc"},
+			errMsg:   "meetingUrl: must be an http or https URL",
+		},
+	}
+	for _, tc := range scheduleErrors {
+		t.Run("validate create interview appointments error when "+tc.name, func(t *testing.T) {
+			body := map[string]interface{}{"title": "title", "description": "description", "candidateId": candidateId}
+			for k, v := range tc.schedule {
+				body[k] = v
+			}
+			var buf bytes.Buffer
+			json.NewEncoder(&buf).Encode(body)
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Set("userId", "6476f457e64589e868aac97b")
+			ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+
+			tvalid := newTestInterviewValidate(t)
+			got, err := tvalid.interviewValidate.ValidateCreateInterviewAppointment(ctx)
+			expected := helpers.NewCustomError(http.StatusBadRequest, tc.errMsg)
+			assert.Nil(t, got)
+			assert.Equal(t, expected, err)
+		})
+	}
 }
 
 func TestValidateUpdateInterviewAppointment(t *testing.T) {
@@ -175,12 +496,15 @@ func TestValidateUpdateInterviewAppointment(t *testing.T) {
 		Description string
 		Status      string
 	}
+	userId := "64ac6cb9b0a3e8792efc438e"
 	t.Run("validate update interview appointment success", func(t *testing.T) {
 		id := "6476f457e64589e868aac97b"
 		body := requestBody{Status: "DONE"}
 		var buf bytes.Buffer
 		json.NewEncoder(&buf).Encode(body)
 		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", userId)
+		ctx.Set("role", constants.INTERVIEWER_ROLE)
 		ctx.Params = []gin.Param{
 			{Key: "id", Value: id},
 		}
@@ -193,6 +517,157 @@ func TestValidateUpdateInterviewAppointment(t *testing.T) {
 			Title:       "",
 			Description: "",
 			Status:      "DONE",
+			UserID:      userId,
+			Role:        constants.INTERVIEWER_ROLE,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update interview appointment with If-Match", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		version := int64(3)
+		for ifMatch, expectedVersion := range map[string]*int64{`"3"`: &version, helpers.ContentETag(3, "comment"): &version, "*": nil} {
+			var buf bytes.Buffer
+			json.NewEncoder(&buf).Encode(requestBody{Status: "DONE"})
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Set("userId", userId)
+			ctx.Set("role", constants.INTERVIEWER_ROLE)
+			ctx.Params = []gin.Param{
+				{Key: "id", Value: id},
+			}
+			ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+			ctx.Request.Header.Set("If-Match", ifMatch)
+
+			tvalid := newTestInterviewValidate(t)
+			got, err := tvalid.interviewValidate.ValidateUpdateInterviewAppointment(ctx)
+			expected := &dto.UpdateInterviewAppointmentRequest{
+				ID:      id,
+				Status:  "DONE",
+				UserID:  userId,
+				Role:    constants.INTERVIEWER_ROLE,
+				IfMatch: expectedVersion,
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expected, got, ifMatch)
+		}
+	})
+	t.Run("validate update interview appointment error when If-Match is not a version", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(requestBody{Status: "DONE"})
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", userId)
+		ctx.Set("role", constants.INTERVIEWER_ROLE)
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+		ctx.Request.Header.Set("If-Match", `W/"3"`)
+
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateUpdateInterviewAppointment(ctx)
+		expected := helpers.NewCustomError(http.StatusPreconditionFailed, "If-Match does not match the current version")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate update interview appointment schedule", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		body := map[string]interface{}{
+			"startAt":  "2023-07-10T02:00:00Z",
+			"endAt":    "2023-07-10T03:30:00Z",
+			"timezone": "Europe/London",
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", userId)
+		ctx.Set("role", constants.INTERVIEWER_ROLE)
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateUpdateInterviewAppointment(ctx)
+		startAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		endAt := time.Date(2023, 7, 10, 3, 30, 0, 0, time.UTC)
+		expected := &dto.UpdateInterviewAppointmentRequest{
+			ID:              id,
+			StartAt:         &startAt,
+			EndAt:           &endAt,
+			DurationMinutes: 90,
+			Timezone:        "Europe/London",
+			UserID:          userId,
+			Role:            constants.INTERVIEWER_ROLE,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update interview appointment tags", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		body := map[string]interface{}{"tags": []string{"Backend", " senior ", "backend"}}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", userId)
+		ctx.Set("role", constants.INTERVIEWER_ROLE)
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateUpdateInterviewAppointment(ctx)
+		tags := []string{"backend", "senior"}
+		expected := &dto.UpdateInterviewAppointmentRequest{
+			ID:     id,
+			Tags:   &tags,
+			UserID: userId,
+			Role:   constants.INTERVIEWER_ROLE,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update interview appointment error when tag is invalid", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		body := map[string]interface{}{"tags": []string{"back end"}}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", userId)
+		ctx.Set("role", constants.INTERVIEWER_ROLE)
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateUpdateInterviewAppointment(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "tags: \"back end\" is not a valid tag")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate update interview appointment schedule without timezone", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		body := map[string]interface{}{"startAt": "2023-07-10T02:00:00Z", "durationMinutes": 30}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", userId)
+		ctx.Set("role", constants.INTERVIEWER_ROLE)
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateUpdateInterviewAppointment(ctx)
+		startAt := time.Date(2023, 7, 10, 2, 0, 0, 0, time.UTC)
+		endAt := time.Date(2023, 7, 10, 2, 30, 0, 0, time.UTC)
+		expected := &dto.UpdateInterviewAppointmentRequest{
+			ID:              id,
+			StartAt:         &startAt,
+			EndAt:           &endAt,
+			DurationMinutes: 30,
+			UserID:          userId,
+			Role:            constants.INTERVIEWER_ROLE,
 		}
 		assert.NoError(t, err)
 		assert.Equal(t, expected, got)
@@ -203,6 +678,8 @@ func TestValidateUpdateInterviewAppointment(t *testing.T) {
 		var buf bytes.Buffer
 		json.NewEncoder(&buf).Encode(body)
 		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", userId)
+		ctx.Set("role", constants.INTERVIEWER_ROLE)
 		ctx.Params = []gin.Param{
 			{Key: "id", Value: id},
 		}
@@ -219,6 +696,8 @@ func TestValidateUpdateInterviewAppointment(t *testing.T) {
 		var buf bytes.Buffer
 		json.NewEncoder(&buf).Encode(body)
 		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", userId)
+		ctx.Set("role", constants.INTERVIEWER_ROLE)
 		ctx.Params = []gin.Param{
 			{Key: "id", Value: id},
 		}
@@ -236,23 +715,35 @@ func TestValidateArchiveInterviewAppointment(t *testing.T) {
 	govalidator.SetFieldsRequiredByDefault(true)
 	t.Run("validate archive interview appointment success", func(t *testing.T) {
 		id := "6476f457e64589e868aac97b"
+		userId := "64ac6cb9b0a3e8792efc438e"
 		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
 		ctx.Params = []gin.Param{
 			{Key: "id", Value: id},
 		}
-
+		ctx.Request = httptest.NewRequest("DELETE", "http://example.com", nil)
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		ctx.Set("requestId", "req-1")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateArchiveInterviewAppointment(ctx)
-		expected := id
+		expected := &dto.ArchiveInterviewAppointmentRequest{
+			ID:        id,
+			UserID:    userId,
+			Role:      "STAFF",
+			RequestID: "req-1",
+			ClientIP:  "192.0.2.1",
+		}
 		assert.NoError(t, err)
 		assert.Equal(t, expected, got)
 	})
 	t.Run("validate archive interview appointment error when id is missing", func(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "64ac6cb9b0a3e8792efc438e")
+		ctx.Set("role", "STAFF")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateArchiveInterviewAppointment(ctx)
 		expected := helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
-		assert.Equal(t, "", got)
+		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
 	t.Run("validate archive interview appointment error when id is invalid format", func(t *testing.T) {
@@ -261,11 +752,176 @@ func TestValidateArchiveInterviewAppointment(t *testing.T) {
 		ctx.Params = []gin.Param{
 			{Key: "id", Value: id},
 		}
-
+		ctx.Set("userId", "64ac6cb9b0a3e8792efc438e")
+		ctx.Set("role", "STAFF")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateArchiveInterviewAppointment(ctx)
 		expected := helpers.NewCustomError(http.StatusBadRequest, "id in param must be of type bsonobjectid: \"xxxxxxx\"")
-		assert.Equal(t, "", got)
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate archive interview appointment error when role is missing", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		ctx.Set("userId", "64ac6cb9b0a3e8792efc438e")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateArchiveInterviewAppointment(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.InternalError, err)
+	})
+}
+
+func TestValidateAssignInterviewers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	id := "6476f457e64589e868aac97b"
+	t.Run("validate assign interviewers success", func(t *testing.T) {
+		body := map[string]interface{}{
+			"interviewerIds": []string{"64ac6cb9b0a3e8792efc438e", "64ac6cb9b0a3e8792efc438f", "64ac6cb9b0a3e8792efc438e"},
+		}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Request, _ = http.NewRequest("PUT", "http://example.com", &buf)
+		ctx.Set("userId", "64ac6cb9b0a3e8792efc4390")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateAssignInterviewers(ctx)
+		expected := &dto.AssignInterviewersRequest{
+			ID:             id,
+			InterviewerIDs: []string{"64ac6cb9b0a3e8792efc438e", "64ac6cb9b0a3e8792efc438f"},
+			UserID:         "64ac6cb9b0a3e8792efc4390",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate assign interviewers with empty panel", func(t *testing.T) {
+		body := map[string]interface{}{"interviewerIds": []string{}}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Request, _ = http.NewRequest("PUT", "http://example.com", &buf)
+		ctx.Set("userId", "64ac6cb9b0a3e8792efc4390")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateAssignInterviewers(ctx)
+		expected := &dto.AssignInterviewersRequest{ID: id, InterviewerIDs: []string{}, UserID: "64ac6cb9b0a3e8792efc4390"}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate assign interviewers error when interviewerIds is missing", func(t *testing.T) {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(map[string]interface{}{})
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Request, _ = http.NewRequest("PUT", "http://example.com", &buf)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateAssignInterviewers(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "interviewerIds: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate assign interviewers error when interviewer id is invalid format", func(t *testing.T) {
+		body := map[string]interface{}{"interviewerIds": []string{"xxxxx"}}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Request, _ = http.NewRequest("PUT", "http://example.com", &buf)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateAssignInterviewers(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "interviewerIds in body must be of type bsonobjectid: \"xxxxx\"")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateGetInterviewerAppointments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate get my interviews", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "64ac6cb9b0a3e8792efc438e")
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?page=2&limit=5", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewerAppointments(ctx)
+		expected := &dto.GetInterviewerAppointmentsRequest{InterviewerID: "64ac6cb9b0a3e8792efc438e", Page: 2, Limit: 5}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interviews of another user", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "64ac6cb9b0a3e8792efc438e")
+		ctx.Params = []gin.Param{{Key: "id", Value: "64ac6cb9b0a3e8792efc438f"}}
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewerAppointments(ctx)
+		expected := &dto.GetInterviewerAppointmentsRequest{InterviewerID: "64ac6cb9b0a3e8792efc438f"}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interviewer appointments error when id is invalid format", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{{Key: "id", Value: "xxxxx"}}
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/", nil)
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewerAppointments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "id in param must be of type bsonobjectid: \"xxxxx\"")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateGetInterviewComments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	id := "6476f457e64589e868aac97b"
+	commentId := "64ace6bd981e163c387f494e"
+	userId := "64ac6cb9b0a3e8792efc438e"
+	cursor := helpers.NewCreatedAtCursor(time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC), commentId, false)
+	t.Run("validate get interview comments success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "/?limit=10&cursor="+cursor, nil)
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewComments(ctx)
+		expected := &dto.GetInterviewCommentsRequest{
+			ID:     id,
+			Cursor: cursor,
+			Limit:  10,
+			UserID: userId,
+			Role:   "STAFF",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interview comments error when cursor is invalid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "/?cursor=xxxxxxx", nil)
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewComments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid cursor query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview comments error when limit is invalid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "/?limit=-1", nil)
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewComments(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid limit query parameter")
+		assert.Nil(t, got)
 		assert.Equal(t, expected, err)
 	})
 }
@@ -352,6 +1008,7 @@ func TestValidateUpdateInterviewComment(t *testing.T) {
 		}
 		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
 		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateUpdateInterviewComment(ctx)
 		expected := &dto.UpdateInterviewCommentRequest{
@@ -359,6 +1016,7 @@ func TestValidateUpdateInterviewComment(t *testing.T) {
 			CommentID: commentId,
 			Comment:   body.Comment,
 			UserID:    userId,
+			Role:      "STAFF",
 		}
 		assert.NoError(t, err)
 		assert.Equal(t, expected, got)
@@ -375,6 +1033,7 @@ func TestValidateUpdateInterviewComment(t *testing.T) {
 		}
 		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
 		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateUpdateInterviewComment(ctx)
 		expected := helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
@@ -393,6 +1052,7 @@ func TestValidateUpdateInterviewComment(t *testing.T) {
 		}
 		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
 		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateUpdateInterviewComment(ctx)
 		expected := helpers.NewCustomError(http.StatusBadRequest, "commentId: Missing required field")
@@ -413,6 +1073,7 @@ func TestValidateUpdateInterviewComment(t *testing.T) {
 		}
 		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
 		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateUpdateInterviewComment(ctx)
 		expected := helpers.NewCustomError(http.StatusBadRequest, "comment: Missing required field")
@@ -433,6 +1094,7 @@ func TestValidateUpdateInterviewComment(t *testing.T) {
 		}
 		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
 		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateUpdateInterviewComment(ctx)
 		expected := helpers.NewCustomError(http.StatusBadRequest, "id in param must be of type bsonobjectid: \"xxxxxxx\"")
@@ -453,6 +1115,7 @@ func TestValidateUpdateInterviewComment(t *testing.T) {
 		}
 		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
 		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
 		tvalid := newTestInterviewValidate(t)
 		got, err := tvalid.interviewValidate.ValidateUpdateInterviewComment(ctx)
 		expected := helpers.NewCustomError(http.StatusBadRequest, "commentId in param must be of type bsonobjectid: \"xxxxxxx\"")
@@ -460,3 +1123,235 @@ func TestValidateUpdateInterviewComment(t *testing.T) {
 		assert.Equal(t, expected, err)
 	})
 }
+
+func TestValidateUpdateWorkflow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	userId := "64ac6cb9b0a3e8792efc438e"
+	newContext := func(body map[string]interface{}) *gin.Context {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", userId)
+		ctx.Request, _ = http.NewRequest("PUT", "http://example.com", &buf)
+		return ctx
+	}
+	t.Run("validate update workflow success", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{
+			"statuses": []map[string]interface{}{
+				{"name": "TODO", "transitions": []string{"SCHEDULED", "SCHEDULED"}},
+				{"name": "SCHEDULED", "transitions": []string{"DONE"}},
+				{"name": "DONE"},
+			},
+		})
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateUpdateWorkflow(ctx)
+		expected := &dto.UpdateWorkflowRequest{
+			Statuses: []dto.WorkflowStatus{
+				{Name: "TODO", Transitions: []string{"SCHEDULED"}},
+				{Name: "SCHEDULED", Transitions: []string{"DONE"}},
+				{Name: "DONE", Transitions: []string{}},
+			},
+			InitialStatus: "TODO",
+			UserID:        userId,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	workflowErrors := []struct {
+		name   string
+		body   map[string]interface{}
+		errMsg string
+	}{
+		{
+			name:   "statuses are missing",
+			body:   map[string]interface{}{},
+			errMsg: "statuses: Missing required field",
+		},
+		{
+			name:   "status name is invalid",
+			body:   map[string]interface{}{"statuses": []map[string]interface{}{{"name": "in progress"}}},
+			errMsg: "statuses: \"in progress\" is not a valid status name",
+		},
+		{
+			name:   "status is listed twice",
+			body:   map[string]interface{}{"statuses": []map[string]interface{}{{"name": "TODO"}, {"name": "TODO"}}},
+			errMsg: "statuses: TODO is listed more than once",
+		},
+		{
+			name:   "transition targets an unknown status",
+			body:   map[string]interface{}{"statuses": []map[string]interface{}{{"name": "TODO", "transitions": []string{"DONE"}}}},
+			errMsg: "statuses: TODO moves to unknown status \"DONE\"",
+		},
+		{
+			name:   "transition targets itself",
+			body:   map[string]interface{}{"statuses": []map[string]interface{}{{"name": "TODO", "transitions": []string{"TODO"}}}},
+			errMsg: "statuses: TODO cannot move to itself",
+		},
+		{
+			name:   "initial status is unknown",
+			body:   map[string]interface{}{"statuses": []map[string]interface{}{{"name": "TODO"}}, "initialStatus": "NEW"},
+			errMsg: "initialStatus: must be one of the statuses",
+		},
+	}
+	for _, tc := range workflowErrors {
+		t.Run("validate update workflow error when "+tc.name, func(t *testing.T) {
+			ctx := newContext(tc.body)
+			tvalid := newTestInterviewValidate(t)
+			got, err := tvalid.interviewValidate.ValidateUpdateWorkflow(ctx)
+			expected := helpers.NewCustomError(http.StatusBadRequest, tc.errMsg)
+			assert.Nil(t, got)
+			assert.Equal(t, expected, err)
+		})
+	}
+}
+
+func TestValidateGetInterviewCommentRevisions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	id := "6476f457e64589e868aac97b"
+	commentId := "64ace6bd981e163c387f494e"
+	userId := "64ac6cb9b0a3e8792efc438e"
+	t.Run("validate get interview comment revisions success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+			{Key: "commentId", Value: commentId},
+		}
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewCommentRevisions(ctx)
+		expected := &dto.GetInterviewCommentRevisionsRequest{
+			ID:        id,
+			CommentID: commentId,
+			UserID:    userId,
+			Role:      "STAFF",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interview comment revisions error when comment id is invalid format", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+			{Key: "commentId", Value: "xxxxxxx"},
+		}
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewCommentRevisions(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "commentId in param must be of type bsonobjectid: \"xxxxxxx\"")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateDeleteInterviewComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	id := "6476f457e64589e868aac97b"
+	commentId := "64ace6bd981e163c387f494e"
+	userId := "64ac6cb9b0a3e8792efc438e"
+	t.Run("validate delete interview comment success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+			{Key: "commentId", Value: commentId},
+		}
+		ctx.Request, _ = http.NewRequest("DELETE", "http://example.com/?purge=true", nil)
+		ctx.Set("userId", userId)
+		ctx.Set("role", "ADMIN")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateDeleteInterviewComment(ctx)
+		expected := &dto.DeleteInterviewCommentRequest{
+			ID:        id,
+			CommentID: commentId,
+			Purge:     true,
+			UserID:    userId,
+			Role:      "ADMIN",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate delete interview comment error when comment id is invalid format", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+			{Key: "commentId", Value: "xxxxxxx"},
+		}
+		ctx.Request, _ = http.NewRequest("DELETE", "http://example.com", nil)
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateDeleteInterviewComment(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "commentId in param must be of type bsonobjectid: \"xxxxxxx\"")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate delete interview comment error when invalid purge params", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+			{Key: "commentId", Value: commentId},
+		}
+		ctx.Request, _ = http.NewRequest("DELETE", "http://example.com/?purge=yes", nil)
+		ctx.Set("userId", userId)
+		ctx.Set("role", "ADMIN")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateDeleteInterviewComment(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid purge query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateGetInterviewActivity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	id := "6476f457e64589e868aac97b"
+	userId := "64ac6cb9b0a3e8792efc438e"
+	cursor := helpers.NewCreatedAtCursor(time.Date(2023, 7, 1, 3, 0, 0, 0, time.UTC), "64ace6bd981e163c387f494e", true)
+	t.Run("validate get interview activity success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "/?limit=10&cursor="+cursor, nil)
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewActivity(ctx)
+		expected := &dto.GetInterviewActivityRequest{
+			ID:     id,
+			Cursor: cursor,
+			Limit:  10,
+			UserID: userId,
+			Role:   "STAFF",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get interview activity error when limit is invalid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "/?limit=abc", nil)
+		ctx.Params = []gin.Param{{Key: "id", Value: id}}
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewActivity(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid limit query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get interview activity error when id is invalid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "/", nil)
+		ctx.Params = []gin.Param{{Key: "id", Value: "xxxxxxx"}}
+		ctx.Set("userId", userId)
+		ctx.Set("role", "STAFF")
+		tvalid := newTestInterviewValidate(t)
+		got, err := tvalid.interviewValidate.ValidateGetInterviewActivity(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "id in param must be of type bsonobjectid: \"xxxxxxx\"")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
@@ -0,0 +1,264 @@
+package validate
+
+import (
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"strconv"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+const (
+	maxScorecardCompetencies = 20
+	maxScorecardQuestions    = 20
+	maxRatingScale           = 10
+)
+
+type scorecardValidate struct {
+}
+
+func NewScorecardValidate() ports.ScorecardValidate {
+	return &scorecardValidate{}
+}
+
+func (v scorecardValidate) ValidateGetScorecardTemplates(ctx *gin.Context) (*dto.GetScorecardTemplatesRequest, error) {
+	req := dto.GetScorecardTemplatesRequest{}
+	if page, ok := ctx.GetQuery("page"); ok {
+		v, err := strconv.Atoi(page)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid page query parameter")
+		}
+		req.Page = uint32(v)
+	}
+	if limit, ok := ctx.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid limit query parameter")
+		}
+		req.Limit = uint32(v)
+	}
+	if includeArchived, ok := ctx.GetQuery("includeArchived"); ok {
+		v, err := strconv.ParseBool(includeArchived)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid includeArchived query parameter")
+		}
+		req.IncludeArchived = v
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return &req, nil
+}
+
+func (v scorecardValidate) ValidateGetScorecardTemplate(ctx *gin.Context) (string, error) {
+	return validateObjectIDParam(ctx, "id")
+}
+
+// ValidateCreateScorecardTemplate checks the template on its own: names are
+// unique and the rating scale lies within 0 and 10.
+func (v scorecardValidate) ValidateCreateScorecardTemplate(ctx *gin.Context) (*dto.CreateScorecardTemplateRequest, error) {
+	req := dto.CreateScorecardTemplateRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.CreatedBy = value.(string)
+	req.Name = strings.TrimSpace(req.Name)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	if len(req.Competencies) == 0 {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "competencies: Missing required field")
+	}
+	if len(req.Competencies) > maxScorecardCompetencies {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "competencies: must contain at most "+strconv.Itoa(maxScorecardCompetencies)+" competencies")
+	}
+	names := map[string]bool{}
+	for i := range req.Competencies {
+		competency := &req.Competencies[i]
+		competency.Name = strings.TrimSpace(competency.Name)
+		competency.Description = strings.TrimSpace(competency.Description)
+		if competency.Name == "" {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "competencies: name is required")
+		}
+		if names[competency.Name] {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "competencies: "+competency.Name+" is listed more than once")
+		}
+		names[competency.Name] = true
+	}
+	if req.RatingScale == nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "ratingScale: Missing required field")
+	}
+	if req.RatingScale.Min < 0 || req.RatingScale.Max > maxRatingScale || req.RatingScale.Min >= req.RatingScale.Max {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "ratingScale: min must be less than max, within 0 and "+strconv.Itoa(maxRatingScale))
+	}
+	if req.Questions == nil {
+		req.Questions = []dto.ScorecardQuestion{}
+	}
+	if len(req.Questions) > maxScorecardQuestions {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "questions: must contain at most "+strconv.Itoa(maxScorecardQuestions)+" questions")
+	}
+	for i := range req.Questions {
+		req.Questions[i].Text = strings.TrimSpace(req.Questions[i].Text)
+		if req.Questions[i].Text == "" {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "questions: text is required")
+		}
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v scorecardValidate) ValidateArchiveScorecardTemplate(ctx *gin.Context) (*dto.ArchiveScorecardTemplateRequest, error) {
+	id, err := validateObjectIDParam(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	return &dto.ArchiveScorecardTemplateRequest{
+		ID:        id,
+		UserID:    value.(string),
+		RequestID: ctx.GetString("requestId"),
+		ClientIP:  ctx.ClientIP(),
+	}, nil
+}
+
+func (v scorecardValidate) ValidateGetScorecards(ctx *gin.Context) (*dto.GetScorecardsRequest, error) {
+	id, err := validateObjectIDParam(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	role, exists := ctx.Get("role")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	return &dto.GetScorecardsRequest{
+		ID:     id,
+		UserID: userId.(string),
+		Role:   role.(string),
+	}, nil
+}
+
+func (v scorecardValidate) ValidateSubmitScorecard(ctx *gin.Context) (*dto.SubmitScorecardRequest, error) {
+	req := dto.SubmitScorecardRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	id, err := validateObjectIDParam(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+	req.ID = id
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = value.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	ratings, answers, err := validateScorecardFeedback(req.Ratings, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+	req.Ratings, req.Answers = ratings, answers
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v scorecardValidate) ValidateUpdateScorecard(ctx *gin.Context) (*dto.UpdateScorecardRequest, error) {
+	req := dto.UpdateScorecardRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	id, err := validateObjectIDParam(ctx, "id")
+	if err != nil {
+		return nil, err
+	}
+	scorecardId, err := validateObjectIDParam(ctx, "scorecardId")
+	if err != nil {
+		return nil, err
+	}
+	req.ID, req.ScorecardID = id, scorecardId
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = value.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	ratings, answers, err := validateScorecardFeedback(req.Ratings, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+	req.Ratings, req.Answers = ratings, answers
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+// validateScorecardFeedback checks the shape of ratings and answers. Whether
+// they match the template is checked by the service.
+func validateScorecardFeedback(ratings []dto.ScorecardRating, answers []dto.ScorecardAnswer) ([]dto.ScorecardRating, []dto.ScorecardAnswer, error) {
+	if len(ratings) == 0 {
+		return nil, nil, helpers.NewCustomError(http.StatusBadRequest, "ratings: Missing required field")
+	}
+	rated := map[string]bool{}
+	for i := range ratings {
+		ratings[i].Competency = strings.TrimSpace(ratings[i].Competency)
+		ratings[i].Note = strings.TrimSpace(ratings[i].Note)
+		if ratings[i].Competency == "" {
+			return nil, nil, helpers.NewCustomError(http.StatusBadRequest, "ratings: competency is required")
+		}
+		if rated[ratings[i].Competency] {
+			return nil, nil, helpers.NewCustomError(http.StatusBadRequest, "ratings: "+ratings[i].Competency+" is rated more than once")
+		}
+		rated[ratings[i].Competency] = true
+	}
+	if answers == nil {
+		answers = []dto.ScorecardAnswer{}
+	}
+	formats := strfmt.Default
+	answered := map[string]bool{}
+	for i := range answers {
+		answers[i].Answer = strings.TrimSpace(answers[i].Answer)
+		if err := validate.FormatOf("questionId", "body", "bsonobjectid", answers[i].QuestionID, formats); err != nil {
+			return nil, nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+		}
+		if answered[answers[i].QuestionID] {
+			return nil, nil, helpers.NewCustomError(http.StatusBadRequest, "answers: "+answers[i].QuestionID+" is answered more than once")
+		}
+		answered[answers[i].QuestionID] = true
+	}
+	return ratings, answers, nil
+}
+
+func validateObjectIDParam(ctx *gin.Context, name string) (string, error) {
+	id := ctx.Param(name)
+	if id == "" {
+		return "", helpers.NewCustomError(http.StatusBadRequest, name+": Missing required field")
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf(name, "param", "bsonobjectid", id, formats); err != nil {
+		return "", helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return id, nil
+}
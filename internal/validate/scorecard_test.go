@@ -0,0 +1,271 @@
+package validate_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/validate"
+	"testing"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type testScorecardValidate struct {
+	scorecardValidate ports.ScorecardValidate
+}
+
+func newTestScorecardValidate(t *testing.T) testScorecardValidate {
+	scorecardValidate := validate.NewScorecardValidate()
+	return testScorecardValidate{scorecardValidate}
+}
+
+func TestValidateGetScorecardTemplates(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate get scorecard templates success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?page=2&limit=10&includeArchived=true", nil)
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateGetScorecardTemplates(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.GetScorecardTemplatesRequest{Page: 2, Limit: 10, IncludeArchived: true}, got)
+	})
+	t.Run("validate get scorecard templates error when includeArchived is invalid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?includeArchived=maybe", nil)
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateGetScorecardTemplates(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "Invalid includeArchived query parameter"), err)
+	})
+}
+
+func TestValidateCreateScorecardTemplate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	newContext := func(body map[string]interface{}) *gin.Context {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		return ctx
+	}
+	validBody := func() map[string]interface{} {
+		return map[string]interface{}{
+			"name": " Backend onsite ",
+			"competencies": []map[string]interface{}{
+				{"name": " Coding ", "description": "Writes working code"},
+				{"name": "System design"},
+			},
+			"ratingScale": map[string]interface{}{"min": 1, "max": 4},
+			"questions": []map[string]interface{}{
+				{"text": " What stood out? ", "required": true},
+			},
+		}
+	}
+	t.Run("validate create scorecard template success", func(t *testing.T) {
+		ctx := newContext(validBody())
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateCreateScorecardTemplate(ctx)
+		expected := &dto.CreateScorecardTemplateRequest{
+			Name: "Backend onsite",
+			Competencies: []dto.ScorecardCompetency{
+				{Name: "Coding", Description: "Writes working code"},
+				{Name: "System design"},
+			},
+			RatingScale: &dto.ScorecardRatingScale{Min: 1, Max: 4},
+			Questions:   []dto.ScorecardQuestion{{Text: "What stood out?", Required: true}},
+			CreatedBy:   "6476f457e64589e868aac97b",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate create scorecard template defaults questions to empty", func(t *testing.T) {
+		body := validBody()
+		delete(body, "questions")
+		ctx := newContext(body)
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateCreateScorecardTemplate(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.ScorecardQuestion{}, got.Questions)
+	})
+	errorCases := []struct {
+		name   string
+		modify func(body map[string]interface{})
+		errMsg string
+	}{
+		{"competencies missing", func(body map[string]interface{}) { delete(body, "competencies") }, "competencies: Missing required field"},
+		{"competency name empty", func(body map[string]interface{}) {
+			body["competencies"] = []map[string]interface{}{{"name": " "}}
+		}, "competencies: name is required"},
+		{"competency duplicated", func(body map[string]interface{}) {
+			body["competencies"] = []map[string]interface{}{{"name": "Coding"}, {"name": "Coding "}}
+		}, "competencies: Coding is listed more than once"},
+		{"rating scale missing", func(body map[string]interface{}) { delete(body, "ratingScale") }, "ratingScale: Missing required field"},
+		{"rating scale reversed", func(body map[string]interface{}) {
+			body["ratingScale"] = map[string]interface{}{"min": 4, "max": 1}
+		}, "ratingScale: min must be less than max, within 0 and 10"},
+		{"rating scale too wide", func(body map[string]interface{}) {
+			body["ratingScale"] = map[string]interface{}{"min": 0, "max": 11}
+		}, "ratingScale: min must be less than max, within 0 and 10"},
+		{"question text empty", func(body map[string]interface{}) {
+			body["questions"] = []map[string]interface{}{{"text": ""}}
+		}, "questions: text is required"},
+	}
+	for _, tc := range errorCases {
+		t.Run("validate create scorecard template error when "+tc.name, func(t *testing.T) {
+			body := validBody()
+			tc.modify(body)
+			ctx := newContext(body)
+			tvalid := newTestScorecardValidate(t)
+			got, err := tvalid.scorecardValidate.ValidateCreateScorecardTemplate(ctx)
+			assert.Nil(t, got)
+			assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, tc.errMsg), err)
+		})
+	}
+}
+
+func TestValidateArchiveScorecardTemplate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Run("validate archive scorecard template success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Set("requestId", "req-1")
+		ctx.Params = []gin.Param{{Key: "id", Value: "64ac6cb9b0a3e8792efc438e"}}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", nil)
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateArchiveScorecardTemplate(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.ArchiveScorecardTemplateRequest{ID: "64ac6cb9b0a3e8792efc438e", UserID: "6476f457e64589e868aac97b", RequestID: "req-1"}, got)
+	})
+	t.Run("validate archive scorecard template error when id is invalid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Params = []gin.Param{{Key: "id", Value: "xxxxxxx"}}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", nil)
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateArchiveScorecardTemplate(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "id in param must be of type bsonobjectid: \"xxxxxxx\""), err)
+	})
+}
+
+func TestValidateSubmitScorecard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	id := "64aaf0156999249a602ff55f"
+	questionId := "64aaf0156999249a602ff560"
+	newContext := func(body map[string]interface{}) *gin.Context {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Params = gin.Params{{Key: "id", Value: id}}
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		return ctx
+	}
+	t.Run("validate submit scorecard success", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{
+			"ratings":        []map[string]interface{}{{"competency": " Coding ", "score": 3, "note": " ok "}},
+			"answers":        []map[string]interface{}{{"questionId": questionId, "answer": " Fine "}},
+			"recommendation": "STRONG_YES",
+		})
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateSubmitScorecard(ctx)
+		expected := &dto.SubmitScorecardRequest{
+			ID:             id,
+			Ratings:        []dto.ScorecardRating{{Competency: "Coding", Score: 3, Note: "ok"}},
+			Answers:        []dto.ScorecardAnswer{{QuestionID: questionId, Answer: "Fine"}},
+			Recommendation: "STRONG_YES",
+			UserID:         "6476f457e64589e868aac97b",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate submit scorecard error when recommendation is invalid", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{
+			"ratings":        []map[string]interface{}{{"competency": "Coding", "score": 3}},
+			"recommendation": "MAYBE",
+		})
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateSubmitScorecard(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, helpers.ErrorHandler(err).StatusCode)
+	})
+	t.Run("validate submit scorecard error when ratings missing", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{"recommendation": "NO"})
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateSubmitScorecard(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "ratings: Missing required field"), err)
+	})
+	t.Run("validate submit scorecard error when competency rated twice", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{
+			"ratings":        []map[string]interface{}{{"competency": "Coding", "score": 3}, {"competency": "Coding", "score": 2}},
+			"recommendation": "NO",
+		})
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateSubmitScorecard(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, helpers.NewCustomError(http.StatusBadRequest, "ratings: Coding is rated more than once"), err)
+	})
+	t.Run("validate submit scorecard error when question id is invalid", func(t *testing.T) {
+		ctx := newContext(map[string]interface{}{
+			"ratings":        []map[string]interface{}{{"competency": "Coding", "score": 3}},
+			"answers":        []map[string]interface{}{{"questionId": "xxx", "answer": "Fine"}},
+			"recommendation": "NO",
+		})
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateSubmitScorecard(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, helpers.ErrorHandler(err).StatusCode)
+	})
+}
+
+func TestValidateUpdateScorecard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	id := "64aaf0156999249a602ff55f"
+	newContext := func(scorecardId string, body map[string]interface{}) *gin.Context {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Params = gin.Params{{Key: "id", Value: id}, {Key: "scorecardId", Value: scorecardId}}
+		ctx.Request, _ = http.NewRequest("PUT", "http://example.com", &buf)
+		return ctx
+	}
+	body := map[string]interface{}{
+		"ratings":        []map[string]interface{}{{"competency": "Coding", "score": 1}},
+		"recommendation": "STRONG_NO",
+	}
+	t.Run("validate update scorecard success", func(t *testing.T) {
+		ctx := newContext("64aaf0156999249a602ff561", body)
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateUpdateScorecard(ctx)
+		expected := &dto.UpdateScorecardRequest{
+			ID:             id,
+			ScorecardID:    "64aaf0156999249a602ff561",
+			Ratings:        []dto.ScorecardRating{{Competency: "Coding", Score: 1}},
+			Answers:        []dto.ScorecardAnswer{},
+			Recommendation: "STRONG_NO",
+			UserID:         "6476f457e64589e868aac97b",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update scorecard error when scorecard id is invalid", func(t *testing.T) {
+		ctx := newContext("xxx", body)
+		tvalid := newTestScorecardValidate(t)
+		got, err := tvalid.scorecardValidate.ValidateUpdateScorecard(ctx)
+		assert.Nil(t, got)
+		assert.Equal(t, http.StatusBadRequest, helpers.ErrorHandler(err).StatusCode)
+	})
+}
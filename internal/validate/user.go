@@ -0,0 +1,234 @@
+package validate
+
+import (
+	"net/http"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"strconv"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+type userValidate struct {
+}
+
+func NewUserValidate() ports.UserValidate {
+	return &userValidate{}
+}
+
+func (v userValidate) ValidateGetUsers(ctx *gin.Context) (*dto.GetUsersRequest, error) {
+	req := dto.GetUsersRequest{}
+	if page, ok := ctx.GetQuery("page"); ok {
+		v, err := strconv.Atoi(page)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid page query parameter")
+		}
+		req.Page = uint32(v)
+	}
+	if limit, ok := ctx.GetQuery("limit"); ok {
+		v, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid limit query parameter")
+		}
+		req.Limit = uint32(v)
+	}
+	req.Search = ctx.Query("search")
+	req.Role = ctx.Query("role")
+	req.Status = ctx.Query("status")
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return &req, nil
+}
+
+func (v userValidate) ValidateGetUser(ctx *gin.Context) (string, error) {
+	id := ctx.Param("id")
+	if id == "" {
+		return "", helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
+		return "", helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	return id, nil
+}
+
+func (v userValidate) ValidateUpdateUser(ctx *gin.Context) (*dto.UpdateUserRequest, error) {
+	req := dto.UpdateUserRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	id := ctx.Param("id")
+	if id == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	req.ID = id
+	if req.Name == "" && req.Email == "" && req.ImageUrl == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "at least one field required")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = value.(string)
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v userValidate) ValidateUpdateUserRole(ctx *gin.Context) (*dto.UpdateUserRoleRequest, error) {
+	req := dto.UpdateUserRoleRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	id := ctx.Param("id")
+	if id == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	req.ID = id
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = value.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v userValidate) ValidateUpdateUserStatus(ctx *gin.Context) (*dto.UpdateUserStatusRequest, error) {
+	id := ctx.Param("id")
+	if id == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req := dto.UpdateUserStatusRequest{
+		ID:     id,
+		UserID: value.(string),
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	formats := strfmt.Default
+	if err := validate.FormatOf("id", "param", "bsonobjectid", id, formats); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v userValidate) ValidateUnlockUser(ctx *gin.Context) (*dto.UpdateUserStatusRequest, error) {
+	return v.ValidateUpdateUserStatus(ctx)
+}
+
+func (v userValidate) ValidateGetMe(ctx *gin.Context) (string, error) {
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return "", helpers.InternalError
+	}
+	return value.(string), nil
+}
+
+func (v userValidate) ValidateUpdateMe(ctx *gin.Context) (*dto.UpdateUserRequest, error) {
+	req := dto.UpdateUserRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.ID = value.(string)
+	req.UserID = req.ID
+	if req.Name == "" && req.Email == "" && req.ImageUrl == "" {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "at least one field required")
+	}
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v userValidate) ValidateChangePassword(ctx *gin.Context) (*dto.ChangePasswordRequest, error) {
+	req := dto.ChangePasswordRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	userId, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	sessionId, exists := ctx.Get("sessionId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = userId.(string)
+	req.SessionID = sessionId.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	if req.OldPassword == req.NewPassword {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "New password must be different from old password")
+	}
+	return &req, nil
+}
+
+func (v userValidate) ValidateMFACode(ctx *gin.Context) (*dto.MFACodeRequest, error) {
+	req := dto.MFACodeRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.UserID = value.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
+
+func (v userValidate) ValidateCreateServiceAccount(ctx *gin.Context) (*dto.CreateServiceAccountRequest, error) {
+	req := dto.CreateServiceAccountRequest{}
+	if err := ctx.BindJSON(&req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, "Invalid input parameter")
+	}
+	value, exists := ctx.Get("userId")
+	if !exists {
+		return nil, helpers.InternalError
+	}
+	req.CreatedBy = value.(string)
+	if _, err := govalidator.ValidateStruct(req); err != nil {
+		return nil, helpers.NewCustomError(http.StatusBadRequest, err.Error())
+	}
+	req.RequestID = ctx.GetString("requestId")
+	req.ClientIP = ctx.ClientIP()
+	return &req, nil
+}
@@ -0,0 +1,450 @@
+package validate_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"robinhood-assignment/helpers"
+	"robinhood-assignment/internal/core/ports"
+	"robinhood-assignment/internal/dto"
+	"robinhood-assignment/internal/validate"
+	"testing"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type testUserValidate struct {
+	userValidate ports.UserValidate
+}
+
+func newTestUserValidate(t *testing.T) testUserValidate {
+	userValidate := validate.NewUserValidate()
+	return testUserValidate{userValidate}
+}
+
+func TestValidateGetUsers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate get users success", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?page=2&limit=10&search=john&role=STAFF&status=ACTIVE", nil)
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateGetUsers(ctx)
+		expected := &dto.GetUsersRequest{
+			Page:   2,
+			Limit:  10,
+			Search: "john",
+			Role:   "STAFF",
+			Status: "ACTIVE",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate get users error when invalid page params", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?page=1x", nil)
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateGetUsers(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Invalid page query parameter")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate get users error when invalid status", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("GET", "http://example.com/?status=UNKNOWN", nil)
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateGetUsers(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "Status: UNKNOWN does not validate as in(ACTIVE|DEACTIVATED)")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateGetUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate get user success", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateGetUser(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, id, got)
+	})
+	t.Run("validate get user error when id is invalid format", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: "xxxxxxx"},
+		}
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateGetUser(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "id in param must be of type bsonobjectid: \"xxxxxxx\"")
+		assert.Equal(t, "", got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateUpdateUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		Name     string
+		Email    string
+		ImageUrl string
+	}
+	t.Run("validate update user success", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		body := requestBody{Email: "john@example.com"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUpdateUser(ctx)
+		expected := &dto.UpdateUserRequest{
+			ID:     id,
+			Email:  "john@example.com",
+			UserID: "6476f457e64589e868aac97c",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update user error when not input all field", func(t *testing.T) {
+		body := requestBody{}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: "6476f457e64589e868aac97b"},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUpdateUser(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "at least one field required")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate update user error when email is invalid", func(t *testing.T) {
+		body := requestBody{Email: "john"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: "6476f457e64589e868aac97b"},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUpdateUser(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "email: john does not validate as email")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateUpdateUserRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		Role string
+	}
+	t.Run("validate update user role success", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		body := requestBody{Role: "ADMIN"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUpdateUserRole(ctx)
+		expected := &dto.UpdateUserRoleRequest{
+			ID:     id,
+			Role:   "ADMIN",
+			UserID: "6476f457e64589e868aac97c",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update user role error when role is invalid", func(t *testing.T) {
+		body := requestBody{Role: "OWNER"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: "6476f457e64589e868aac97b"},
+		}
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUpdateUserRole(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "role: OWNER does not validate as in(VIEWER|INTERVIEWER|STAFF|ADMIN)")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateUpdateUserStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate update user status success", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest("PATCH", "http://example.com", nil)
+		ctx.Request.RemoteAddr = "10.0.0.1:51234"
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+		ctx.Set("requestId", "req-1")
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUpdateUserStatus(ctx)
+		expected := &dto.UpdateUserStatusRequest{
+			ID:        id,
+			UserID:    "6476f457e64589e868aac97c",
+			RequestID: "req-1",
+			ClientIP:  "10.0.0.1",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update user status error when id is missing", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUpdateUserStatus(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateUnlockUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	t.Run("validate unlock user success", func(t *testing.T) {
+		id := "6476f457e64589e868aac97b"
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest("PATCH", "http://example.com", nil)
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+		ctx.Params = []gin.Param{
+			{Key: "id", Value: id},
+		}
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUnlockUser(ctx)
+		expected := &dto.UpdateUserStatusRequest{
+			ID:       id,
+			UserID:   "6476f457e64589e868aac97c",
+			ClientIP: "192.0.2.1",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate unlock user error when id is missing", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUnlockUser(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "id: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateUpdateMe(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		Name string
+	}
+	t.Run("validate update me success", func(t *testing.T) {
+		body := requestBody{Name: "John"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUpdateMe(ctx)
+		expected := &dto.UpdateUserRequest{
+			ID:     "6476f457e64589e868aac97b",
+			Name:   "John",
+			UserID: "6476f457e64589e868aac97b",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate update me error when not input all field", func(t *testing.T) {
+		body := requestBody{}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("PATCH", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateUpdateMe(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "at least one field required")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateChangePassword(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		OldPassword string
+		NewPassword string
+	}
+	t.Run("validate change password success", func(t *testing.T) {
+		body := requestBody{OldPassword: "1234567890", NewPassword: "0987654321"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Set("sessionId", "6476f457e64589e868aac97c")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateChangePassword(ctx)
+		expected := &dto.ChangePasswordRequest{
+			OldPassword: "1234567890",
+			NewPassword: "0987654321",
+			UserID:      "6476f457e64589e868aac97b",
+			SessionID:   "6476f457e64589e868aac97c",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate change password error when new password is missing", func(t *testing.T) {
+		body := requestBody{OldPassword: "1234567890"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Set("sessionId", "6476f457e64589e868aac97c")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateChangePassword(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "newPassword: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+	t.Run("validate change password error when new password is the same", func(t *testing.T) {
+		body := requestBody{OldPassword: "1234567890", NewPassword: "1234567890"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Set("sessionId", "6476f457e64589e868aac97c")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateChangePassword(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "New password must be different from old password")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateMFACode(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		Code string `json:"code,omitempty"`
+	}
+	t.Run("validate mfa code success", func(t *testing.T) {
+		body := requestBody{Code: "123456"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateMFACode(ctx)
+		expected := &dto.MFACodeRequest{
+			Code:   "123456",
+			UserID: "6476f457e64589e868aac97b",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate mfa code error when code is missing", func(t *testing.T) {
+		body := requestBody{}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Set("userId", "6476f457e64589e868aac97b")
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateMFACode(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "code: Missing required field")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestValidateCreateServiceAccount(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	govalidator.SetFieldsRequiredByDefault(true)
+	type requestBody struct {
+		Name     string `json:"name,omitempty"`
+		Username string `json:"username,omitempty"`
+		Role     string `json:"role,omitempty"`
+	}
+	t.Run("validate create service account success", func(t *testing.T) {
+		body := requestBody{Name: "Interview sync", Username: "interview-sync", Role: "STAFF"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateCreateServiceAccount(ctx)
+		expected := &dto.CreateServiceAccountRequest{
+			Name:      "Interview sync",
+			Username:  "interview-sync",
+			Role:      "STAFF",
+			CreatedBy: "6476f457e64589e868aac97c",
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	})
+	t.Run("validate create service account error when role is invalid", func(t *testing.T) {
+		body := requestBody{Name: "Interview sync", Username: "interview-sync", Role: "ROBOT"}
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request, _ = http.NewRequest("POST", "http://example.com", &buf)
+		ctx.Set("userId", "6476f457e64589e868aac97c")
+
+		tvalid := newTestUserValidate(t)
+		got, err := tvalid.userValidate.ValidateCreateServiceAccount(ctx)
+		expected := helpers.NewCustomError(http.StatusBadRequest, "role: ROBOT does not validate as in(VIEWER|INTERVIEWER|STAFF|ADMIN)")
+		assert.Nil(t, got)
+		assert.Equal(t, expected, err)
+	})
+}